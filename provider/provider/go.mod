@@ -8,7 +8,7 @@ require (
 	github.com/hashicorp/terraform-plugin-go v0.22.1
 	github.com/hashicorp/terraform-plugin-log v0.9.0
 	github.com/hashicorp/terraform-plugin-testing v1.7.0
-	github.com/petsinc/telnyx-rest-client v0.0.0
+	github.com/petsinc/telnyx-rest-client v1.0.0
 )
 
 replace github.com/petsinc/telnyx-rest-client => ../../telnyx-rest-client
@@ -0,0 +1,352 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
+)
+
+var (
+	_ resource.Resource                = &AddressResource{}
+	_ resource.ResourceWithImportState = &AddressResource{}
+)
+
+func NewAddressResource() resource.Resource {
+	return &AddressResource{}
+}
+
+type AddressResource struct {
+	client *telnyx.TelnyxClient
+}
+
+type AddressResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	CustomerReference  types.String `tfsdk:"customer_reference"`
+	FirstName          types.String `tfsdk:"first_name"`
+	LastName           types.String `tfsdk:"last_name"`
+	BusinessName       types.String `tfsdk:"business_name"`
+	PhoneNumber        types.String `tfsdk:"phone_number"`
+	StreetAddress      types.String `tfsdk:"street_address"`
+	ExtendedAddress    types.String `tfsdk:"extended_address"`
+	Locality           types.String `tfsdk:"locality"`
+	AdministrativeArea types.String `tfsdk:"administrative_area"`
+	Neighborhood       types.String `tfsdk:"neighborhood"`
+	Borough            types.String `tfsdk:"borough"`
+	PostalCode         types.String `tfsdk:"postal_code"`
+	CountryCode        types.String `tfsdk:"country_code"`
+	AddressBook        types.Bool   `tfsdk:"address_book"`
+	ValidateAddress    types.Bool   `tfsdk:"validate_address"`
+	IgnoreSuggestions  types.Bool   `tfsdk:"ignore_suggestions"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+}
+
+func (r *AddressResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_address"
+}
+
+func (r *AddressResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for managing Telnyx addresses, such as the emergency (E911) address of phone numbers. " +
+			"Telnyx does not allow addresses to be edited, so changing any field replaces the address. " +
+			"When validate_address is set, the address is checked before it is created and Telnyx's suggested corrections must be applied to the configuration, unless ignore_suggestions is set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the address",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_reference":  addressString("Customer reference for the address", false),
+			"first_name":          addressString("First name of the person at the address", true),
+			"last_name":           addressString("Last name of the person at the address", true),
+			"business_name":       addressString("Name of the business at the address", true),
+			"phone_number":        addressString("Contact phone number for the address", false),
+			"street_address":      addressString("House number and street, e.g. 311 W Superior St", true),
+			"extended_address":    addressString("Additional address line, such as a suite or floor", false),
+			"locality":            addressString("City or town", true),
+			"administrative_area": addressString("State, province or region. US addresses use the two letter state code", false),
+			"neighborhood":        addressString("Neighborhood of the address", false),
+			"borough":             addressString("Borough of the address", false),
+			"postal_code":         addressString("Postal or ZIP code", false),
+			"country_code":        addressString("ISO 3166-1 alpha-2 country code, e.g. US", true),
+			"address_book": schema.BoolAttribute{
+				Description: "Whether the address is listed in the Telnyx portal address book. Defaults to true",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"validate_address": schema.BoolAttribute{
+				Description: "Validate the address for emergency use before it is created. Only validated addresses can be used to enable emergency calling. Defaults to true",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"ignore_suggestions": schema.BoolAttribute{
+				Description: "Create a valid address as configured even when Telnyx suggests corrections, reporting them as a warning instead of an error. Defaults to false",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"created_at": computedString("ISO 8601 formatted date indicating when the address was created"),
+		},
+	}
+}
+
+// addressString is an address field. Addresses cannot be edited, so every
+// change replaces the address.
+func addressString(description string, required bool) schema.StringAttribute {
+	if required {
+		return schema.StringAttribute{
+			Description: description,
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+	return schema.StringAttribute{
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+func (r *AddressResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		client, ok := req.ProviderData.(*telnyx.TelnyxClient)
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Unexpected Resource Configure Type",
+				fmt.Sprintf("Expected *telnyx.TelnyxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			)
+			return
+		}
+		r.client = client
+		tflog.Info(ctx, "Configured Telnyx client for AddressResource")
+	}
+}
+
+func (r *AddressResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AddressResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := telnyx.CreateAddressRequest{
+		CustomerReference:  plan.CustomerReference.ValueString(),
+		FirstName:          plan.FirstName.ValueString(),
+		LastName:           plan.LastName.ValueString(),
+		BusinessName:       plan.BusinessName.ValueString(),
+		PhoneNumber:        plan.PhoneNumber.ValueString(),
+		StreetAddress:      plan.StreetAddress.ValueString(),
+		ExtendedAddress:    plan.ExtendedAddress.ValueString(),
+		Locality:           plan.Locality.ValueString(),
+		AdministrativeArea: plan.AdministrativeArea.ValueString(),
+		Neighborhood:       plan.Neighborhood.ValueString(),
+		Borough:            plan.Borough.ValueString(),
+		PostalCode:         plan.PostalCode.ValueString(),
+		CountryCode:        plan.CountryCode.ValueString(),
+		AddressBook:        telnyx.BoolPtr(plan.AddressBook.ValueBool()),
+		ValidateAddress:    telnyx.BoolPtr(plan.ValidateAddress.ValueBool()),
+	}
+
+	if plan.ValidateAddress.ValueBool() {
+		resp.Diagnostics.Append(r.checkAddress(ctx, request, plan.IgnoreSuggestions.ValueBool())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	address, err := r.client.CreateAddress(ctx, request)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error creating address", err)
+		return
+	}
+
+	setAddressState(&plan, address)
+
+	tflog.Info(ctx, "Created Address", map[string]interface{}{"id": address.ID})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// checkAddress validates the address with Telnyx before it is created. An
+// invalid address is an error. Suggested corrections to a valid address are
+// an error as well, so the configuration matches what Telnyx has on file,
+// unless ignoreSuggestions turns them into a warning.
+func (r *AddressResource) checkAddress(ctx context.Context, request telnyx.CreateAddressRequest, ignoreSuggestions bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	configured := telnyx.ValidateAddressRequest{
+		StreetAddress:      request.StreetAddress,
+		ExtendedAddress:    request.ExtendedAddress,
+		Locality:           request.Locality,
+		AdministrativeArea: request.AdministrativeArea,
+		PostalCode:         request.PostalCode,
+		CountryCode:        request.CountryCode,
+	}
+	validation, err := r.client.ValidateAddress(ctx, configured)
+	if err != nil {
+		diags.AddError("Error validating address", "Could not validate address, unexpected error: "+err.Error())
+		return diags
+	}
+
+	corrections := addressCorrections(configured, validation.Suggested)
+	if validation.Result != "valid" {
+		var reasons []string
+		for _, detail := range validation.Errors {
+			reasons = append(reasons, "  - "+detail.String())
+		}
+		message := "Telnyx rejected the address for emergency use:\n" + strings.Join(reasons, "\n")
+		if len(corrections) > 0 {
+			message += "\n\nSuggested corrections:\n" + strings.Join(corrections, "\n")
+		}
+		diags.AddError("Invalid address", message)
+		return diags
+	}
+
+	if len(corrections) == 0 {
+		return diags
+	}
+	message := "Telnyx suggests these corrections to the address:\n" + strings.Join(corrections, "\n")
+	if ignoreSuggestions {
+		diags.AddWarning("Address has suggested corrections", message+"\n\nThe address was created as configured because ignore_suggestions is set.")
+	} else {
+		diags.AddError("Address has suggested corrections", message+"\n\nApply them to the configuration, or set ignore_suggestions to create the address as configured.")
+	}
+	return diags
+}
+
+// addressCorrections lists the fields whose suggested value differs from the
+// configured one, ignoring case and surrounding whitespace.
+func addressCorrections(configured, suggested telnyx.ValidateAddressRequest) []string {
+	fields := []struct {
+		name                  string
+		configured, suggested string
+	}{
+		{"street_address", configured.StreetAddress, suggested.StreetAddress},
+		{"extended_address", configured.ExtendedAddress, suggested.ExtendedAddress},
+		{"locality", configured.Locality, suggested.Locality},
+		{"administrative_area", configured.AdministrativeArea, suggested.AdministrativeArea},
+		{"postal_code", configured.PostalCode, suggested.PostalCode},
+		{"country_code", configured.CountryCode, suggested.CountryCode},
+	}
+	var corrections []string
+	for _, field := range fields {
+		if field.suggested == "" || strings.EqualFold(strings.TrimSpace(field.configured), strings.TrimSpace(field.suggested)) {
+			continue
+		}
+		corrections = append(corrections, fmt.Sprintf("  - %s: %q -> %q", field.name, field.configured, field.suggested))
+	}
+	return corrections
+}
+
+func (r *AddressResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AddressResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	address, err := r.client.GetAddress(ctx, state.ID.ValueString())
+	if err == nil {
+		setAddressState(&state, address)
+		if state.IgnoreSuggestions.IsNull() {
+			state.IgnoreSuggestions = types.BoolValue(false)
+		}
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	if telnyx.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.AddError(
+		"Error reading address",
+		"Could not read address, unexpected error: "+err.Error(),
+	)
+}
+
+// Update only stores ignore_suggestions; every other change replaces the
+// address.
+func (r *AddressResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AddressResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *AddressResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AddressResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAddress(ctx, state.ID.ValueString())
+	if err != nil && !telnyx.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting address",
+			"Could not delete address, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *AddressResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func setAddressState(state *AddressResourceModel, address *telnyx.Address) {
+	state.ID = types.StringValue(address.ID)
+	state.CustomerReference = types.StringValue(address.CustomerReference)
+	state.FirstName = types.StringValue(address.FirstName)
+	state.LastName = types.StringValue(address.LastName)
+	state.BusinessName = types.StringValue(address.BusinessName)
+	state.PhoneNumber = types.StringValue(address.PhoneNumber)
+	state.StreetAddress = types.StringValue(address.StreetAddress)
+	state.ExtendedAddress = types.StringValue(address.ExtendedAddress)
+	state.Locality = types.StringValue(address.Locality)
+	state.AdministrativeArea = types.StringValue(address.AdministrativeArea)
+	state.Neighborhood = types.StringValue(address.Neighborhood)
+	state.Borough = types.StringValue(address.Borough)
+	state.PostalCode = types.StringValue(address.PostalCode)
+	state.CountryCode = types.StringValue(address.CountryCode)
+	state.AddressBook = types.BoolValue(address.AddressBook)
+	state.ValidateAddress = types.BoolValue(address.ValidateAddress)
+	state.CreatedAt = types.StringValue(address.CreatedAt.String())
+}
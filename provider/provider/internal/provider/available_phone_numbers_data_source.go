@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
+)
+
+var (
+	_ datasource.DataSource                   = &AvailablePhoneNumbersDataSource{}
+	_ datasource.DataSourceWithConfigure      = &AvailablePhoneNumbersDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &AvailablePhoneNumbersDataSource{}
+)
+
+func NewAvailablePhoneNumbersDataSource() datasource.DataSource {
+	return &AvailablePhoneNumbersDataSource{}
+}
+
+type AvailablePhoneNumbersDataSource struct {
+	client *telnyx.TelnyxClient
+}
+
+type AvailablePhoneNumbersDataSourceModel struct {
+	StartsWith              types.String `tfsdk:"starts_with"`
+	EndsWith                types.String `tfsdk:"ends_with"`
+	Contains                types.String `tfsdk:"contains"`
+	Locality                types.String `tfsdk:"locality"`
+	AdministrativeArea      types.String `tfsdk:"administrative_area"`
+	CountryCode             types.String `tfsdk:"country_code"`
+	NationalDestinationCode types.String `tfsdk:"national_destination_code"`
+	RateCenter              types.String `tfsdk:"rate_center"`
+	PhoneNumberType         types.String `tfsdk:"phone_number_type"`
+	Features                types.List   `tfsdk:"features"`
+	Limit                   types.Int64  `tfsdk:"limit"`
+	BestEffort              types.Bool   `tfsdk:"best_effort"`
+	Quickship               types.Bool   `tfsdk:"quickship"`
+	Reservable              types.Bool   `tfsdk:"reservable"`
+	ExcludeHeldNumbers      types.Bool   `tfsdk:"exclude_held_numbers"`
+	PhoneNumbers            types.List   `tfsdk:"phone_numbers"`
+	TotalResults            types.Int64  `tfsdk:"total_results"`
+	BestEffortResults       types.Int64  `tfsdk:"best_effort_results"`
+}
+
+var regionInformationType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"region_type": types.StringType,
+	"region_name": types.StringType,
+}}
+
+var availablePhoneNumberType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"phone_number":       types.StringType,
+	"vanity_format":      types.StringType,
+	"best_effort":        types.BoolType,
+	"quickship":          types.BoolType,
+	"reservable":         types.BoolType,
+	"upfront_cost":       types.StringType,
+	"monthly_cost":       types.StringType,
+	"currency":           types.StringType,
+	"features":           types.ListType{ElemType: types.StringType},
+	"region_information": types.ListType{ElemType: regionInformationType},
+}}
+
+func (d *AvailablePhoneNumbersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_available_phone_numbers"
+}
+
+func (d *AvailablePhoneNumbersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Searches the phone numbers Telnyx has available to order. Results are searched again on every plan, so pin the numbers you order rather than ordering whatever the search returns first.",
+		Attributes: map[string]schema.Attribute{
+			"starts_with": schema.StringAttribute{
+				Description: "Only return numbers whose national part starts with these digits",
+				Optional:    true,
+			},
+			"ends_with": schema.StringAttribute{
+				Description: "Only return numbers that end with these digits",
+				Optional:    true,
+			},
+			"contains": schema.StringAttribute{
+				Description: "Only return numbers that contain these digits",
+				Optional:    true,
+			},
+			"locality": schema.StringAttribute{
+				Description: "City or town the numbers belong to",
+				Optional:    true,
+			},
+			"administrative_area": schema.StringAttribute{
+				Description: "State or province the numbers belong to, e.g. IL",
+				Optional:    true,
+			},
+			"country_code": schema.StringAttribute{
+				Description: "ISO 3166-1 alpha-2 country code of the numbers, e.g. US",
+				Optional:    true,
+			},
+			"national_destination_code": schema.StringAttribute{
+				Description: "National destination code, or area code, of the numbers",
+				Optional:    true,
+			},
+			"rate_center": schema.StringAttribute{
+				Description: "Rate center the numbers belong to",
+				Optional:    true,
+			},
+			"phone_number_type": schema.StringAttribute{
+				Description: "Type of number, e.g. local, toll_free, mobile or national",
+				Optional:    true,
+			},
+			"features": schema.ListAttribute{
+				Description: "Features every returned number must support, e.g. sms, mms, voice, fax or emergency",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "Maximum number of results to return",
+				Optional:    true,
+			},
+			"best_effort": schema.BoolAttribute{
+				Description: "Also return numbers that only approximately match the filters when there are too few exact matches",
+				Optional:    true,
+			},
+			"quickship": schema.BoolAttribute{
+				Description: "Only return numbers that are activated immediately when ordered",
+				Optional:    true,
+			},
+			"reservable": schema.BoolAttribute{
+				Description: "Only return numbers that can be reserved",
+				Optional:    true,
+			},
+			"exclude_held_numbers": schema.BoolAttribute{
+				Description: "Leave out numbers held by other customers",
+				Optional:    true,
+			},
+			"phone_numbers": schema.ListNestedAttribute{
+				Description: "Numbers matching the filters",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"phone_number": schema.StringAttribute{
+							Description: "Number in E.164 format",
+							Computed:    true,
+						},
+						"vanity_format": schema.StringAttribute{
+							Description: "Number spelled out with letters, when it has a vanity format",
+							Computed:    true,
+						},
+						"best_effort": schema.BoolAttribute{
+							Description: "Whether the number only approximately matches the filters",
+							Computed:    true,
+						},
+						"quickship": schema.BoolAttribute{
+							Description: "Whether the number is activated immediately when ordered",
+							Computed:    true,
+						},
+						"reservable": schema.BoolAttribute{
+							Description: "Whether the number can be reserved",
+							Computed:    true,
+						},
+						"upfront_cost": schema.StringAttribute{
+							Description: "One-off cost of ordering the number",
+							Computed:    true,
+						},
+						"monthly_cost": schema.StringAttribute{
+							Description: "Monthly cost of the number",
+							Computed:    true,
+						},
+						"currency": schema.StringAttribute{
+							Description: "Currency of the costs",
+							Computed:    true,
+						},
+						"features": schema.ListAttribute{
+							Description: "Features the number supports, sorted by name",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"region_information": schema.ListNestedAttribute{
+							Description: "Regions the number belongs to",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"region_type": schema.StringAttribute{
+										Description: "Kind of region, e.g. country_code, rate_center or state",
+										Computed:    true,
+									},
+									"region_name": schema.StringAttribute{
+										Description: "Name of the region",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"total_results": schema.Int64Attribute{
+				Description: "Number of results Telnyx found",
+				Computed:    true,
+			},
+			"best_effort_results": schema.Int64Attribute{
+				Description: "How many of the results only approximately match the filters",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *AvailablePhoneNumbersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = dataSourceClient(ctx, req, resp, "AvailablePhoneNumbersDataSource")
+}
+
+func (d *AvailablePhoneNumbersDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var limit types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("limit"), &limit)...)
+	if !limit.IsNull() && !limit.IsUnknown() && limit.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("limit"), "Invalid limit", "limit must be at least 1.")
+	}
+}
+
+func (d *AvailablePhoneNumbersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state AvailablePhoneNumbersDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := telnyx.AvailablePhoneNumbersRequest{
+		StartsWith:              state.StartsWith.ValueString(),
+		EndsWith:                state.EndsWith.ValueString(),
+		Contains:                state.Contains.ValueString(),
+		Locality:                state.Locality.ValueString(),
+		AdministrativeArea:      state.AdministrativeArea.ValueString(),
+		CountryCode:             state.CountryCode.ValueString(),
+		NationalDestinationCode: state.NationalDestinationCode.ValueString(),
+		RateCenter:              state.RateCenter.ValueString(),
+		PhoneNumberType:         state.PhoneNumberType.ValueString(),
+		Limit:                   int(state.Limit.ValueInt64()),
+		BestEffort:              state.BestEffort.ValueBool(),
+		Quickship:               state.Quickship.ValueBool(),
+		Reservable:              state.Reservable.ValueBool(),
+		ExcludeHeldNumbers:      state.ExcludeHeldNumbers.ValueBool(),
+	}
+	if !state.Features.IsNull() {
+		resp.Diagnostics.Append(state.Features.ElementsAs(ctx, &filters.Features, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response, err := d.client.ListAvailablePhoneNumbers(ctx, filters)
+	if err != nil {
+		resp.Diagnostics.AddError("Error searching available phone numbers", err.Error())
+		return
+	}
+
+	state.PhoneNumbers = availablePhoneNumbersList(response.Data)
+	state.TotalResults = types.Int64Value(int64(response.Meta.TotalResults))
+	state.BestEffortResults = types.Int64Value(int64(response.Meta.BestEffortResults))
+
+	tflog.Info(ctx, "Searched available phone numbers", map[string]interface{}{"results": len(response.Data)})
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func availablePhoneNumbersList(phoneNumbers []telnyx.AvailablePhoneNumber) types.List {
+	values := make([]attr.Value, len(phoneNumbers))
+	for i, phoneNumber := range phoneNumbers {
+		featureNames := make([]string, len(phoneNumber.Features))
+		for j, feature := range phoneNumber.Features {
+			featureNames[j] = feature.Name
+		}
+		sort.Strings(featureNames)
+
+		regions := make([]attr.Value, len(phoneNumber.RegionInformation))
+		for j, region := range phoneNumber.RegionInformation {
+			regions[j] = types.ObjectValueMust(regionInformationType.AttrTypes, map[string]attr.Value{
+				"region_type": types.StringValue(region.RegionType),
+				"region_name": types.StringValue(region.RegionName),
+			})
+		}
+
+		values[i] = types.ObjectValueMust(availablePhoneNumberType.AttrTypes, map[string]attr.Value{
+			"phone_number":       types.StringValue(phoneNumber.PhoneNumber),
+			"vanity_format":      types.StringValue(phoneNumber.VanityFormat),
+			"best_effort":        types.BoolValue(phoneNumber.BestEffort),
+			"quickship":          types.BoolValue(phoneNumber.Quickship),
+			"reservable":         types.BoolValue(phoneNumber.Reservable),
+			"upfront_cost":       types.StringValue(phoneNumber.CostInformation.UpfrontCost),
+			"monthly_cost":       types.StringValue(phoneNumber.CostInformation.MonthlyCost),
+			"currency":           types.StringValue(phoneNumber.CostInformation.Currency),
+			"features":           convertStringsToList(featureNames),
+			"region_information": types.ListValueMust(regionInformationType, regions),
+		})
+	}
+	return types.ListValueMust(availablePhoneNumberType, values)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,7 +16,8 @@ import (
 )
 
 var (
-	_ resource.Resource = &BillingGroupResource{}
+	_ resource.Resource                = &BillingGroupResource{}
+	_ resource.ResourceWithImportState = &BillingGroupResource{}
 )
 
 func NewBillingGroupResource() resource.Resource {
@@ -77,9 +79,9 @@ func (r *BillingGroupResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	group, err := r.client.CreateBillingGroup(plan.Name.ValueString())
+	group, err := r.client.CreateBillingGroup(ctx, plan.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating billing group", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error creating billing group", err)
 		return
 	}
 
@@ -100,13 +102,13 @@ func (r *BillingGroupResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	group, err := r.client.GetBillingGroup(state.ID.ValueString())
+	group, err := r.client.GetBillingGroup(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading billing group", err.Error())
 		return
 	}
 
-	state.Name = types.StringValue(group.Name)
+	setBillingGroupState(&state, group)
 
 	tflog.Info(ctx, "Read Billing Group", map[string]interface{}{"id": group.ID, "name": group.Name})
 
@@ -122,9 +124,9 @@ func (r *BillingGroupResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
-	_, err := r.client.UpdateBillingGroup(plan.ID.ValueString(), plan.Name.ValueString())
+	_, err := r.client.UpdateBillingGroup(ctx, plan.ID.ValueString(), plan.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating billing group", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error updating billing group", err)
 		return
 	}
 
@@ -142,7 +144,7 @@ func (r *BillingGroupResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	err := r.client.DeleteBillingGroup(state.ID.ValueString())
+	err := r.client.DeleteBillingGroup(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting billing group", err.Error())
 	}
@@ -154,3 +156,18 @@ func (r *BillingGroupResource) Delete(ctx context.Context, req resource.DeleteRe
 func (r *BillingGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func setBillingGroupState(state *BillingGroupResourceModel, group *telnyx.BillingGroup) {
+	state.ID = types.StringValue(group.ID)
+	state.Name = types.StringValue(group.Name)
+}
+
+// findBillingGroup returns the billing group with the given ID or, when id is null,
+// the one named name.
+func findBillingGroup(ctx context.Context, client *telnyx.TelnyxClient, id, name types.String, diags *diag.Diagnostics) *telnyx.BillingGroup {
+	return lookupByIDOrName(ctx, "billing group", "name", id, name,
+		client.GetBillingGroup, client.ListBillingGroups, "",
+		func(record telnyx.BillingGroup) (string, string) { return record.ID, record.Name },
+		diags,
+	)
+}
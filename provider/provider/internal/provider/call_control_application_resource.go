@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -19,8 +20,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &CallControlApplicationResource{}
-	_ resource.ResourceWithConfigure = &CallControlApplicationResource{}
+	_ resource.Resource                = &CallControlApplicationResource{}
+	_ resource.ResourceWithConfigure   = &CallControlApplicationResource{}
+	_ resource.ResourceWithImportState = &CallControlApplicationResource{}
 )
 
 func NewCallControlApplicationResource() resource.Resource {
@@ -55,7 +57,7 @@ func (r *CallControlApplicationResource) Metadata(ctx context.Context, req resou
 
 func (r *CallControlApplicationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Resource for managing Telnyx Call Control Applications",
+		Description: "Resource for managing Telnyx Call Control Applications. Import with the application's ID or its exact application_name.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Unique identifier of the Call Control Application",
@@ -241,9 +243,9 @@ func (r *CallControlApplicationResource) Create(ctx context.Context, req resourc
 			OutboundVoiceProfileID: outboundAttributes["outbound_voice_profile_id"].(types.String).ValueString(),
 		},
 	}
-	app, err := r.client.CreateCallControlApplication(request)
+	app, err := r.client.CreateCallControlApplication(ctx, request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Call Control Application", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error creating Call Control Application", err)
 		return
 	}
 	setStateResponse(&plan, app)
@@ -258,14 +260,14 @@ func (r *CallControlApplicationResource) Read(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	app, err := r.client.GetCallControlApplication(state.ID.ValueString())
+	app, err := r.client.GetCallControlApplication(ctx, state.ID.ValueString())
 	if err == nil {
 		setStateResponse(&state, app)
 		diags = resp.State.Set(ctx, &state)
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-	if telnyxErr, ok := err.(*telnyx.TelnyxError); ok && telnyxErr.IsResourceNotFound() {
+	if telnyx.IsNotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -305,9 +307,9 @@ func (r *CallControlApplicationResource) Update(ctx context.Context, req resourc
 			OutboundVoiceProfileID: outboundAttributes["outbound_voice_profile_id"].(types.String).ValueString(),
 		},
 	}
-	app, err := r.client.UpdateCallControlApplication(plan.ID.ValueString(), request)
+	app, err := r.client.UpdateCallControlApplication(ctx, plan.ID.ValueString(), request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Call Control Application", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error updating Call Control Application", err)
 		return
 	}
 	setStateResponse(&plan, app)
@@ -322,18 +324,27 @@ func (r *CallControlApplicationResource) Delete(ctx context.Context, req resourc
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	err := r.client.DeleteCallControlApplication(state.ID.ValueString())
+	err := r.client.DeleteCallControlApplication(ctx, state.ID.ValueString())
 	if err == nil {
 		resp.State.RemoveResource(ctx)
 		return
 	}
-	if telnyxErr, ok := err.(*telnyx.TelnyxError); ok && telnyxErr.IsResourceNotFound() {
+	if telnyx.IsNotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 	resp.Diagnostics.AddError("Error deleting Call Control Application", err.Error())
 }
 
+func (r *CallControlApplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importByName(ctx, req, resp, func(id, name types.String) string {
+		if application := findCallControlApplication(ctx, r.client, id, name, &resp.Diagnostics); application != nil {
+			return application.ID
+		}
+		return ""
+	})
+}
+
 func flattenInboundSettings(inbound telnyx.CallControlInboundSettings) types.Object {
 	obj, _ := types.ObjectValue(map[string]attr.Type{
 		"channel_limit":                  types.Int64Type,
@@ -392,3 +403,13 @@ func setStateResponse(state *CallControlApplicationResourceModel, application *t
 	state.CreatedAt = types.StringValue(application.CreatedAt.String())
 	state.UpdatedAt = types.StringValue(application.UpdatedAt.String())
 }
+
+// findCallControlApplication returns the call control application with the
+// given ID or, when id is null, the one with exactly the given name.
+func findCallControlApplication(ctx context.Context, client *telnyx.TelnyxClient, id, name types.String, diags *diag.Diagnostics) *telnyx.CallControlApplication {
+	return lookupByIDOrName(ctx, "call control application", "application_name", id, name,
+		client.GetCallControlApplication, client.ListCallControlApplications, "filter[application_name][contains]",
+		func(record telnyx.CallControlApplication) (string, string) { return record.ID, record.ApplicationName },
+		diags,
+	)
+}
@@ -0,0 +1,408 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
+)
+
+// The rtcp_settings, inbound and outbound attributes are shared by the SIP
+// connection resources, such as telnyx_fqdn_connection and
+// telnyx_ip_connection, which Telnyx configures with the same settings.
+
+func rtcpSettingsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"port":                  types.StringType,
+		"capture_enabled":       types.BoolType,
+		"report_frequency_secs": types.Int64Type,
+	}
+}
+
+func inboundSettingsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"ani_number_format":              types.StringType,
+		"dnis_number_format":             types.StringType,
+		"codecs":                         types.ListType{ElemType: types.StringType},
+		"default_routing_method":         types.StringType,
+		"channel_limit":                  types.Int64Type,
+		"generate_ringback_tone":         types.BoolType,
+		"isup_headers_enabled":           types.BoolType,
+		"prack_enabled":                  types.BoolType,
+		"privacy_zone_enabled":           types.BoolType,
+		"sip_compact_headers_enabled":    types.BoolType,
+		"sip_region":                     types.StringType,
+		"sip_subdomain":                  types.StringType,
+		"sip_subdomain_receive_settings": types.StringType,
+		"timeout_1xx_secs":               types.Int64Type,
+		"timeout_2xx_secs":               types.Int64Type,
+		"shaken_stir_enabled":            types.BoolType,
+	}
+}
+
+func outboundSettingsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"ani_override":              types.StringType,
+		"ani_override_type":         types.StringType,
+		"call_parking_enabled":      types.BoolType,
+		"channel_limit":             types.Int64Type,
+		"generate_ringback_tone":    types.BoolType,
+		"instant_ringback_enabled":  types.BoolType,
+		"ip_authentication_method":  types.StringType,
+		"ip_authentication_token":   types.StringType,
+		"localization":              types.StringType,
+		"outbound_voice_profile_id": types.StringType,
+		"t38_reinvite_source":       types.StringType,
+	}
+}
+
+// rtcpSettingsSchema is the rtcp_settings attribute shared by SIP connections.
+func rtcpSettingsSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "RTCP settings",
+		Optional:    true,
+		Computed:    true,
+		Default: objectdefault.StaticValue(types.ObjectValueMust(
+			rtcpSettingsAttrTypes(),
+			map[string]attr.Value{
+				"port":                  types.StringValue("rtp+1"),
+				"capture_enabled":       types.BoolValue(false),
+				"report_frequency_secs": types.Int64Value(5),
+			},
+		)),
+		Attributes: map[string]schema.Attribute{
+			"port": schema.StringAttribute{
+				Description: "Port for RTCP",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("rtp+1"),
+			},
+			"capture_enabled": schema.BoolAttribute{
+				Description: "Capture enabled for RTCP",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"report_frequency_secs": schema.Int64Attribute{
+				Description: "Report frequency for RTCP in seconds",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(5),
+			},
+		},
+	}
+}
+
+// inboundSettingsSchema is the inbound attribute shared by SIP connections.
+func inboundSettingsSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Inbound settings",
+		Optional:    true,
+		Computed:    true,
+		Default: objectdefault.StaticValue(types.ObjectValueMust(
+			inboundSettingsAttrTypes(),
+			map[string]attr.Value{
+				"ani_number_format":              types.StringValue("E.164-national"),
+				"dnis_number_format":             types.StringValue("e164"),
+				"codecs":                         types.ListValueMust(types.StringType, []attr.Value{types.StringValue("G722"), types.StringValue("G711U"), types.StringValue("G711A"), types.StringValue("G729"), types.StringValue("OPUS"), types.StringValue("H.264")}),
+				"default_routing_method":         types.StringValue("sequential"),
+				"channel_limit":                  types.Int64Null(),
+				"generate_ringback_tone":         types.BoolNull(),
+				"isup_headers_enabled":           types.BoolNull(),
+				"prack_enabled":                  types.BoolNull(),
+				"privacy_zone_enabled":           types.BoolNull(),
+				"sip_compact_headers_enabled":    types.BoolNull(),
+				"sip_region":                     types.StringValue("US"),
+				"sip_subdomain_receive_settings": types.StringValue(""),
+				"sip_subdomain":                  types.StringValue(""),
+				"timeout_1xx_secs":               types.Int64Null(),
+				"timeout_2xx_secs":               types.Int64Null(),
+				"shaken_stir_enabled":            types.BoolNull(),
+			},
+		)),
+		Attributes: map[string]schema.Attribute{
+			"ani_number_format": schema.StringAttribute{
+				Description: "ANI number format",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("E.164-national"),
+			},
+			"dnis_number_format": schema.StringAttribute{
+				Description: "DNIS number format",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("e164"),
+			},
+			"codecs": schema.ListAttribute{
+				Description: "List of codecs",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("G722"), types.StringValue("G711U"), types.StringValue("G711A"), types.StringValue("G729"), types.StringValue("OPUS"), types.StringValue("H.264")})),
+			},
+			"default_routing_method": schema.StringAttribute{
+				Description: "Default routing method",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("sequential"),
+			},
+			"channel_limit": schema.Int64Attribute{
+				Description: "Channel limit",
+				Optional:    true,
+				Computed:    true,
+			},
+			"generate_ringback_tone": schema.BoolAttribute{
+				Description: "Generate ringback tone",
+				Optional:    true,
+				Computed:    true,
+			},
+			"isup_headers_enabled": schema.BoolAttribute{
+				Description: "ISUP headers enabled",
+				Optional:    true,
+				Computed:    true,
+			},
+			"prack_enabled": schema.BoolAttribute{
+				Description: "PRACK enabled",
+				Optional:    true,
+				Computed:    true,
+			},
+			"privacy_zone_enabled": schema.BoolAttribute{
+				Description: "Privacy zone enabled",
+				Optional:    true,
+				Computed:    true,
+			},
+			"sip_compact_headers_enabled": schema.BoolAttribute{
+				Description: "SIP compact headers enabled",
+				Optional:    true,
+				Computed:    true,
+			},
+			"sip_region": schema.StringAttribute{
+				Description: "SIP region",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("US"),
+			},
+			"sip_subdomain": schema.StringAttribute{
+				Description: "SIP subdomain",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"sip_subdomain_receive_settings": schema.StringAttribute{
+				Description: "SIP subdomain receive settings",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("only_my_connections"),
+			},
+			"timeout_1xx_secs": schema.Int64Attribute{
+				Description: "Timeout for 1xx responses in seconds",
+				Optional:    true,
+				Computed:    true,
+			},
+			"timeout_2xx_secs": schema.Int64Attribute{
+				Description: "Timeout for 2xx responses in seconds",
+				Optional:    true,
+				Computed:    true,
+			},
+			"shaken_stir_enabled": schema.BoolAttribute{
+				Description: "SHAKEN/STIR enabled",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// outboundSettingsSchema is the outbound attribute shared by SIP connections.
+func outboundSettingsSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Outbound settings",
+		Optional:    true,
+		Computed:    true,
+		Default: objectdefault.StaticValue(types.ObjectValueMust(
+			outboundSettingsAttrTypes(),
+			map[string]attr.Value{
+				"ani_override":              types.StringValue(""),
+				"ani_override_type":         types.StringValue("always"),
+				"call_parking_enabled":      types.BoolValue(false),
+				"channel_limit":             types.Int64Null(),
+				"generate_ringback_tone":    types.BoolNull(),
+				"instant_ringback_enabled":  types.BoolNull(),
+				"ip_authentication_method":  types.StringValue("token"),
+				"ip_authentication_token":   types.StringNull(),
+				"localization":              types.StringValue("US"),
+				"outbound_voice_profile_id": types.StringValue(""),
+				"t38_reinvite_source":       types.StringValue("customer"),
+			},
+		)),
+		Attributes: map[string]schema.Attribute{
+			"ani_override": schema.StringAttribute{
+				Description: "ANI override",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"ani_override_type": schema.StringAttribute{
+				Description: "ANI override type",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("always"),
+			},
+			"call_parking_enabled": schema.BoolAttribute{
+				Description: "Call parking enabled",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"channel_limit": schema.Int64Attribute{
+				Description: "Channel limit",
+				Optional:    true,
+				Computed:    true,
+			},
+			"generate_ringback_tone": schema.BoolAttribute{
+				Description: "Generate ringback tone",
+				Optional:    true,
+				Computed:    true,
+			},
+			"instant_ringback_enabled": schema.BoolAttribute{
+				Description: "Instant ringback enabled",
+				Optional:    true,
+				Computed:    true,
+			},
+			"ip_authentication_method": schema.StringAttribute{
+				Description: "IP authentication method",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("token"),
+			},
+			"ip_authentication_token": schema.StringAttribute{
+				Description: "IP authentication token",
+				Optional:    true,
+				Computed:    true,
+			},
+			"localization": schema.StringAttribute{
+				Description: "Localization",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("US"),
+			},
+			"outbound_voice_profile_id": schema.StringAttribute{
+				Description: "Outbound voice profile ID",
+				Optional:    true,
+			},
+			"t38_reinvite_source": schema.StringAttribute{
+				Description: "T38 reinvite source",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("customer"),
+			},
+		},
+	}
+}
+
+func buildRTCPSettings(rtcpSettings types.Object) telnyx.RTCPSettings {
+	attributes := rtcpSettings.Attributes()
+	return telnyx.RTCPSettings{
+		Port:                attributes["port"].(types.String).ValueString(),
+		CaptureEnabled:      attributes["capture_enabled"].(types.Bool).ValueBool(),
+		ReportFrequencySecs: int(attributes["report_frequency_secs"].(types.Int64).ValueInt64()),
+	}
+}
+
+func buildInboundSettings(ctx context.Context, inbound types.Object) (telnyx.InboundSettings, diag.Diagnostics) {
+	attributes := inbound.Attributes()
+	codecs, diags := convertListToStrings(ctx, attributes["codecs"].(types.List))
+	return telnyx.InboundSettings{
+		ANINumberFormat:             attributes["ani_number_format"].(types.String).ValueString(),
+		DNISNumberFormat:            attributes["dnis_number_format"].(types.String).ValueString(),
+		Codecs:                      codecs,
+		DefaultRoutingMethod:        attributes["default_routing_method"].(types.String).ValueString(),
+		ChannelLimit:                getIntPointer(attributes["channel_limit"].(types.Int64)),
+		GenerateRingbackTone:        getBoolPointer(attributes["generate_ringback_tone"].(types.Bool)),
+		ISUPHeadersEnabled:          getBoolPointer(attributes["isup_headers_enabled"].(types.Bool)),
+		PRACKEnabled:                getBoolPointer(attributes["prack_enabled"].(types.Bool)),
+		PrivacyZoneEnabled:          getBoolPointer(attributes["privacy_zone_enabled"].(types.Bool)),
+		SIPCompactHeadersEnabled:    getBoolPointer(attributes["sip_compact_headers_enabled"].(types.Bool)),
+		SIPRegion:                   attributes["sip_region"].(types.String).ValueString(),
+		SIPSubdomain:                attributes["sip_subdomain"].(types.String).ValueString(),
+		SIPSubdomainReceiveSettings: attributes["sip_subdomain_receive_settings"].(types.String).ValueString(),
+		Timeout1xxSecs:              getIntPointer(attributes["timeout_1xx_secs"].(types.Int64)),
+		Timeout2xxSecs:              getIntPointer(attributes["timeout_2xx_secs"].(types.Int64)),
+		ShakenSTIREnabled:           getBoolPointer(attributes["shaken_stir_enabled"].(types.Bool)),
+	}, diags
+}
+
+func buildOutboundSettings(outbound types.Object) telnyx.OutboundSettings {
+	attributes := outbound.Attributes()
+	return telnyx.OutboundSettings{
+		ANIOverride:            attributes["ani_override"].(types.String).ValueString(),
+		ANIOverrideType:        attributes["ani_override_type"].(types.String).ValueString(),
+		CallParkingEnabled:     getBoolPointer(attributes["call_parking_enabled"].(types.Bool)),
+		ChannelLimit:           getIntPointer(attributes["channel_limit"].(types.Int64)),
+		GenerateRingbackTone:   getBoolPointer(attributes["generate_ringback_tone"].(types.Bool)),
+		InstantRingbackEnabled: getBoolPointer(attributes["instant_ringback_enabled"].(types.Bool)),
+		IPAuthenticationMethod: attributes["ip_authentication_method"].(types.String).ValueString(),
+		IPAuthenticationToken:  getStringPointer(attributes["ip_authentication_token"].(types.String)),
+		Localization:           attributes["localization"].(types.String).ValueString(),
+		OutboundVoiceProfileID: attributes["outbound_voice_profile_id"].(types.String).ValueString(),
+		T38ReinviteSource:      attributes["t38_reinvite_source"].(types.String).ValueString(),
+	}
+}
+
+// rtcpSettingsValue converts RTCP settings to state, leaving them null when
+// Telnyx did not return any.
+func rtcpSettingsValue(rtcpSettings telnyx.RTCPSettings) types.Object {
+	if rtcpSettings == (telnyx.RTCPSettings{}) {
+		return types.ObjectNull(rtcpSettingsAttrTypes())
+	}
+	return types.ObjectValueMust(rtcpSettingsAttrTypes(), map[string]attr.Value{
+		"port":                  types.StringValue(rtcpSettings.Port),
+		"capture_enabled":       types.BoolValue(rtcpSettings.CaptureEnabled),
+		"report_frequency_secs": types.Int64Value(int64(rtcpSettings.ReportFrequencySecs)),
+	})
+}
+
+func inboundSettingsValue(inbound telnyx.InboundSettings) types.Object {
+	return types.ObjectValueMust(inboundSettingsAttrTypes(), map[string]attr.Value{
+		"ani_number_format":              types.StringValue(inbound.ANINumberFormat),
+		"dnis_number_format":             types.StringValue(inbound.DNISNumberFormat),
+		"codecs":                         convertStringsToList(inbound.Codecs),
+		"default_routing_method":         types.StringValue(inbound.DefaultRoutingMethod),
+		"channel_limit":                  types.Int64Value(getInt64(inbound.ChannelLimit)),
+		"generate_ringback_tone":         types.BoolValue(getBool(inbound.GenerateRingbackTone)),
+		"isup_headers_enabled":           types.BoolValue(getBool(inbound.ISUPHeadersEnabled)),
+		"prack_enabled":                  types.BoolValue(getBool(inbound.PRACKEnabled)),
+		"privacy_zone_enabled":           types.BoolValue(getBool(inbound.PrivacyZoneEnabled)),
+		"sip_compact_headers_enabled":    types.BoolValue(getBool(inbound.SIPCompactHeadersEnabled)),
+		"sip_region":                     types.StringValue(inbound.SIPRegion),
+		"sip_subdomain":                  types.StringValue(inbound.SIPSubdomain),
+		"sip_subdomain_receive_settings": types.StringValue(inbound.SIPSubdomainReceiveSettings),
+		"timeout_1xx_secs":               types.Int64Value(getInt64(inbound.Timeout1xxSecs)),
+		"timeout_2xx_secs":               types.Int64Value(getInt64(inbound.Timeout2xxSecs)),
+		"shaken_stir_enabled":            types.BoolValue(getBool(inbound.ShakenSTIREnabled)),
+	})
+}
+
+func outboundSettingsValue(outbound telnyx.OutboundSettings) types.Object {
+	return types.ObjectValueMust(outboundSettingsAttrTypes(), map[string]attr.Value{
+		"ani_override":              types.StringValue(outbound.ANIOverride),
+		"ani_override_type":         types.StringValue(outbound.ANIOverrideType),
+		"call_parking_enabled":      types.BoolValue(getBool(outbound.CallParkingEnabled)),
+		"channel_limit":             types.Int64Value(getInt64(outbound.ChannelLimit)),
+		"generate_ringback_tone":    types.BoolValue(getBool(outbound.GenerateRingbackTone)),
+		"instant_ringback_enabled":  types.BoolValue(getBool(outbound.InstantRingbackEnabled)),
+		"ip_authentication_method":  types.StringValue(outbound.IPAuthenticationMethod),
+		"ip_authentication_token":   types.StringValue(getString(outbound.IPAuthenticationToken)),
+		"localization":              types.StringValue(outbound.Localization),
+		"outbound_voice_profile_id": types.StringValue(outbound.OutboundVoiceProfileID),
+		"t38_reinvite_source":       types.StringValue(outbound.T38ReinviteSource),
+	})
+}
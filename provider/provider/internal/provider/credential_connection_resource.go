@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
@@ -20,8 +21,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &CredentialConnectionResource{}
-	_ resource.ResourceWithConfigure = &CredentialConnectionResource{}
+	_ resource.Resource                = &CredentialConnectionResource{}
+	_ resource.ResourceWithConfigure   = &CredentialConnectionResource{}
+	_ resource.ResourceWithImportState = &CredentialConnectionResource{}
 )
 
 func NewCredentialConnectionResource() resource.Resource {
@@ -460,9 +462,9 @@ func (r *CredentialConnectionResource) Create(ctx context.Context, req resource.
 		},
 	}
 
-	createdConnection, err := r.client.CreateCredentialConnection(connection)
+	createdConnection, err := r.client.CreateCredentialConnection(ctx, connection)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating credential connection", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error creating credential connection", err)
 		return
 	}
 
@@ -486,7 +488,7 @@ func (r *CredentialConnectionResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
-	connection, err := r.client.GetCredentialConnection(state.ID.ValueString())
+	connection, err := r.client.GetCredentialConnection(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading credential connection", err.Error())
 		return
@@ -568,12 +570,9 @@ func (r *CredentialConnectionResource) Update(ctx context.Context, req resource.
 	}
 
 	// Use state ID in update call
-	updatedConnection, err := r.client.UpdateCredentialConnection(state.ID.ValueString(), connection)
+	updatedConnection, err := r.client.UpdateCredentialConnection(ctx, state.ID.ValueString(), connection)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating credential connection",
-			"Could not update credential connection, unexpected error: "+err.Error(),
-		)
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error updating credential connection", err)
 		return
 	}
 
@@ -591,7 +590,7 @@ func (r *CredentialConnectionResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
-	err := r.client.DeleteCredentialConnection(state.ID.ValueString())
+	err := r.client.DeleteCredentialConnection(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting credential connection", err.Error())
 	}
@@ -699,3 +698,13 @@ func setCredentialConnectionState(ctx context.Context, state *CredentialConnecti
 		},
 	)
 }
+
+// findCredentialConnection returns the credential connection with the given ID or, when id is null,
+// the one with exactly the given connection name.
+func findCredentialConnection(ctx context.Context, client *telnyx.TelnyxClient, id, name types.String, diags *diag.Diagnostics) *telnyx.CredentialConnection {
+	return lookupByIDOrName(ctx, "credential connection", "connection_name", id, name,
+		client.GetCredentialConnection, client.ListCredentialConnections, "filter[connection_name][contains]",
+		func(record telnyx.CredentialConnection) (string, string) { return record.ID, record.ConnectionName },
+		diags,
+	)
+}
@@ -0,0 +1,375 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
+)
+
+// lookupSchema builds the schema of a data source that reads an existing
+// record of the given resource type. Every attribute of the resource is
+// exposed read-only, so the data source shares the resource's model and
+// state functions; only id and the name attribute can be set, and exactly
+// one of them must be.
+func lookupSchema(ctx context.Context, description string, r resource.Resource, nameAttribute string, diags *diag.Diagnostics) dschema.Schema {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+	diags.Append(resp.Diagnostics...)
+
+	attributes := computedAttributes("", resp.Schema.Attributes, resp.Schema.Blocks, diags)
+	attributes["id"] = dschema.StringAttribute{
+		Description: fmt.Sprintf("ID of the record to look up. Conflicts with %s.", nameAttribute),
+		Optional:    true,
+		Computed:    true,
+	}
+	nameSchema := resp.Schema.Attributes[nameAttribute]
+	attributes[nameAttribute] = dschema.StringAttribute{
+		Description: fmt.Sprintf("%s. Looks the record up by exact name when id is not set; the name must be unique.", nameSchema.GetDescription()),
+		Optional:    true,
+		Computed:    true,
+	}
+	return dschema.Schema{Description: description, Attributes: attributes}
+}
+
+// computedAttributes converts resource attributes to computed data source
+// attributes, keeping their descriptions, sensitivity and custom types.
+// Blocks cannot be computed, so they become the matching nested attributes.
+// An attribute kind this function does not know is reported as an error
+// naming it by its dotted path below parent.
+func computedAttributes(parent string, attributes map[string]rschema.Attribute, blocks map[string]rschema.Block, diags *diag.Diagnostics) map[string]dschema.Attribute {
+	converted := make(map[string]dschema.Attribute, len(attributes)+len(blocks))
+	for name, attribute := range attributes {
+		attributeName := strings.TrimPrefix(parent+"."+name, ".")
+		description, sensitive := attribute.GetDescription(), attribute.IsSensitive()
+		switch a := attribute.(type) {
+		case rschema.StringAttribute:
+			converted[name] = dschema.StringAttribute{Description: description, Computed: true, Sensitive: sensitive, CustomType: a.CustomType}
+		case rschema.BoolAttribute:
+			converted[name] = dschema.BoolAttribute{Description: description, Computed: true, Sensitive: sensitive, CustomType: a.CustomType}
+		case rschema.Int64Attribute:
+			converted[name] = dschema.Int64Attribute{Description: description, Computed: true, Sensitive: sensitive, CustomType: a.CustomType}
+		case rschema.Float64Attribute:
+			converted[name] = dschema.Float64Attribute{Description: description, Computed: true, Sensitive: sensitive, CustomType: a.CustomType}
+		case rschema.NumberAttribute:
+			converted[name] = dschema.NumberAttribute{Description: description, Computed: true, Sensitive: sensitive, CustomType: a.CustomType}
+		case rschema.DynamicAttribute:
+			converted[name] = dschema.DynamicAttribute{Description: description, Computed: true, Sensitive: sensitive, CustomType: a.CustomType}
+		case rschema.ListAttribute:
+			converted[name] = dschema.ListAttribute{Description: description, Computed: true, Sensitive: sensitive, CustomType: a.CustomType, ElementType: a.ElementType}
+		case rschema.SetAttribute:
+			converted[name] = dschema.SetAttribute{Description: description, Computed: true, Sensitive: sensitive, CustomType: a.CustomType, ElementType: a.ElementType}
+		case rschema.MapAttribute:
+			converted[name] = dschema.MapAttribute{Description: description, Computed: true, Sensitive: sensitive, CustomType: a.CustomType, ElementType: a.ElementType}
+		case rschema.ObjectAttribute:
+			converted[name] = dschema.ObjectAttribute{Description: description, Computed: true, Sensitive: sensitive, CustomType: a.CustomType, AttributeTypes: a.AttributeTypes}
+		case rschema.SingleNestedAttribute:
+			converted[name] = dschema.SingleNestedAttribute{Description: description, Computed: true, Sensitive: sensitive, CustomType: a.CustomType, Attributes: computedAttributes(attributeName, a.Attributes, nil, diags)}
+		case rschema.ListNestedAttribute:
+			converted[name] = dschema.ListNestedAttribute{Description: description, Computed: true, Sensitive: sensitive, CustomType: a.CustomType, NestedObject: computedObject(attributeName, a.NestedObject.Attributes, nil, a.NestedObject.CustomType, diags)}
+		case rschema.SetNestedAttribute:
+			converted[name] = dschema.SetNestedAttribute{Description: description, Computed: true, Sensitive: sensitive, CustomType: a.CustomType, NestedObject: computedObject(attributeName, a.NestedObject.Attributes, nil, a.NestedObject.CustomType, diags)}
+		case rschema.MapNestedAttribute:
+			converted[name] = dschema.MapNestedAttribute{Description: description, Computed: true, Sensitive: sensitive, CustomType: a.CustomType, NestedObject: computedObject(attributeName, a.NestedObject.Attributes, nil, a.NestedObject.CustomType, diags)}
+		default:
+			diags.AddError("Unsupported data source attribute", fmt.Sprintf("The data source cannot expose attribute %s of type %T. Please report this issue to the provider developers.", attributeName, attribute))
+		}
+	}
+	for name, block := range blocks {
+		blockName := strings.TrimPrefix(parent+"."+name, ".")
+		description := block.GetDescription()
+		switch b := block.(type) {
+		case rschema.SingleNestedBlock:
+			converted[name] = dschema.SingleNestedAttribute{Description: description, Computed: true, CustomType: b.CustomType, Attributes: computedAttributes(blockName, b.Attributes, b.Blocks, diags)}
+		case rschema.ListNestedBlock:
+			converted[name] = dschema.ListNestedAttribute{Description: description, Computed: true, CustomType: b.CustomType, NestedObject: computedObject(blockName, b.NestedObject.Attributes, b.NestedObject.Blocks, b.NestedObject.CustomType, diags)}
+		case rschema.SetNestedBlock:
+			converted[name] = dschema.SetNestedAttribute{Description: description, Computed: true, CustomType: b.CustomType, NestedObject: computedObject(blockName, b.NestedObject.Attributes, b.NestedObject.Blocks, b.NestedObject.CustomType, diags)}
+		default:
+			diags.AddError("Unsupported data source block", fmt.Sprintf("The data source cannot expose block %s of type %T. Please report this issue to the provider developers.", blockName, block))
+		}
+	}
+	return converted
+}
+
+// computedObject converts the object of a nested attribute or block.
+func computedObject(objectName string, attributes map[string]rschema.Attribute, blocks map[string]rschema.Block, customType basetypes.ObjectTypable, diags *diag.Diagnostics) dschema.NestedAttributeObject {
+	return dschema.NestedAttributeObject{CustomType: customType, Attributes: computedAttributes(objectName, attributes, blocks, diags)}
+}
+
+// validateLookupConfig requires exactly one of id and the name attribute.
+func validateLookupConfig(ctx context.Context, config tfsdk.Config, nameAttribute string, diags *diag.Diagnostics) {
+	var id, name types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("id"), &id)...)
+	diags.Append(config.GetAttribute(ctx, path.Root(nameAttribute), &name)...)
+	if diags.HasError() {
+		return
+	}
+	switch {
+	case id.IsNull() && name.IsNull():
+		diags.AddAttributeError(path.Root("id"), "Missing lookup attribute", fmt.Sprintf("Set either id or %s.", nameAttribute))
+	case !id.IsNull() && !name.IsNull():
+		diags.AddAttributeError(path.Root(nameAttribute), "Conflicting lookup attributes", fmt.Sprintf("Set either id or %s, not both.", nameAttribute))
+	}
+}
+
+// lookupByIDOrName fetches the record with the given ID or, when id is
+// null, lists the records and returns the one named name. Telnyx name
+// filters match substrings, if the endpoint has one at all, so names are
+// compared exactly here and more than one match is an error. filter is the
+// query parameter that narrows the listing, or "" to list everything.
+func lookupByIDOrName[T any](
+	ctx context.Context,
+	kind, nameAttribute string,
+	id, name types.String,
+	get func(context.Context, string) (*T, error),
+	list func(context.Context, *telnyx.ListOptions) ([]T, error),
+	filter string,
+	identify func(T) (string, string),
+	diags *diag.Diagnostics,
+) *T {
+	if !id.IsNull() {
+		record, err := get(ctx, id.ValueString())
+		if telnyx.IsNotFound(err) {
+			diags.AddAttributeError(path.Root("id"), fmt.Sprintf("No such %s", kind), fmt.Sprintf("There is no %s with ID %q.", kind, id.ValueString()))
+			return nil
+		}
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Error reading %s", kind), err.Error())
+			return nil
+		}
+		return record
+	}
+
+	opts := &telnyx.ListOptions{}
+	if filter != "" {
+		opts.Filters = url.Values{filter: {name.ValueString()}}
+	}
+	records, err := list(ctx, opts)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error listing %ss", kind), err.Error())
+		return nil
+	}
+	var matches []T
+	var ids []string
+	for _, record := range records {
+		recordID, recordName := identify(record)
+		if recordName == name.ValueString() {
+			matches = append(matches, record)
+			ids = append(ids, recordID)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		diags.AddAttributeError(path.Root(nameAttribute), fmt.Sprintf("No such %s", kind), fmt.Sprintf("There is no %s named %q.", kind, name.ValueString()))
+		return nil
+	case 1:
+		tflog.Debug(ctx, "Found "+kind+" by name", map[string]interface{}{"id": ids[0], "name": name.ValueString()})
+		return &matches[0]
+	default:
+		sort.Strings(ids)
+		diags.AddAttributeError(
+			path.Root(nameAttribute),
+			fmt.Sprintf("Ambiguous %s name", kind),
+			fmt.Sprintf("%d %ss are named %q: %s. Look it up by id instead.", len(matches), kind, name.ValueString(), strings.Join(ids, ", ")),
+		)
+		return nil
+	}
+}
+
+// lookupDataSource reads an existing record of a resource type by ID or
+// name, sharing the resource's schema, model M and state setter. T is the
+// API record.
+type lookupDataSource[M, T any] struct {
+	typeName      string
+	description   string
+	resource      func() resource.Resource
+	nameAttribute string
+	kind          string
+	lookup        func(ctx context.Context, client *telnyx.TelnyxClient, id, name types.String, diags *diag.Diagnostics) *T
+	setState      func(ctx context.Context, state *M, record *T) diag.Diagnostics
+
+	client *telnyx.TelnyxClient
+}
+
+var (
+	_ datasource.DataSource                   = &lookupDataSource[BillingGroupResourceModel, telnyx.BillingGroup]{}
+	_ datasource.DataSourceWithConfigure      = &lookupDataSource[BillingGroupResourceModel, telnyx.BillingGroup]{}
+	_ datasource.DataSourceWithValidateConfig = &lookupDataSource[BillingGroupResourceModel, telnyx.BillingGroup]{}
+)
+
+func (d *lookupDataSource[M, T]) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.typeName
+}
+
+func (d *lookupDataSource[M, T]) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = lookupSchema(ctx, d.description, d.resource(), d.nameAttribute, &resp.Diagnostics)
+}
+
+func (d *lookupDataSource[M, T]) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = dataSourceClient(ctx, req, resp, d.typeName+" data source")
+}
+
+func (d *lookupDataSource[M, T]) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	validateLookupConfig(ctx, req.Config, d.nameAttribute, &resp.Diagnostics)
+}
+
+func (d *lookupDataSource[M, T]) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state M
+	var id, name types.String
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(d.nameAttribute), &name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	record := d.lookup(ctx, d.client, id, name, &resp.Diagnostics)
+	if record == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(d.setState(ctx, &state, record)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	tflog.Info(ctx, "Read "+d.kind, map[string]interface{}{"id": id.ValueString()})
+}
+
+func NewBillingGroupDataSource() datasource.DataSource {
+	return &lookupDataSource[BillingGroupResourceModel, telnyx.BillingGroup]{
+		typeName:      "billing_group",
+		description:   "Looks up an existing Telnyx Billing Group by ID or name",
+		resource:      NewBillingGroupResource,
+		nameAttribute: "name",
+		kind:          "billing group",
+		lookup:        findBillingGroup,
+		setState: func(_ context.Context, state *BillingGroupResourceModel, group *telnyx.BillingGroup) diag.Diagnostics {
+			setBillingGroupState(state, group)
+			return nil
+		},
+	}
+}
+
+func NewOutboundVoiceProfileDataSource() datasource.DataSource {
+	return &lookupDataSource[OutboundVoiceProfileResourceModel, telnyx.OutboundVoiceProfile]{
+		typeName:      "outbound_voice_profile",
+		description:   "Looks up an existing Telnyx Outbound Voice Profile by ID or name",
+		resource:      NewOutboundVoiceProfileResource,
+		nameAttribute: "name",
+		kind:          "outbound voice profile",
+		lookup:        findOutboundVoiceProfile,
+		setState: func(_ context.Context, state *OutboundVoiceProfileResourceModel, profile *telnyx.OutboundVoiceProfile) diag.Diagnostics {
+			return setOutboundVoiceProfileState(state, profile)
+		},
+	}
+}
+
+func NewMessagingProfileDataSource() datasource.DataSource {
+	return &lookupDataSource[MessagingProfileResourceModel, telnyx.MessagingProfile]{
+		typeName:      "messaging_profile",
+		description:   "Looks up an existing Telnyx Messaging Profile by ID or name",
+		resource:      NewMessagingProfileResource,
+		nameAttribute: "name",
+		kind:          "messaging profile",
+		lookup:        findMessagingProfile,
+		setState: func(_ context.Context, state *MessagingProfileResourceModel, profile *telnyx.MessagingProfile) diag.Diagnostics {
+			setMessagingProfileState(state, profile)
+			return nil
+		},
+	}
+}
+
+func NewCredentialConnectionDataSource() datasource.DataSource {
+	return &lookupDataSource[CredentialConnectionResourceModel, telnyx.CredentialConnection]{
+		typeName:      "credential_connection",
+		description:   "Looks up an existing Telnyx Credential Connection by ID or connection name",
+		resource:      NewCredentialConnectionResource,
+		nameAttribute: "connection_name",
+		kind:          "credential connection",
+		lookup:        findCredentialConnection,
+		setState: func(ctx context.Context, state *CredentialConnectionResourceModel, connection *telnyx.CredentialConnection) diag.Diagnostics {
+			setCredentialConnectionState(ctx, state, connection)
+			return nil
+		},
+	}
+}
+
+func NewFQDNConnectionDataSource() datasource.DataSource {
+	return &lookupDataSource[FQDNConnectionResourceModel, telnyx.FQDNConnection]{
+		typeName:      "fqdn_connection",
+		description:   "Looks up an existing Telnyx FQDN Connection by ID or connection name",
+		resource:      NewFQDNConnectionResource,
+		nameAttribute: "connection_name",
+		kind:          "FQDN connection",
+		lookup:        findFQDNConnection,
+		setState: func(ctx context.Context, state *FQDNConnectionResourceModel, connection *telnyx.FQDNConnection) diag.Diagnostics {
+			setFQDNConnectionState(ctx, state, connection)
+			return nil
+		},
+	}
+}
+
+func NewTeXMLApplicationDataSource() datasource.DataSource {
+	return &lookupDataSource[TeXMLApplicationResourceModel, telnyx.TeXMLApplication]{
+		typeName:      "texml_application",
+		description:   "Looks up an existing Telnyx TeXML Application by ID or friendly name",
+		resource:      NewTeXMLApplicationResource,
+		nameAttribute: "friendly_name",
+		kind:          "TeXML application",
+		lookup:        findTeXMLApplication,
+		setState: func(_ context.Context, state *TeXMLApplicationResourceModel, application *telnyx.TeXMLApplication) diag.Diagnostics {
+			setStateFromTeXMLApplicationResponse(state, application)
+			return nil
+		},
+	}
+}
+
+func NewCallControlApplicationDataSource() datasource.DataSource {
+	return &lookupDataSource[CallControlApplicationResourceModel, telnyx.CallControlApplication]{
+		typeName:      "call_control_application",
+		description:   "Looks up an existing Telnyx Call Control Application by ID or application name",
+		resource:      NewCallControlApplicationResource,
+		nameAttribute: "application_name",
+		kind:          "call control application",
+		lookup:        findCallControlApplication,
+		setState: func(_ context.Context, state *CallControlApplicationResourceModel, application *telnyx.CallControlApplication) diag.Diagnostics {
+			setStateResponse(state, application)
+			return nil
+		},
+	}
+}
+
+// dataSourceClient returns the client the provider configured, or nil when
+// the provider has not been configured yet.
+func dataSourceClient(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse, name string) *telnyx.TelnyxClient {
+	if req.ProviderData == nil {
+		return nil
+	}
+	client, ok := req.ProviderData.(*telnyx.TelnyxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *telnyx.TelnyxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return nil
+	}
+	tflog.Info(ctx, "Configured Telnyx client for "+name)
+	return client
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// unsupportedAttribute is an attribute kind computedAttributes does not know.
+type unsupportedAttribute struct {
+	rschema.StringAttribute
+}
+
+func TestComputedAttributes(t *testing.T) {
+	nested := map[string]rschema.Attribute{"value": rschema.StringAttribute{Optional: true}}
+	attributes := map[string]rschema.Attribute{
+		"string":        rschema.StringAttribute{Required: true, Description: "A string."},
+		"secret":        rschema.StringAttribute{Optional: true, Sensitive: true},
+		"bool":          rschema.BoolAttribute{Optional: true},
+		"int64":         rschema.Int64Attribute{Optional: true},
+		"float64":       rschema.Float64Attribute{Optional: true},
+		"number":        rschema.NumberAttribute{Optional: true},
+		"dynamic":       rschema.DynamicAttribute{Optional: true},
+		"list":          rschema.ListAttribute{Optional: true, ElementType: types.StringType},
+		"set":           rschema.SetAttribute{Optional: true, ElementType: types.StringType},
+		"map":           rschema.MapAttribute{Optional: true, ElementType: types.Int64Type},
+		"object":        rschema.ObjectAttribute{Optional: true, AttributeTypes: map[string]attr.Type{"value": types.StringType}},
+		"single_nested": rschema.SingleNestedAttribute{Optional: true, Attributes: nested},
+		"list_nested":   rschema.ListNestedAttribute{Optional: true, NestedObject: rschema.NestedAttributeObject{Attributes: nested}},
+		"set_nested":    rschema.SetNestedAttribute{Optional: true, NestedObject: rschema.NestedAttributeObject{Attributes: nested}},
+		"map_nested":    rschema.MapNestedAttribute{Optional: true, NestedObject: rschema.NestedAttributeObject{Attributes: nested}},
+	}
+	blocks := map[string]rschema.Block{
+		"single_block": rschema.SingleNestedBlock{Attributes: nested},
+		"list_block": rschema.ListNestedBlock{NestedObject: rschema.NestedBlockObject{
+			Attributes: nested,
+			Blocks:     map[string]rschema.Block{"inner": rschema.SetNestedBlock{NestedObject: rschema.NestedBlockObject{Attributes: nested}}},
+		}},
+		"set_block": rschema.SetNestedBlock{NestedObject: rschema.NestedBlockObject{Attributes: nested}},
+	}
+
+	var diags diag.Diagnostics
+	converted := computedAttributes("", attributes, blocks, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(converted) != len(attributes)+len(blocks) {
+		t.Fatalf("converted %d attributes, want %d", len(converted), len(attributes)+len(blocks))
+	}
+
+	ctx := context.Background()
+	for name, attribute := range converted {
+		if !attribute.IsComputed() || attribute.IsOptional() || attribute.IsRequired() {
+			t.Errorf("%s is not purely computed", name)
+		}
+		if original, ok := attributes[name]; ok && !attribute.GetType().Equal(original.GetType()) {
+			t.Errorf("%s has type %s, want %s", name, attribute.GetType(), original.GetType())
+		}
+		if block, ok := blocks[name]; ok && !attribute.GetType().Equal(block.Type()) {
+			t.Errorf("block %s has type %s, want %s", name, attribute.GetType(), block.Type())
+		}
+	}
+	if converted["string"].GetDescription() != "A string." || !converted["secret"].IsSensitive() {
+		t.Error("descriptions or sensitivity were not kept")
+	}
+	inner := converted["list_block"].(dschema.ListNestedAttribute).NestedObject.Attributes["inner"]
+	if _, ok := inner.(dschema.SetNestedAttribute); !ok {
+		t.Errorf("block nested in a block became %T, want a set nested attribute", inner)
+	}
+
+	schema := dschema.Schema{Attributes: converted}
+	if diags := schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Errorf("converted schema is invalid: %v", diags)
+	}
+}
+
+func TestComputedAttributesUnsupported(t *testing.T) {
+	var diags diag.Diagnostics
+	attributes := map[string]rschema.Attribute{
+		"outbound": rschema.SingleNestedAttribute{
+			Optional:   true,
+			Attributes: map[string]rschema.Attribute{"custom": unsupportedAttribute{}},
+		},
+	}
+
+	converted := computedAttributes("", attributes, nil, &diags)
+
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("got %v, want one error for the unsupported attribute", diags)
+	}
+	if got := diags[0].Detail(); got != "The data source cannot expose attribute outbound.custom of type provider.unsupportedAttribute. Please report this issue to the provider developers." {
+		t.Errorf("error detail = %q", got)
+	}
+	if _, ok := converted["outbound"]; !ok {
+		t.Error("the supported parent attribute was dropped")
+	}
+}
+
+func TestDataSourceSchemas(t *testing.T) {
+	ctx := context.Background()
+	for _, newDataSource := range New("test")().DataSources(ctx) {
+		dataSource := newDataSource()
+		var metadata datasource.MetadataResponse
+		dataSource.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "telnyx"}, &metadata)
+		t.Run(metadata.TypeName, func(t *testing.T) {
+			var resp datasource.SchemaResponse
+			dataSource.Schema(ctx, datasource.SchemaRequest{}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("schema diagnostics: %v", resp.Diagnostics)
+			}
+			resp.Diagnostics.Append(resp.Schema.ValidateImplementation(ctx)...)
+			if resp.Diagnostics.HasError() {
+				t.Errorf("invalid schema: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
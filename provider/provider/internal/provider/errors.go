@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
+)
+
+// addAPIError reports a failed create or update. Telnyx errors whose
+// source.pointer names an attribute of the resource are attached to that
+// attribute, so Terraform points at the offending line of configuration.
+// Anything that cannot be placed is reported as a single general error.
+func addAPIError(ctx context.Context, diags *diag.Diagnostics, plan tfsdk.Plan, summary string, err error) {
+	apiErr, ok := telnyx.AsAPIError(err)
+	if !ok || len(apiErr.Errors) == 0 {
+		diags.AddError(summary, err.Error())
+		return
+	}
+
+	unplaced := false
+	for _, detail := range apiErr.Errors {
+		attributePath, ok := pointerToPath(ctx, plan, detail.Pointer())
+		if !ok {
+			unplaced = true
+			continue
+		}
+		message := detail.String()
+		if apiErr.RequestID != "" {
+			message += "\n\nTelnyx request ID: " + apiErr.RequestID
+		}
+		diags.AddAttributeError(attributePath, summary, message)
+	}
+	if unplaced {
+		diags.AddError(summary, err.Error())
+	}
+}
+
+// pointerToPath converts a JSON pointer such as "/outbound/channel_limit" to
+// the matching attribute path, provided the resource schema has it.
+func pointerToPath(ctx context.Context, plan tfsdk.Plan, pointer string) (path.Path, bool) {
+	if plan.Schema == nil || !strings.HasPrefix(pointer, "/") {
+		return path.Empty(), false
+	}
+
+	var attributePath path.Path
+	for i, segment := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		segment = strings.NewReplacer("~1", "/", "~0", "~").Replace(segment)
+		switch index, err := strconv.Atoi(segment); {
+		case i == 0 && err == nil, segment == "":
+			return path.Empty(), false
+		case i == 0:
+			attributePath = path.Root(segment)
+		case err == nil:
+			attributePath = attributePath.AtListIndex(index)
+		default:
+			attributePath = attributePath.AtName(segment)
+		}
+	}
+
+	// A pointer to a list element, such as "/phone_numbers/0", is checked
+	// against the list attribute that holds it
+	attribute := attributePath
+	for {
+		last, _ := attribute.Steps().LastStep()
+		if _, ok := last.(path.PathStepElementKeyInt); !ok {
+			break
+		}
+		attribute = attribute.ParentPath()
+	}
+	if _, diags := plan.Schema.AttributeAtPath(ctx, attribute); diags.HasError() {
+		return path.Empty(), false
+	}
+	return attributePath, true
+}
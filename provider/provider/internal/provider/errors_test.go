@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
+)
+
+// errorTestPlan is a plan whose schema has top-level, nested and list
+// attributes for pointers to resolve against.
+var errorTestPlan = tfsdk.Plan{
+	Schema: schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"connection_name": schema.StringAttribute{Optional: true},
+			"outbound": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"channel_limit": schema.Int64Attribute{Optional: true},
+				},
+			},
+			"phone_numbers": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"phone_number": schema.StringAttribute{Optional: true},
+					},
+				},
+			},
+			"tags": schema.ListAttribute{Optional: true, ElementType: types.StringType},
+		},
+	},
+}
+
+func TestPointerToPath(t *testing.T) {
+	tests := []struct {
+		pointer string
+		want    path.Path
+		wantOK  bool
+	}{
+		{pointer: "/connection_name", want: path.Root("connection_name"), wantOK: true},
+		{pointer: "/outbound/channel_limit", want: path.Root("outbound").AtName("channel_limit"), wantOK: true},
+		{pointer: "/phone_numbers/1/phone_number", want: path.Root("phone_numbers").AtListIndex(1).AtName("phone_number"), wantOK: true},
+		{pointer: "/phone_numbers/0", want: path.Root("phone_numbers").AtListIndex(0), wantOK: true},
+		{pointer: "/tags/2", want: path.Root("tags").AtListIndex(2), wantOK: true},
+		{pointer: "/webhook_event_url"},
+		{pointer: "/outbound/unknown"},
+		{pointer: "/0/connection_name"},
+		{pointer: "/outbound//channel_limit"},
+		{pointer: "connection_name"},
+		{pointer: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.pointer, func(t *testing.T) {
+			got, ok := pointerToPath(context.Background(), errorTestPlan, test.pointer)
+			if ok != test.wantOK || (ok && !got.Equal(test.want)) {
+				t.Errorf("pointerToPath(%q) = %s, %t, want %s, %t", test.pointer, got, ok, test.want, test.wantOK)
+			}
+		})
+	}
+
+	if _, ok := pointerToPath(context.Background(), tfsdk.Plan{}, "/connection_name"); ok {
+		t.Error("pointerToPath resolved a pointer without a schema")
+	}
+}
+
+func TestAddAPIError(t *testing.T) {
+	apiErr := &telnyx.APIError{
+		StatusCode: 422,
+		Method:     "POST",
+		Path:       "/credential_connections",
+		RequestID:  "req-123",
+		Errors: []telnyx.TelnyxErrorDetail{
+			{Code: "10015", Title: "Invalid value", Source: map[string]string{"pointer": "/outbound/channel_limit"}},
+			{Code: "10032", Title: "Missing required parameter", Source: map[string]string{"pointer": "/user_name"}},
+		},
+	}
+
+	var diags diag.Diagnostics
+	addAPIError(context.Background(), &diags, errorTestPlan, "Error creating connection", fmt.Errorf("wrapped: %w", apiErr))
+
+	if len(diags) != 2 {
+		t.Fatalf("got %d diagnostics, want one for the attribute and one general: %v", len(diags), diags)
+	}
+	attribute, ok := diags[0].(diag.DiagnosticWithPath)
+	if !ok || !attribute.Path().Equal(path.Root("outbound").AtName("channel_limit")) {
+		t.Errorf("first diagnostic is not on outbound.channel_limit: %v", diags[0])
+	}
+	if detail := diags[0].Detail(); !strings.Contains(detail, "Invalid value") || !strings.Contains(detail, "req-123") {
+		t.Errorf("attribute diagnostic detail %q lacks the error or request ID", detail)
+	}
+	if _, ok := diags[1].(diag.DiagnosticWithPath); ok {
+		t.Errorf("unplaced error was attached to an attribute: %v", diags[1])
+	}
+	if detail := diags[1].Detail(); !strings.Contains(detail, "Missing required parameter") {
+		t.Errorf("general diagnostic detail %q lacks the unplaced error", detail)
+	}
+	for _, d := range diags {
+		if d.Summary() != "Error creating connection" {
+			t.Errorf("diagnostic summary = %q", d.Summary())
+		}
+	}
+}
+
+func TestAddAPIErrorWithoutErrorObjects(t *testing.T) {
+	var diags diag.Diagnostics
+	err := &telnyx.APIError{StatusCode: 502, Method: "GET", Path: "/fqdns", Body: []byte("Bad Gateway")}
+	addAPIError(context.Background(), &diags, errorTestPlan, "Error reading FQDN", err)
+
+	if len(diags) != 1 || diags[0].Detail() != err.Error() {
+		t.Errorf("got %v, want a single general error with the API error text", diags)
+	}
+	if _, ok := diags[0].(diag.DiagnosticWithPath); ok {
+		t.Error("error without error objects was attached to an attribute")
+	}
+}
@@ -3,7 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -14,7 +18,8 @@ import (
 )
 
 var (
-	_ resource.Resource = &FQDNResource{}
+	_ resource.Resource                = &FQDNResource{}
+	_ resource.ResourceWithImportState = &FQDNResource{}
 )
 
 func NewFQDNResource() resource.Resource {
@@ -41,7 +46,7 @@ func (r *FQDNResource) Metadata(ctx context.Context, req resource.MetadataReques
 
 func (r *FQDNResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Resource for managing Telnyx FQDNs",
+		Description: "Resource for managing Telnyx FQDNs. Import with the FQDN's ID or connection_id/fqdn.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Unique identifier of the FQDN",
@@ -108,12 +113,9 @@ func (r *FQDNResource) Create(ctx context.Context, req resource.CreateRequest, r
 		DNSRecordType: plan.DNSRecordType.ValueString(),
 	}
 
-	createdFQDN, err := r.client.CreateFQDN(fqdn)
+	createdFQDN, err := r.client.CreateFQDN(ctx, fqdn)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating FQDN",
-			"Could not create FQDN, unexpected error: "+err.Error(),
-		)
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error creating FQDN", err)
 		return
 	}
 
@@ -131,14 +133,14 @@ func (r *FQDNResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	fqdn, err := r.client.GetFQDN(state.ID.ValueString())
+	fqdn, err := r.client.GetFQDN(ctx, state.ID.ValueString())
 	if err == nil {
 		setFQDNState(ctx, &state, fqdn)
 		diags = resp.State.Set(ctx, &state)
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-	if telnyxErr, ok := err.(*telnyx.TelnyxError); ok && telnyxErr.IsResourceNotFound() {
+	if telnyx.IsNotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -170,12 +172,9 @@ func (r *FQDNResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		DNSRecordType: plan.DNSRecordType.ValueString(),
 	}
 
-	updatedFQDN, err := r.client.UpdateFQDN(state.ID.ValueString(), fqdn)
+	updatedFQDN, err := r.client.UpdateFQDN(ctx, state.ID.ValueString(), fqdn)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating FQDN",
-			"Could not update FQDN, unexpected error: "+err.Error(),
-		)
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error updating FQDN", err)
 		return
 	}
 
@@ -193,7 +192,7 @@ func (r *FQDNResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	err := r.client.DeleteFQDN(state.ID.ValueString())
+	err := r.client.DeleteFQDN(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting FQDN",
@@ -203,6 +202,33 @@ func (r *FQDNResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 }
 
+// ImportState accepts the FQDN's ID or connection_id/fqdn.
+func (r *FQDNResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	connectionID, name, composite := splitImportID(req.ID, "connection_id/fqdn", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !composite {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	fqdns, err := r.client.ListFQDNs(ctx, &telnyx.ListOptions{
+		Filters: url.Values{"filter[connection_id]": {connectionID}, "filter[fqdn]": {name}},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing FQDNs", err.Error())
+		return
+	}
+	for _, fqdn := range fqdns {
+		if strconv.Itoa(fqdn.ConnectionID) == connectionID && strings.EqualFold(fqdn.FQDN, name) {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fqdn.ID)...)
+			return
+		}
+	}
+	resp.Diagnostics.AddError("No such FQDN", fmt.Sprintf("Connection %s has no FQDN %q.", connectionID, name))
+}
+
 func setFQDNState(ctx context.Context, state *FQDNResourceModel, fqdn *telnyx.FQDN) {
 	state.ID = types.StringValue(fqdn.ID)
 	state.ConnectionID = types.Int64Value(int64(fqdn.ConnectionID))
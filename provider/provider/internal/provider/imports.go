@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
+)
+
+// looksLikeID reports whether an import ID is a Telnyx record ID, which is
+// either numeric or a UUID, rather than a name.
+func looksLikeID(importID string) bool {
+	if importID == "" {
+		return false
+	}
+	if strings.Trim(importID, "0123456789") == "" {
+		return true
+	}
+	if len(importID) != 36 {
+		return false
+	}
+	for i, r := range importID {
+		switch {
+		case i == 8 || i == 13 || i == 18 || i == 23:
+			if r != '-' {
+				return false
+			}
+		case !strings.ContainsRune("0123456789abcdefABCDEF", r):
+			return false
+		}
+	}
+	return true
+}
+
+// isE164 reports whether an import ID is a phone number in E.164 format
+// rather than the ID of a phone number record.
+func isE164(importID string) bool {
+	return len(importID) > 1 && importID[0] == '+' && strings.Trim(importID[1:], "0123456789") == ""
+}
+
+// importByName imports the record named by the import ID, or the record
+// with that ID when it looks like one. find looks the record up the same
+// way the resource's data source does and returns its ID, or "" after
+// reporting why there is none.
+func importByName(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, find func(id, name types.String) string) {
+	if looksLikeID(req.ID) {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+	if id := find(types.StringNull(), types.StringValue(req.ID)); id != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	}
+}
+
+// importPhoneNumberID returns the ID of the phone number an import ID
+// names, which is either that ID or the number itself in E.164 format.
+// It returns "" after reporting an error when the number is not on the
+// account.
+func importPhoneNumberID(ctx context.Context, client *telnyx.TelnyxClient, importID string, diags *diag.Diagnostics) string {
+	if !isE164(importID) {
+		return importID
+	}
+	phoneNumbers, err := client.ListPhoneNumbersMatching(ctx, telnyx.PhoneNumberFilters{PhoneNumber: importID})
+	if err != nil {
+		diags.AddError("Error looking up phone number", err.Error())
+		return ""
+	}
+	for _, phoneNumber := range phoneNumbers {
+		if phoneNumber.PhoneNumber == importID {
+			return phoneNumber.ID
+		}
+	}
+	diags.AddError("Phone number not found", fmt.Sprintf("%s is not on this Telnyx account.", importID))
+	return ""
+}
+
+// splitImportID splits a composite import ID of the form "parent/child".
+// It reports false, without an error, for an ID with no slash so the
+// caller can treat it as a plain record ID.
+func splitImportID(importID, format string, diags *diag.Diagnostics) (string, string, bool) {
+	parent, child, composite := strings.Cut(importID, "/")
+	if !composite {
+		return "", "", false
+	}
+	if parent == "" || child == "" {
+		diags.AddError("Unexpected import identifier", fmt.Sprintf("Expected an ID or %s, got %q.", format, importID))
+		return "", "", false
+	}
+	return parent, child, true
+}
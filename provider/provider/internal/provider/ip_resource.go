@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
+)
+
+var (
+	_ resource.Resource                = &IPResource{}
+	_ resource.ResourceWithImportState = &IPResource{}
+)
+
+func NewIPResource() resource.Resource {
+	return &IPResource{}
+}
+
+type IPResource struct {
+	client *telnyx.TelnyxClient
+}
+
+type IPResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	ConnectionID types.String `tfsdk:"connection_id"`
+	IPAddress    types.String `tfsdk:"ip_address"`
+	Port         types.Int64  `tfsdk:"port"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+}
+
+func (r *IPResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip"
+}
+
+func (r *IPResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for managing Telnyx IPs, the addresses allowed to send traffic on an IP connection. Import with the IP's ID or connection_id/ip_address.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the IP",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "ID of the IP connection associated with the IP",
+				Required:    true,
+			},
+			"ip_address": schema.StringAttribute{
+				Description: "IPv4 address",
+				Required:    true,
+			},
+			"port": schema.Int64Attribute{
+				Description: "Port associated with the IP",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(5060),
+			},
+			"created_at": schema.StringAttribute{
+				Description: "ISO 8601 formatted date indicating when the resource was created",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "ISO 8601 formatted date indicating when the resource was updated",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *IPResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		client, ok := req.ProviderData.(*telnyx.TelnyxClient)
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Unexpected Resource Configure Type",
+				fmt.Sprintf("Expected *telnyx.TelnyxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			)
+			return
+		}
+		r.client = client
+		tflog.Info(ctx, "Configured Telnyx client for IPResource")
+	}
+}
+
+func (r *IPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan IPResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ip := telnyx.IP{
+		ConnectionID: plan.ConnectionID.ValueString(),
+		IPAddress:    plan.IPAddress.ValueString(),
+		Port:         int(plan.Port.ValueInt64()),
+	}
+
+	createdIP, err := r.client.CreateIP(ctx, ip)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error creating IP", err)
+		return
+	}
+
+	setIPState(ctx, &plan, createdIP)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *IPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state IPResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ip, err := r.client.GetIP(ctx, state.ID.ValueString())
+	if err == nil {
+		setIPState(ctx, &state, ip)
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	if telnyx.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.AddError(
+		"Error reading IP",
+		"Could not read IP, unexpected error: "+err.Error(),
+	)
+}
+
+func (r *IPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan IPResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state IPResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ip := telnyx.IP{
+		ConnectionID: plan.ConnectionID.ValueString(),
+		IPAddress:    plan.IPAddress.ValueString(),
+		Port:         int(plan.Port.ValueInt64()),
+	}
+
+	updatedIP, err := r.client.UpdateIP(ctx, state.ID.ValueString(), ip)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error updating IP", err)
+		return
+	}
+
+	setIPState(ctx, &plan, updatedIP)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *IPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state IPResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteIP(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting IP",
+			"Could not delete IP, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState accepts the IP's ID or connection_id/ip_address.
+func (r *IPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	connectionID, address, composite := splitImportID(req.ID, "connection_id/ip_address", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !composite {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	ips, err := r.client.ListIPs(ctx, &telnyx.ListOptions{
+		Filters: url.Values{"filter[connection_id]": {connectionID}, "filter[ip_address]": {address}},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing IPs", err.Error())
+		return
+	}
+	for _, ip := range ips {
+		if ip.ConnectionID == connectionID && ip.IPAddress == address {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ip.ID)...)
+			return
+		}
+	}
+	resp.Diagnostics.AddError("No such IP", fmt.Sprintf("Connection %s has no IP %s.", connectionID, address))
+}
+
+func setIPState(ctx context.Context, state *IPResourceModel, ip *telnyx.IP) {
+	state.ID = types.StringValue(ip.ID)
+	state.ConnectionID = types.StringValue(ip.ConnectionID)
+	state.IPAddress = types.StringValue(ip.IPAddress)
+	state.Port = types.Int64Value(int64(ip.Port))
+	state.CreatedAt = types.StringValue(ip.CreatedAt.String())
+	state.UpdatedAt = types.StringValue(ip.UpdatedAt.String())
+}
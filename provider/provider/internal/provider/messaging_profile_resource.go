@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
@@ -17,7 +19,8 @@ import (
 )
 
 var (
-	_ resource.Resource = &MessagingProfileResource{}
+	_ resource.Resource                = &MessagingProfileResource{}
+	_ resource.ResourceWithImportState = &MessagingProfileResource{}
 )
 
 func NewMessagingProfileResource() resource.Resource {
@@ -141,7 +144,7 @@ func (r *MessagingProfileResource) Create(ctx context.Context, req resource.Crea
 		"whitelisted_destinations": whitelistedDestinations,
 	})
 
-	profile, err := r.client.CreateMessagingProfile(telnyx.MessagingProfile{
+	profile, err := r.client.CreateMessagingProfile(ctx, telnyx.MessagingProfile{
 		Name:                    plan.Name.ValueString(),
 		Enabled:                 plan.Enabled.ValueBool(),
 		WebhookURL:              plan.WebhookURL.ValueString(),
@@ -150,7 +153,7 @@ func (r *MessagingProfileResource) Create(ctx context.Context, req resource.Crea
 		WhitelistedDestinations: whitelistedDestinations,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating messaging profile", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error creating messaging profile", err)
 		return
 	}
 
@@ -175,23 +178,15 @@ func (r *MessagingProfileResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	profile, err := r.client.GetMessagingProfile(state.ID.ValueString())
+	profile, err := r.client.GetMessagingProfile(ctx, state.ID.ValueString())
 	if err == nil {
-		state.Name = types.StringValue(profile.Name)
-		state.Enabled = types.BoolValue(profile.Enabled)
-		state.WebhookURL = types.StringValue(profile.WebhookURL)
-		state.WebhookFailoverURL = types.StringValue(profile.WebhookFailoverURL)
-		state.WebhookAPIVersion = types.StringValue(profile.WebhookAPIVersion)
-		state.WhitelistedDestinations = convertStringsToList(profile.WhitelistedDestinations)
-		state.CreatedAt = types.StringValue(profile.CreatedAt.String())
-		state.UpdatedAt = types.StringValue(profile.UpdatedAt.String())
-		state.V1Secret = types.StringValue(profile.V1Secret)
+		setMessagingProfileState(&state, profile)
 
 		diags = resp.State.Set(ctx, state)
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-	if telnyxErr, ok := err.(*telnyx.TelnyxError); ok && telnyxErr.IsResourceNotFound() {
+	if telnyx.IsNotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -212,7 +207,7 @@ func (r *MessagingProfileResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	profile, err := r.client.UpdateMessagingProfile(plan.ID.ValueString(), telnyx.MessagingProfile{
+	profile, err := r.client.UpdateMessagingProfile(ctx, plan.ID.ValueString(), telnyx.MessagingProfile{
 		Name:                    plan.Name.ValueString(),
 		Enabled:                 plan.Enabled.ValueBool(),
 		WebhookURL:              plan.WebhookURL.ValueString(),
@@ -221,7 +216,7 @@ func (r *MessagingProfileResource) Update(ctx context.Context, req resource.Upda
 		WhitelistedDestinations: whitelistedDestinations,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating messaging profile", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error updating messaging profile", err)
 		return
 	}
 
@@ -241,8 +236,35 @@ func (r *MessagingProfileResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	err := r.client.DeleteMessagingProfile(state.ID.ValueString())
+	err := r.client.DeleteMessagingProfile(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting messaging profile", err.Error())
 	}
 }
+
+func (r *MessagingProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func setMessagingProfileState(state *MessagingProfileResourceModel, profile *telnyx.MessagingProfile) {
+	state.ID = types.StringValue(profile.ID)
+	state.Name = types.StringValue(profile.Name)
+	state.Enabled = types.BoolValue(profile.Enabled)
+	state.WebhookURL = types.StringValue(profile.WebhookURL)
+	state.WebhookFailoverURL = types.StringValue(profile.WebhookFailoverURL)
+	state.WebhookAPIVersion = types.StringValue(profile.WebhookAPIVersion)
+	state.WhitelistedDestinations = convertStringsToList(profile.WhitelistedDestinations)
+	state.CreatedAt = types.StringValue(profile.CreatedAt.String())
+	state.UpdatedAt = types.StringValue(profile.UpdatedAt.String())
+	state.V1Secret = types.StringValue(profile.V1Secret)
+}
+
+// findMessagingProfile returns the messaging profile with the given ID or, when id is null,
+// the one with exactly the given name.
+func findMessagingProfile(ctx context.Context, client *telnyx.TelnyxClient, id, name types.String, diags *diag.Diagnostics) *telnyx.MessagingProfile {
+	return lookupByIDOrName(ctx, "messaging profile", "name", id, name,
+		client.GetMessagingProfile, client.ListMessagingProfiles, "filter[name]",
+		func(record telnyx.MessagingProfile) (string, string) { return record.ID, record.Name },
+		diags,
+	)
+}
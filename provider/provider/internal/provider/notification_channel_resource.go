@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
+)
+
+var (
+	_ resource.Resource                   = &NotificationChannelResource{}
+	_ resource.ResourceWithConfigure      = &NotificationChannelResource{}
+	_ resource.ResourceWithValidateConfig = &NotificationChannelResource{}
+	_ resource.ResourceWithImportState    = &NotificationChannelResource{}
+)
+
+// notificationChannelTypes are the channel_type_id values Telnyx accepts.
+var notificationChannelTypes = []string{"email", "sms", "voice", "webhook"}
+
+func NewNotificationChannelResource() resource.Resource {
+	return &NotificationChannelResource{}
+}
+
+type NotificationChannelResource struct {
+	client *telnyx.TelnyxClient
+}
+
+type NotificationChannelResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	NotificationProfileID types.String `tfsdk:"notification_profile_id"`
+	ChannelTypeID         types.String `tfsdk:"channel_type_id"`
+	ChannelDestination    types.String `tfsdk:"channel_destination"`
+	CreatedAt             types.String `tfsdk:"created_at"`
+	UpdatedAt             types.String `tfsdk:"updated_at"`
+}
+
+func (r *NotificationChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_channel"
+}
+
+func (r *NotificationChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for managing Telnyx Notification Channels, the email addresses, phone numbers and webhooks a notification profile delivers alerts to",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the notification channel",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"notification_profile_id": schema.StringAttribute{
+				Description: "ID of the notification profile the channel belongs to",
+				Required:    true,
+			},
+			"channel_type_id": schema.StringAttribute{
+				Description: "Type of the channel: one of " + strings.Join(notificationChannelTypes, ", "),
+				Required:    true,
+			},
+			"channel_destination": schema.StringAttribute{
+				Description: "Where alerts are delivered: an email address, an E.164 phone number for sms and voice, or a URL for webhook",
+				Required:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "ISO 8601 formatted date indicating when the resource was created",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "ISO 8601 formatted date indicating when the resource was updated",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *NotificationChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		client, ok := req.ProviderData.(*telnyx.TelnyxClient)
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Unexpected Resource Configure Type",
+				fmt.Sprintf("Expected *telnyx.TelnyxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			)
+			return
+		}
+		r.client = client
+		tflog.Info(ctx, "Configured Telnyx client for NotificationChannelResource")
+	}
+}
+
+// ValidateConfig checks that the destination fits the channel type, so a
+// typo fails at plan time instead of silently dropping alerts.
+func (r *NotificationChannelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config NotificationChannelResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ChannelTypeID.IsUnknown() || config.ChannelTypeID.IsNull() {
+		return
+	}
+	channelType := config.ChannelTypeID.ValueString()
+	if !slices.Contains(notificationChannelTypes, channelType) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("channel_type_id"),
+			"Invalid channel_type_id",
+			fmt.Sprintf("channel_type_id must be one of %s, got %q.", strings.Join(notificationChannelTypes, ", "), channelType),
+		)
+		return
+	}
+
+	if config.ChannelDestination.IsUnknown() || config.ChannelDestination.IsNull() {
+		return
+	}
+	destination := config.ChannelDestination.ValueString()
+	var problem string
+	switch channelType {
+	case "email":
+		if address, err := mail.ParseAddress(destination); err != nil || address.Address != destination {
+			problem = "an email address such as ops@example.com"
+		}
+	case "sms", "voice":
+		if !e164Pattern.MatchString(destination) {
+			problem = "a phone number in E.164 format, e.g. +13125550100"
+		}
+	case "webhook":
+		if parsed, err := url.Parse(destination); err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+			problem = "an http or https URL"
+		}
+	}
+	if problem != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("channel_destination"),
+			"Invalid channel_destination",
+			fmt.Sprintf("%s channels need %s, got %q.", channelType, problem, destination),
+		)
+	}
+}
+
+func (r *NotificationChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan NotificationChannelResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.CreateNotificationChannel(ctx, notificationChannelRequest(plan))
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error creating notification channel", err)
+		return
+	}
+
+	tflog.Info(ctx, "Created Notification Channel", map[string]interface{}{"id": channel.ID, "channel_type_id": channel.ChannelTypeID})
+
+	setNotificationChannelState(&plan, channel)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *NotificationChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state NotificationChannelResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.GetNotificationChannel(ctx, state.ID.ValueString())
+	if err == nil {
+		setNotificationChannelState(&state, channel)
+		diags = resp.State.Set(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	if telnyx.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.AddError("Error reading notification channel", err.Error())
+}
+
+func (r *NotificationChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan NotificationChannelResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.UpdateNotificationChannel(ctx, plan.ID.ValueString(), notificationChannelRequest(plan))
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error updating notification channel", err)
+		return
+	}
+
+	setNotificationChannelState(&plan, channel)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *NotificationChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NotificationChannelResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteNotificationChannel(ctx, state.ID.ValueString())
+	if err != nil && !telnyx.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting notification channel", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Notification Channel", map[string]interface{}{"id": state.ID.ValueString()})
+}
+
+func (r *NotificationChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func notificationChannelRequest(plan NotificationChannelResourceModel) telnyx.NotificationChannel {
+	return telnyx.NotificationChannel{
+		NotificationProfileID: plan.NotificationProfileID.ValueString(),
+		ChannelTypeID:         plan.ChannelTypeID.ValueString(),
+		ChannelDestination:    plan.ChannelDestination.ValueString(),
+	}
+}
+
+func setNotificationChannelState(state *NotificationChannelResourceModel, channel *telnyx.NotificationChannel) {
+	state.ID = types.StringValue(channel.ID)
+	state.NotificationProfileID = types.StringValue(channel.NotificationProfileID)
+	state.ChannelTypeID = types.StringValue(channel.ChannelTypeID)
+	state.ChannelDestination = types.StringValue(channel.ChannelDestination)
+	state.CreatedAt = types.StringValue(channel.CreatedAt.String())
+	state.UpdatedAt = types.StringValue(channel.UpdatedAt.String())
+}
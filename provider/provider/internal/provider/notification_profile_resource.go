@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
+)
+
+var (
+	_ resource.Resource                = &NotificationProfileResource{}
+	_ resource.ResourceWithConfigure   = &NotificationProfileResource{}
+	_ resource.ResourceWithImportState = &NotificationProfileResource{}
+)
+
+func NewNotificationProfileResource() resource.Resource {
+	return &NotificationProfileResource{}
+}
+
+type NotificationProfileResource struct {
+	client *telnyx.TelnyxClient
+}
+
+type NotificationProfileResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func (r *NotificationProfileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_profile"
+}
+
+func (r *NotificationProfileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for managing Telnyx Notification Profiles, which group the channels alerts are delivered to",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the notification profile",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the notification profile",
+				Required:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "ISO 8601 formatted date indicating when the resource was created",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "ISO 8601 formatted date indicating when the resource was updated",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *NotificationProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		client, ok := req.ProviderData.(*telnyx.TelnyxClient)
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Unexpected Resource Configure Type",
+				fmt.Sprintf("Expected *telnyx.TelnyxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			)
+			return
+		}
+		r.client = client
+		tflog.Info(ctx, "Configured Telnyx client for NotificationProfileResource")
+	}
+}
+
+func (r *NotificationProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan NotificationProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := r.client.CreateNotificationProfile(ctx, telnyx.NotificationProfile{Name: plan.Name.ValueString()})
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error creating notification profile", err)
+		return
+	}
+
+	tflog.Info(ctx, "Created Notification Profile", map[string]interface{}{"id": profile.ID, "name": profile.Name})
+
+	setNotificationProfileState(&plan, profile)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *NotificationProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state NotificationProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := r.client.GetNotificationProfile(ctx, state.ID.ValueString())
+	if err == nil {
+		setNotificationProfileState(&state, profile)
+		diags = resp.State.Set(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	if telnyx.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.AddError("Error reading notification profile", err.Error())
+}
+
+func (r *NotificationProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan NotificationProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := r.client.UpdateNotificationProfile(ctx, plan.ID.ValueString(), telnyx.NotificationProfile{Name: plan.Name.ValueString()})
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error updating notification profile", err)
+		return
+	}
+
+	setNotificationProfileState(&plan, profile)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *NotificationProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NotificationProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteNotificationProfile(ctx, state.ID.ValueString())
+	if err != nil && !telnyx.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting notification profile", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Notification Profile", map[string]interface{}{"id": state.ID.ValueString()})
+}
+
+func (r *NotificationProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func setNotificationProfileState(state *NotificationProfileResourceModel, profile *telnyx.NotificationProfile) {
+	state.ID = types.StringValue(profile.ID)
+	state.Name = types.StringValue(profile.Name)
+	state.CreatedAt = types.StringValue(profile.CreatedAt.String())
+	state.UpdatedAt = types.StringValue(profile.UpdatedAt.String())
+}
@@ -0,0 +1,276 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
+)
+
+var (
+	_ resource.Resource                = &NotificationSettingResource{}
+	_ resource.ResourceWithConfigure   = &NotificationSettingResource{}
+	_ resource.ResourceWithImportState = &NotificationSettingResource{}
+)
+
+func NewNotificationSettingResource() resource.Resource {
+	return &NotificationSettingResource{}
+}
+
+type NotificationSettingResource struct {
+	client *telnyx.TelnyxClient
+}
+
+type NotificationSettingResourceModel struct {
+	ID                           types.String `tfsdk:"id"`
+	NotificationEventConditionID types.String `tfsdk:"notification_event_condition_id"`
+	NotificationProfileID        types.String `tfsdk:"notification_profile_id"`
+	NotificationChannelID        types.String `tfsdk:"notification_channel_id"`
+	AssociatedRecordType         types.String `tfsdk:"associated_record_type"`
+	AssociatedRecordTypeValue    types.String `tfsdk:"associated_record_type_value"`
+	Parameters                   types.Map    `tfsdk:"parameters"`
+	Status                       types.String `tfsdk:"status"`
+	CreatedAt                    types.String `tfsdk:"created_at"`
+	UpdatedAt                    types.String `tfsdk:"updated_at"`
+}
+
+func (r *NotificationSettingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_setting"
+}
+
+func (r *NotificationSettingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for managing Telnyx Notification Settings, which deliver the events matching an event condition to a notification channel. " +
+			"Telnyx cannot change a setting once it is created, so every change replaces it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the notification setting",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"notification_event_condition_id": schema.StringAttribute{
+				Description: "ID of the event condition to be notified about, such as a port status change or a low balance",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"notification_profile_id": schema.StringAttribute{
+				Description: "ID of the notification profile the channel belongs to",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"notification_channel_id": schema.StringAttribute{
+				Description: "ID of the notification channel alerts are delivered to",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"associated_record_type": schema.StringAttribute{
+				Description: "Type of record the events are about, such as account, phone_number or connection. Defaults to the type the event condition is about",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"associated_record_type_value": schema.StringAttribute{
+				Description: "ID of the record the events are about, such as a phone number or connection ID. Leave unset for account wide events",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parameters": schema.MapAttribute{
+				Description: "Parameters of the event condition by name, such as the amount of a low balance alert",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the setting. Telnyx confirms new settings in the background, so it may read enable-received or enable-pending at first",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "ISO 8601 formatted date indicating when the resource was created",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "ISO 8601 formatted date indicating when the resource was updated",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *NotificationSettingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		client, ok := req.ProviderData.(*telnyx.TelnyxClient)
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Unexpected Resource Configure Type",
+				fmt.Sprintf("Expected *telnyx.TelnyxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			)
+			return
+		}
+		r.client = client
+		tflog.Info(ctx, "Configured Telnyx client for NotificationSettingResource")
+	}
+}
+
+func (r *NotificationSettingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan NotificationSettingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var parameters map[string]string
+	if !plan.Parameters.IsNull() {
+		resp.Diagnostics.Append(plan.Parameters.ElementsAs(ctx, &parameters, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	setting := telnyx.NotificationSetting{
+		NotificationEventConditionID: plan.NotificationEventConditionID.ValueString(),
+		NotificationProfileID:        plan.NotificationProfileID.ValueString(),
+		NotificationChannelID:        plan.NotificationChannelID.ValueString(),
+		AssociatedRecordType:         plan.AssociatedRecordType.ValueString(),
+		AssociatedRecordTypeValue:    plan.AssociatedRecordTypeValue.ValueString(),
+	}
+	// Sort by name so the request is the same on every run
+	for _, name := range sortedParameterNames(parameters) {
+		setting.Parameters = append(setting.Parameters, telnyx.NotificationParameter{Name: name, Value: parameters[name]})
+	}
+
+	created, err := r.client.CreateNotificationSetting(ctx, setting)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error creating notification setting", err)
+		return
+	}
+
+	tflog.Info(ctx, "Created Notification Setting", map[string]interface{}{"id": created.ID, "status": created.Status})
+
+	resp.Diagnostics.Append(setNotificationSettingState(&plan, created)...)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *NotificationSettingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state NotificationSettingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setting, err := r.client.GetNotificationSetting(ctx, state.ID.ValueString())
+	if err == nil {
+		resp.Diagnostics.Append(setNotificationSettingState(&state, setting)...)
+		diags = resp.State.Set(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	if telnyx.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.AddError("Error reading notification setting", err.Error())
+}
+
+// Update is never called with a change Telnyx could apply, since every
+// configurable attribute requires replacement; it only stores the plan.
+func (r *NotificationSettingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan NotificationSettingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *NotificationSettingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NotificationSettingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteNotificationSetting(ctx, state.ID.ValueString())
+	if err != nil && !telnyx.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting notification setting", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Notification Setting", map[string]interface{}{"id": state.ID.ValueString()})
+}
+
+func (r *NotificationSettingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func sortedParameterNames(parameters map[string]string) []string {
+	names := make([]string, 0, len(parameters))
+	for name := range parameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func setNotificationSettingState(state *NotificationSettingResourceModel, setting *telnyx.NotificationSetting) diag.Diagnostics {
+	state.ID = types.StringValue(setting.ID)
+	state.NotificationEventConditionID = types.StringValue(setting.NotificationEventConditionID)
+	state.NotificationProfileID = types.StringValue(setting.NotificationProfileID)
+	state.NotificationChannelID = types.StringValue(setting.NotificationChannelID)
+	state.AssociatedRecordType = types.StringValue(setting.AssociatedRecordType)
+	state.AssociatedRecordTypeValue = types.StringNull()
+	if setting.AssociatedRecordTypeValue != "" {
+		state.AssociatedRecordTypeValue = types.StringValue(setting.AssociatedRecordTypeValue)
+	}
+	state.Status = types.StringValue(setting.Status)
+	state.CreatedAt = types.StringValue(setting.CreatedAt.String())
+	state.UpdatedAt = types.StringValue(setting.UpdatedAt.String())
+
+	state.Parameters = types.MapNull(types.StringType)
+	if len(setting.Parameters) == 0 {
+		return nil
+	}
+	parameters := make(map[string]attr.Value, len(setting.Parameters))
+	for _, parameter := range setting.Parameters {
+		parameters[parameter.Name] = types.StringValue(parameter.Value)
+	}
+	var diags diag.Diagnostics
+	state.Parameters, diags = types.MapValue(types.StringType, parameters)
+	return diags
+}
@@ -0,0 +1,293 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
+)
+
+var (
+	_ datasource.DataSource                   = &NumberLookupDataSource{}
+	_ datasource.DataSourceWithConfigure      = &NumberLookupDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &NumberLookupDataSource{}
+)
+
+var numberLookupTypes = []string{telnyx.LookupTypeCarrier, telnyx.LookupTypeCallerName}
+
+func NewNumberLookupDataSource() datasource.DataSource {
+	return &NumberLookupDataSource{}
+}
+
+type NumberLookupDataSource struct {
+	client *telnyx.TelnyxClient
+}
+
+type NumberLookupDataSourceModel struct {
+	PhoneNumber    types.String `tfsdk:"phone_number"`
+	LookupTypes    types.List   `tfsdk:"lookup_types"`
+	CountryCode    types.String `tfsdk:"country_code"`
+	NationalFormat types.String `tfsdk:"national_format"`
+	LineType       types.String `tfsdk:"line_type"`
+	Ported         types.Bool   `tfsdk:"ported"`
+	Carrier        types.Object `tfsdk:"carrier"`
+	CallerName     types.Object `tfsdk:"caller_name"`
+	Portability    types.Object `tfsdk:"portability"`
+}
+
+var numberLookupCarrierTypes = map[string]attr.Type{
+	"name":                types.StringType,
+	"normalized_carrier":  types.StringType,
+	"type":                types.StringType,
+	"mobile_country_code": types.StringType,
+	"mobile_network_code": types.StringType,
+	"error_code":          types.StringType,
+}
+
+var numberLookupCallerNameTypes = map[string]attr.Type{
+	"caller_name": types.StringType,
+	"error_code":  types.StringType,
+}
+
+var numberLookupPortabilityTypes = map[string]attr.Type{
+	"lrn":               types.StringType,
+	"ported_status":     types.StringType,
+	"ported_date":       types.StringType,
+	"ocn":               types.StringType,
+	"line_type":         types.StringType,
+	"spid":              types.StringType,
+	"spid_carrier_name": types.StringType,
+	"spid_carrier_type": types.StringType,
+	"city":              types.StringType,
+	"state":             types.StringType,
+}
+
+func (d *NumberLookupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_number_lookup"
+}
+
+func (d *NumberLookupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up the carrier, line type, portability and caller name of any phone number, for example to check a customer's number in a precondition before routing to it. " +
+			"Telnyx bills every lookup, and the lookup runs again on every plan.",
+		Attributes: map[string]schema.Attribute{
+			"phone_number": schema.StringAttribute{
+				Description: "Number to look up, in E.164 format",
+				Required:    true,
+			},
+			"lookup_types": schema.ListAttribute{
+				Description: "Optional parts of the lookup to perform: " + strings.Join(numberLookupTypes, " and ") + ". Portability is always looked up",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"country_code": schema.StringAttribute{
+				Description: "ISO 3166-1 alpha-2 country of the number",
+				Computed:    true,
+			},
+			"national_format": schema.StringAttribute{
+				Description: "Number formatted the way it is dialled within its country",
+				Computed:    true,
+			},
+			"line_type": schema.StringAttribute{
+				Description: "Line type of the number, such as mobile, fixed line, voip or toll free. Taken from the carrier lookup when it was performed, otherwise from portability",
+				Computed:    true,
+			},
+			"ported": schema.BoolAttribute{
+				Description: "Whether the number has been ported away from the carrier it was first assigned to",
+				Computed:    true,
+			},
+			"carrier": schema.SingleNestedAttribute{
+				Description: "Carrier of the number. Null unless lookup_types includes carrier",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Name of the carrier",
+						Computed:    true,
+					},
+					"normalized_carrier": schema.StringAttribute{
+						Description: "Carrier name normalized across lookups",
+						Computed:    true,
+					},
+					"type": schema.StringAttribute{
+						Description: "Line type reported by the carrier",
+						Computed:    true,
+					},
+					"mobile_country_code": schema.StringAttribute{
+						Description: "Mobile country code of the carrier",
+						Computed:    true,
+					},
+					"mobile_network_code": schema.StringAttribute{
+						Description: "Mobile network code of the carrier",
+						Computed:    true,
+					},
+					"error_code": schema.StringAttribute{
+						Description: "Error Telnyx reported for the carrier lookup, if any",
+						Computed:    true,
+					},
+				},
+			},
+			"caller_name": schema.SingleNestedAttribute{
+				Description: "Caller name (CNAM) of the number. Null unless lookup_types includes caller-name",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"caller_name": schema.StringAttribute{
+						Description: "Caller name registered for the number",
+						Computed:    true,
+					},
+					"error_code": schema.StringAttribute{
+						Description: "Error Telnyx reported for the caller name lookup, if any",
+						Computed:    true,
+					},
+				},
+			},
+			"portability": schema.SingleNestedAttribute{
+				Description: "Portability of the number",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"lrn": schema.StringAttribute{
+						Description: "Local routing number",
+						Computed:    true,
+					},
+					"ported_status": schema.StringAttribute{
+						Description: "Y when the number has been ported, N otherwise",
+						Computed:    true,
+					},
+					"ported_date": schema.StringAttribute{
+						Description: "Date the number was last ported",
+						Computed:    true,
+					},
+					"ocn": schema.StringAttribute{
+						Description: "Operating company number of the current carrier",
+						Computed:    true,
+					},
+					"line_type": schema.StringAttribute{
+						Description: "Line type of the number",
+						Computed:    true,
+					},
+					"spid": schema.StringAttribute{
+						Description: "Service provider ID of the current carrier",
+						Computed:    true,
+					},
+					"spid_carrier_name": schema.StringAttribute{
+						Description: "Name of the current carrier",
+						Computed:    true,
+					},
+					"spid_carrier_type": schema.StringAttribute{
+						Description: "Type of the current carrier",
+						Computed:    true,
+					},
+					"city": schema.StringAttribute{
+						Description: "City the number belongs to",
+						Computed:    true,
+					},
+					"state": schema.StringAttribute{
+						Description: "State the number belongs to",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *NumberLookupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = dataSourceClient(ctx, req, resp, "NumberLookupDataSource")
+}
+
+func (d *NumberLookupDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var lookupTypes types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("lookup_types"), &lookupTypes)...)
+	if resp.Diagnostics.HasError() || lookupTypes.IsNull() || lookupTypes.IsUnknown() {
+		return
+	}
+	for i, element := range lookupTypes.Elements() {
+		lookupType, ok := element.(types.String)
+		if !ok || lookupType.IsNull() || lookupType.IsUnknown() || slices.Contains(numberLookupTypes, lookupType.ValueString()) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("lookup_types").AtListIndex(i),
+			"Invalid lookup type",
+			fmt.Sprintf("lookup_types must only contain %s, got %q.", strings.Join(numberLookupTypes, " and "), lookupType.ValueString()),
+		)
+	}
+}
+
+func (d *NumberLookupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state NumberLookupDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var lookupTypes []string
+	if !state.LookupTypes.IsNull() {
+		resp.Diagnostics.Append(state.LookupTypes.ElementsAs(ctx, &lookupTypes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	lookup, err := d.client.LookupNumber(ctx, state.PhoneNumber.ValueString(), lookupTypes)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("phone_number"), "Error looking up phone number", err.Error())
+		return
+	}
+
+	state.CountryCode = types.StringValue(lookup.CountryCode)
+	state.NationalFormat = types.StringValue(lookup.NationalFormat)
+	state.LineType = types.StringNull()
+	state.Ported = types.BoolNull()
+	state.Carrier = types.ObjectNull(numberLookupCarrierTypes)
+	state.CallerName = types.ObjectNull(numberLookupCallerNameTypes)
+	state.Portability = types.ObjectNull(numberLookupPortabilityTypes)
+	if portability := lookup.Portability; portability != nil {
+		state.LineType = stringOrNull(portability.LineType)
+		state.Ported = types.BoolValue(portability.PortedStatus == "Y")
+		state.Portability = types.ObjectValueMust(numberLookupPortabilityTypes, map[string]attr.Value{
+			"lrn":               types.StringValue(portability.LRN),
+			"ported_status":     types.StringValue(portability.PortedStatus),
+			"ported_date":       types.StringValue(portability.PortedDate),
+			"ocn":               types.StringValue(portability.OCN),
+			"line_type":         types.StringValue(portability.LineType),
+			"spid":              types.StringValue(portability.SPID),
+			"spid_carrier_name": types.StringValue(portability.SPIDCarrierName),
+			"spid_carrier_type": types.StringValue(portability.SPIDCarrierType),
+			"city":              types.StringValue(portability.City),
+			"state":             types.StringValue(portability.State),
+		})
+	}
+	if carrier := lookup.Carrier; carrier != nil {
+		if carrier.Type != "" {
+			state.LineType = types.StringValue(carrier.Type)
+		}
+		state.Carrier = types.ObjectValueMust(numberLookupCarrierTypes, map[string]attr.Value{
+			"name":                types.StringValue(carrier.Name),
+			"normalized_carrier":  types.StringValue(carrier.NormalizedCarrier),
+			"type":                types.StringValue(carrier.Type),
+			"mobile_country_code": types.StringValue(carrier.MobileCountryCode),
+			"mobile_network_code": types.StringValue(carrier.MobileNetworkCode),
+			"error_code":          stringOrNull(carrier.ErrorCode),
+		})
+	}
+	if callerName := lookup.CallerName; callerName != nil {
+		state.CallerName = types.ObjectValueMust(numberLookupCallerNameTypes, map[string]attr.Value{
+			"caller_name": types.StringValue(callerName.CallerName),
+			"error_code":  stringOrNull(callerName.ErrorCode),
+		})
+	}
+
+	tflog.Info(ctx, "Looked up phone number", map[string]interface{}{"phone_number": lookup.PhoneNumber, "line_type": state.LineType.ValueString()})
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
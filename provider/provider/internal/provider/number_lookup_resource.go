@@ -6,7 +6,6 @@ import (
 	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -28,6 +27,11 @@ func (r *PhoneNumberLookupResource) Metadata(ctx context.Context, req resource.M
 
 func (r *PhoneNumberLookupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Description: "Searches available phone numbers once and keeps the results in state. Deprecated in favour of the telnyx_available_phone_numbers data source.",
+		DeprecationMessage: "Use the telnyx_available_phone_numbers data source instead. Terraform cannot move resource state into a data source, " +
+			"but this resource only keeps a search result in state: deleting it makes no API call, so replacing it with the data source " +
+			"loses nothing in Telnyx. Copy the numbers you have already ordered or reserved into those resources before switching, " +
+			"because the data source searches again on every plan.",
 		Attributes: map[string]schema.Attribute{
 			"starts_with": schema.StringAttribute{
 				Optional: true,
@@ -173,7 +177,7 @@ func (r *PhoneNumberLookupResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	client := r.client
-	response, err := client.ListAvailablePhoneNumbers(filters)
+	response, err := client.ListAvailablePhoneNumbers(ctx, filters)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error retrieving available phone numbers",
@@ -263,7 +267,8 @@ func (r *PhoneNumberLookupResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	// No API call is needed, just return the current state
+	// The search is kept as it was when the resource was created, so that
+	// numbers ordered from it do not change underneath the orders
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -277,11 +282,6 @@ func (r *PhoneNumberLookupResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *PhoneNumberLookupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Remove the resource from the state
+	// Nothing exists in Telnyx, so deleting only forgets the search
 	resp.State.RemoveResource(ctx)
 }
-
-// Implement ImportState function
-func (r *PhoneNumberLookupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-}
@@ -3,8 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -16,7 +20,8 @@ import (
 )
 
 var (
-	_ resource.Resource = &NumberOrderResource{}
+	_ resource.Resource                = &NumberOrderResource{}
+	_ resource.ResourceWithImportState = &NumberOrderResource{}
 )
 
 func NewNumberOrderResource() resource.Resource {
@@ -38,12 +43,14 @@ type NumberOrderResourceModel struct {
 	UpdatedAt          types.String `tfsdk:"updated_at"`
 	PhoneNumbers       types.List   `tfsdk:"phone_numbers"`
 	SubNumberOrderIDs  types.List   `tfsdk:"sub_number_orders_ids"`
+	RequirementValues  types.Map    `tfsdk:"requirement_values"`
 }
 
-type PhoneNumberResourceModel struct {
+type NumberOrderPhoneNumberModel struct {
 	ID                     types.String `tfsdk:"id"`
 	PhoneNumber            types.String `tfsdk:"phone_number"`
 	Status                 types.String `tfsdk:"status"`
+	RequirementGroupID     types.String `tfsdk:"requirement_group_id"`
 	RegulatoryRequirements types.List   `tfsdk:"regulatory_requirements"`
 }
 
@@ -53,11 +60,12 @@ type RegulatoryRequirementResourceModel struct {
 	FieldType     types.String `tfsdk:"field_type"`
 }
 
-func (p PhoneNumberResourceModel) AttrTypes() map[string]attr.Type {
+func (p NumberOrderPhoneNumberModel) AttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
 		"id":                      types.StringType,
 		"phone_number":            types.StringType,
 		"status":                  types.StringType,
+		"requirement_group_id":    types.StringType,
 		"regulatory_requirements": types.ListType{ElemType: types.ObjectType{AttrTypes: RegulatoryRequirementResourceModel{}.AttrTypes()}},
 	}
 }
@@ -129,11 +137,15 @@ func (r *NumberOrderResource) Schema(ctx context.Context, req resource.SchemaReq
 							Required:    true,
 						},
 						"status": schema.StringAttribute{
-							Description: "Status of the phone number",
+							Description: "Status of the phone number, pending until its regulatory requirements are met",
 							Computed:    true,
 						},
+						"requirement_group_id": schema.StringAttribute{
+							Description: "ID of an approved requirement group that fulfils the regulatory requirements of the phone number",
+							Optional:    true,
+						},
 						"regulatory_requirements": schema.ListNestedAttribute{
-							Description: "List of regulatory requirements for the phone number",
+							Description: "Regulatory requirement values Telnyx holds for the phone number",
 							Computed:    true,
 							NestedObject: schema.NestedAttributeObject{
 								Attributes: map[string]schema.Attribute{
@@ -160,6 +172,11 @@ func (r *NumberOrderResource) Schema(ctx context.Context, req resource.SchemaReq
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"requirement_values": schema.MapAttribute{
+				Description: "Values for the regulatory requirements of the ordered numbers, keyed by requirement ID. Document requirements take the ID of an uploaded document. Each value is given to every number that has the requirement.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
 		},
 	}
 }
@@ -193,9 +210,22 @@ func (r *NumberOrderResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	var numbers []string
+	for _, phoneNumber := range phoneNumbers {
+		numbers = append(numbers, phoneNumber.PhoneNumber.ValueString())
+	}
+	requirements := r.lookupRequirements(ctx, plan.RequirementValues, numbers, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var phoneNumbersRequest []telnyx.PhoneNumberRequest
 	for _, phoneNumber := range phoneNumbers {
-		phoneNumbersRequest = append(phoneNumbersRequest, telnyx.PhoneNumberRequest{PhoneNumber: phoneNumber.PhoneNumber.ValueString()})
+		phoneNumbersRequest = append(phoneNumbersRequest, telnyx.PhoneNumberRequest{
+			PhoneNumber:            phoneNumber.PhoneNumber.ValueString(),
+			RequirementGroupID:     phoneNumber.RequirementGroupID.ValueString(),
+			RegulatoryRequirements: requirementValuesFor(ctx, plan.RequirementValues, requirements[phoneNumber.PhoneNumber.ValueString()]),
+		})
 	}
 
 	request := telnyx.CreateNumberOrderRequest{
@@ -206,9 +236,9 @@ func (r *NumberOrderResource) Create(ctx context.Context, req resource.CreateReq
 		CustomerReference:  plan.CustomerReference.ValueString(),
 	}
 
-	order, err := r.client.CreateNumberOrder(request)
+	order, err := r.client.CreateNumberOrder(ctx, request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating number order", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error creating number order", err)
 		return
 	}
 
@@ -217,6 +247,7 @@ func (r *NumberOrderResource) Create(ctx context.Context, req resource.CreateReq
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	r.warnUnmetRequirements(ctx, order, requirements, &resp.Diagnostics)
 }
 
 func (r *NumberOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -227,7 +258,7 @@ func (r *NumberOrderResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	order, err := r.client.GetNumberOrder(state.ID.ValueString())
+	order, err := r.client.GetNumberOrder(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading number order", err.Error())
 		return
@@ -240,22 +271,68 @@ func (r *NumberOrderResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 func (r *NumberOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan NumberOrderResourceModel
-	diags := req.Plan.Get(ctx, &plan)
+	var plan, state NumberOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	phoneNumbers, diags := ConvertListToPhoneNumbers(ctx, plan.PhoneNumbers)
+	resp.Diagnostics.Append(diags...)
+	currentNumbers, diags := ConvertListToPhoneNumbers(ctx, state.PhoneNumbers)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// TODO Prepare the request with an empty array for regulatory requirements until we support this prop
+	var numbers []string
+	for _, phoneNumber := range phoneNumbers {
+		numbers = append(numbers, phoneNumber.PhoneNumber.ValueString())
+	}
+	requirements := r.lookupRequirements(ctx, plan.RequirementValues, numbers, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Requirement groups are assigned number by number, so a number the
+	// order is still holding can be fulfilled by a newly approved group
+	current := map[string]NumberOrderPhoneNumberModel{}
+	for _, phoneNumber := range currentNumbers {
+		current[phoneNumber.PhoneNumber.ValueString()] = phoneNumber
+	}
+	for _, phoneNumber := range phoneNumbers {
+		existing, ok := current[phoneNumber.PhoneNumber.ValueString()]
+		if !ok || phoneNumber.RequirementGroupID.ValueString() == "" || phoneNumber.RequirementGroupID.Equal(existing.RequirementGroupID) {
+			continue
+		}
+		if _, err := r.client.UpdateNumberOrderPhoneNumberRequirementGroup(ctx, existing.ID.ValueString(), phoneNumber.RequirementGroupID.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("phone_numbers"), "Error assigning requirement group", fmt.Sprintf("Could not assign requirement group %s to %s: %s", phoneNumber.RequirementGroupID.ValueString(), phoneNumber.PhoneNumber.ValueString(), err))
+			return
+		}
+	}
+
+	// Telnyx applies each value to every number of the order that has the
+	// requirement, so the values are sent once for the whole order
+	values := []telnyx.NumberOrderRegulatoryRequirement{}
+	seen := map[string]bool{}
+	for _, phoneNumber := range numbers {
+		for _, value := range requirementValuesFor(ctx, plan.RequirementValues, requirements[phoneNumber]) {
+			if !seen[value.RequirementID] {
+				seen[value.RequirementID] = true
+				values = append(values, value)
+			}
+		}
+	}
+
 	request := telnyx.UpdateNumberOrderRequest{
 		CustomerReference:      plan.CustomerReference.ValueString(),
-		RegulatoryRequirements: []telnyx.NumberOrderRegulatoryRequirement{}, // Ensure this is always an empty array
+		RegulatoryRequirements: values,
 	}
 
-	order, err := r.client.UpdateNumberOrder(plan.ID.ValueString(), request)
+	order, err := r.client.UpdateNumberOrder(ctx, plan.ID.ValueString(), request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating number order", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error updating number order", err)
 		return
 	}
 
@@ -264,6 +341,7 @@ func (r *NumberOrderResource) Update(ctx context.Context, req resource.UpdateReq
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	r.warnUnmetRequirements(ctx, order, requirements, &resp.Diagnostics)
 }
 
 func (r *NumberOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -283,13 +361,13 @@ func (r *NumberOrderResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	// Cancel sub number orders if they exist
 	for _, subOrderID := range subNumberOrderIDs {
-		if subOrder, err := r.client.GetSubNumberOrder(subOrderID); err == nil && subOrder.Status == "deleted" {
+		if subOrder, err := r.client.GetSubNumberOrder(ctx, subOrderID); err == nil && subOrder.Status == "deleted" {
 			continue
 		}
-		fmt.Printf("Cancelling sub number order with ID: %s\n", subOrderID)
-		_, err := r.client.CancelSubNumberOrder(subOrderID)
+		tflog.Info(ctx, "Cancelling sub number order", map[string]interface{}{"id": subOrderID})
+		_, err := r.client.CancelSubNumberOrder(ctx, subOrderID)
 		if err != nil {
-			fmt.Printf("Error cancelling sub number order ID: %s, Error: %s\n", subOrderID, err.Error())
+			tflog.Error(ctx, "Error cancelling sub number order", map[string]interface{}{"id": subOrderID, "error": err.Error()})
 			resp.Diagnostics.AddError("Error cancelling sub number order", fmt.Sprintf("ID: %s, Error: %s", subOrderID, err.Error()))
 			return
 		}
@@ -298,18 +376,27 @@ func (r *NumberOrderResource) Delete(ctx context.Context, req resource.DeleteReq
 	resp.State.RemoveResource(ctx)
 }
 
+func (r *NumberOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
 func setStateFromOrderResponse(state *NumberOrderResourceModel, order *telnyx.PhoneNumberOrderResponse) {
 	state.ID = types.StringValue(order.ID)
+	state.ConnectionID = types.StringValue(order.ConnectionID)
+	state.MessagingProfileID = types.StringValue(order.MessagingProfileID)
+	state.BillingGroupID = types.StringValue(order.BillingGroupID)
+	state.CustomerReference = stringOrNull(order.CustomerReference)
 	state.Status = types.StringValue(order.Status)
 	state.CreatedAt = types.StringValue(order.CreatedAt.String())
 	state.UpdatedAt = types.StringValue(order.UpdatedAt.String())
 
-	var phoneNumbersModel []PhoneNumberResourceModel
+	var phoneNumbersModel []NumberOrderPhoneNumberModel
 	for _, pn := range order.PhoneNumbers {
-		phoneNumberModel := PhoneNumberResourceModel{
-			ID:          types.StringValue(pn.ID),
-			PhoneNumber: types.StringValue(pn.PhoneNumber),
-			Status:      types.StringValue(pn.Status),
+		phoneNumberModel := NumberOrderPhoneNumberModel{
+			ID:                 types.StringValue(pn.ID),
+			PhoneNumber:        types.StringValue(pn.PhoneNumber),
+			Status:             types.StringValue(pn.Status),
+			RequirementGroupID: stringOrNull(pn.RequirementGroupID),
 		}
 
 		if pn.RegulatoryRequirements != nil {
@@ -333,3 +420,116 @@ func setStateFromOrderResponse(state *NumberOrderResourceModel, order *telnyx.Ph
 	state.PhoneNumbers, _ = ConvertPhoneNumbersToList(context.Background(), phoneNumbersModel)
 	state.SubNumberOrderIDs = convertStringsToList(order.SubNumberOrderIDs)
 }
+
+// lookupRequirements returns the regulatory requirements of each phone
+// number, and reports requirement_values entries that none of the numbers
+// has. Nothing is looked up when no values are configured.
+func (r *NumberOrderResource) lookupRequirements(ctx context.Context, configured types.Map, phoneNumbers []string, diags *diag.Diagnostics) map[string][]telnyx.RegulatoryRequirement {
+	if len(configured.Elements()) == 0 {
+		return nil
+	}
+	found, err := r.client.ListPhoneNumberRegulatoryRequirements(ctx, phoneNumbers)
+	if err != nil {
+		diags.AddError("Error looking up regulatory requirements", err.Error())
+		return nil
+	}
+
+	requirements := map[string][]telnyx.RegulatoryRequirement{}
+	known := map[string]bool{}
+	var names []string
+	for _, number := range found {
+		requirements[number.PhoneNumber] = number.RegulatoryRequirements
+		for _, requirement := range number.RegulatoryRequirements {
+			if !known[requirement.ID] {
+				known[requirement.ID] = true
+				names = append(names, fmt.Sprintf("%s (%s)", requirement.ID, requirement.Name))
+			}
+		}
+	}
+	sort.Strings(names)
+	for requirementID := range configured.Elements() {
+		if known[requirementID] {
+			continue
+		}
+		detail := fmt.Sprintf("None of the ordered numbers has the regulatory requirement %q.", requirementID)
+		if len(names) > 0 {
+			detail += " The numbers require: " + strings.Join(names, ", ")
+		} else {
+			detail += " The numbers have no regulatory requirements."
+		}
+		diags.AddAttributeError(path.Root("requirement_values").AtMapKey(requirementID), "Unknown regulatory requirement", detail)
+	}
+	return requirements
+}
+
+// requirementValuesFor returns the configured values for the given
+// requirements of one phone number.
+func requirementValuesFor(ctx context.Context, configured types.Map, requirements []telnyx.RegulatoryRequirement) []telnyx.NumberOrderRegulatoryRequirement {
+	var values map[string]string
+	configured.ElementsAs(ctx, &values, false)
+
+	var result []telnyx.NumberOrderRegulatoryRequirement
+	for _, requirement := range requirements {
+		if value, ok := values[requirement.ID]; ok {
+			result = append(result, telnyx.NumberOrderRegulatoryRequirement{
+				RequirementID: requirement.ID,
+				FieldValue:    value,
+				FieldType:     requirement.FieldType,
+			})
+		}
+	}
+	return result
+}
+
+// warnUnmetRequirements warns about numbers Telnyx is holding until their
+// regulatory requirements are met, naming the requirements still missing.
+// Such numbers are not on the account yet.
+func (r *NumberOrderResource) warnUnmetRequirements(ctx context.Context, order *telnyx.PhoneNumberOrderResponse, requirements map[string][]telnyx.RegulatoryRequirement, diags *diag.Diagnostics) {
+	var pending []telnyx.OrderResponsePhoneNumbers
+	var phoneNumbers []string
+	for _, number := range order.PhoneNumbers {
+		if !number.RequirementsMet && number.Status != "cancelled" {
+			pending = append(pending, number)
+			phoneNumbers = append(phoneNumbers, number.PhoneNumber)
+		}
+	}
+	if len(pending) == 0 {
+		return
+	}
+	if requirements == nil {
+		if found, err := r.client.ListPhoneNumberRegulatoryRequirements(ctx, phoneNumbers); err == nil {
+			requirements = map[string][]telnyx.RegulatoryRequirement{}
+			for _, number := range found {
+				requirements[number.PhoneNumber] = number.RegulatoryRequirements
+			}
+		} else {
+			tflog.Warn(ctx, "Could not look up regulatory requirements", map[string]interface{}{"error": err.Error()})
+		}
+	}
+
+	var lines []string
+	for _, number := range pending {
+		given := map[string]bool{}
+		for _, value := range number.RegulatoryRequirements {
+			given[value.RequirementID] = true
+		}
+		var missing []string
+		for _, requirement := range requirements[number.PhoneNumber] {
+			if !given[requirement.ID] {
+				missing = append(missing, fmt.Sprintf("%s (%s, %s)", requirement.ID, requirement.Name, requirement.FieldType))
+			}
+		}
+		line := fmt.Sprintf("%s is %s", number.PhoneNumber, number.Status)
+		if number.RequirementGroupID != "" {
+			line += fmt.Sprintf(" with requirement group %s", number.RequirementGroupID)
+		}
+		if len(missing) > 0 {
+			line += ", missing " + strings.Join(missing, ", ")
+		}
+		lines = append(lines, line)
+	}
+	diags.AddWarning(
+		"Regulatory requirements not met",
+		fmt.Sprintf("Telnyx holds these numbers until their regulatory requirements are met:\n%s\n\nSet requirement_values or an approved requirement_group_id and apply again.", strings.Join(lines, "\n")),
+	)
+}
@@ -0,0 +1,386 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
+)
+
+var (
+	_ resource.Resource                   = &NumberReservationResource{}
+	_ resource.ResourceWithValidateConfig = &NumberReservationResource{}
+	_ resource.ResourceWithImportState    = &NumberReservationResource{}
+)
+
+// defaultExtendBefore is how close to expiry a reservation with auto_extend
+// gets extended. Telnyx holds numbers for 30 minutes at a time.
+const defaultExtendBefore = "10m"
+
+func NewNumberReservationResource() resource.Resource {
+	return &NumberReservationResource{}
+}
+
+type NumberReservationResource struct {
+	client *telnyx.TelnyxClient
+}
+
+type NumberReservationResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	CustomerReference types.String `tfsdk:"customer_reference"`
+	PhoneNumbers      types.List   `tfsdk:"phone_numbers"`
+	AutoExtend        types.Bool   `tfsdk:"auto_extend"`
+	ExtendBefore      types.String `tfsdk:"extend_before"`
+	Status            types.String `tfsdk:"status"`
+	ExpiredAt         types.String `tfsdk:"expired_at"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+}
+
+type ReservedPhoneNumberModel struct {
+	ID          types.String `tfsdk:"id"`
+	PhoneNumber types.String `tfsdk:"phone_number"`
+	Status      types.String `tfsdk:"status"`
+	ExpiredAt   types.String `tfsdk:"expired_at"`
+}
+
+func (m ReservedPhoneNumberModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":           types.StringType,
+		"phone_number": types.StringType,
+		"status":       types.StringType,
+		"expired_at":   types.StringType,
+	}
+}
+
+func (r *NumberReservationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_number_reservation"
+}
+
+func (r *NumberReservationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for managing Telnyx Number Reservations, which hold available phone numbers for the account before they are ordered. " +
+			"Telnyx releases reserved numbers when the reservation expires; with auto_extend set, every refresh extends a reservation that is close to expiry. " +
+			"An expired reservation is removed from state so the next apply reserves the numbers again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the number reservation",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_reference": schema.StringAttribute{
+				Description: "Customer reference for the reservation. Number orders with the same customer reference may order the reserved numbers",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"phone_numbers": schema.ListNestedAttribute{
+				Description: "Phone numbers to reserve",
+				Required:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Unique identifier of the reserved phone number",
+							Computed:    true,
+						},
+						"phone_number": schema.StringAttribute{
+							Description: "Phone number in E.164 format",
+							Required:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the reservation for this number",
+							Computed:    true,
+						},
+						"expired_at": schema.StringAttribute{
+							Description: "RFC 3339 time at which the number is released",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"auto_extend": schema.BoolAttribute{
+				Description: "Extend the reservation during refresh when it expires within extend_before. Defaults to false",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"extend_before": schema.StringAttribute{
+				Description: "How close to expiry a reservation with auto_extend is extended, as a Go duration. Defaults to " + defaultExtendBefore,
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultExtendBefore),
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the number reservation",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expired_at": schema.StringAttribute{
+				Description: "RFC 3339 time at which the first of the reserved numbers is released",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation time of the number reservation",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *NumberReservationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config NumberReservationResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ExtendBefore.IsUnknown() {
+		parseDurationAttribute(config.ExtendBefore, "extend_before", 0, &resp.Diagnostics)
+	}
+
+	if config.PhoneNumbers.IsUnknown() || config.PhoneNumbers.IsNull() {
+		return
+	}
+	var phoneNumbers []ReservedPhoneNumberModel
+	resp.Diagnostics.Append(config.PhoneNumbers.ElementsAs(ctx, &phoneNumbers, false)...)
+	for i, phoneNumber := range phoneNumbers {
+		if phoneNumber.PhoneNumber.IsUnknown() || e164Pattern.MatchString(phoneNumber.PhoneNumber.ValueString()) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("phone_numbers").AtListIndex(i).AtName("phone_number"),
+			"Invalid phone number",
+			fmt.Sprintf("%q is not in E.164 format, e.g. +13125550100.", phoneNumber.PhoneNumber.ValueString()),
+		)
+	}
+}
+
+func (r *NumberReservationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		client, ok := req.ProviderData.(*telnyx.TelnyxClient)
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Unexpected Resource Configure Type",
+				fmt.Sprintf("Expected *telnyx.TelnyxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			)
+			return
+		}
+		r.client = client
+		tflog.Info(ctx, "Configured Telnyx client for NumberReservationResource")
+	}
+}
+
+func (r *NumberReservationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan NumberReservationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var phoneNumbers []ReservedPhoneNumberModel
+	diags = plan.PhoneNumbers.ElementsAs(ctx, &phoneNumbers, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	numbers := make([]string, len(phoneNumbers))
+	for i, phoneNumber := range phoneNumbers {
+		numbers[i] = phoneNumber.PhoneNumber.ValueString()
+	}
+
+	reservation, err := r.client.CreateNumberReservation(ctx, numbers, plan.CustomerReference.ValueString())
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error creating number reservation", err)
+		return
+	}
+
+	resp.Diagnostics.Append(setNumberReservationState(&plan, reservation)...)
+
+	tflog.Info(ctx, "Created Number Reservation", map[string]interface{}{"id": reservation.ID, "expired_at": plan.ExpiredAt.ValueString()})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *NumberReservationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state NumberReservationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reservation, err := r.client.GetNumberReservation(ctx, state.ID.ValueString())
+	if telnyx.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading number reservation",
+			"Could not read number reservation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	expiredAt := earliestExpiry(reservation)
+	if reservation.Status == "expired" || (!expiredAt.IsZero() && time.Now().After(expiredAt)) {
+		tflog.Warn(ctx, "Number Reservation has expired, removing it from state", map[string]interface{}{"id": reservation.ID, "expired_at": expiredAt})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// A reservation with no expiry has nothing to extend.
+	if state.AutoExtend.ValueBool() && !expiredAt.IsZero() {
+		extendBefore, ok := parseDurationAttribute(state.ExtendBefore, "extend_before", 10*time.Minute, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		if time.Until(expiredAt) <= extendBefore {
+			reservation, err = r.client.ExtendPhoneNumberReservation(ctx, reservation.ID)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error extending number reservation",
+					"Could not extend number reservation, unexpected error: "+err.Error(),
+				)
+				return
+			}
+			tflog.Info(ctx, "Extended Number Reservation", map[string]interface{}{"id": reservation.ID, "expired_at": earliestExpiry(reservation)})
+		}
+	}
+
+	resp.Diagnostics.Append(setNumberReservationState(&state, reservation)...)
+	if state.AutoExtend.IsNull() {
+		state.AutoExtend = types.BoolValue(false)
+	}
+	if state.ExtendBefore.IsNull() {
+		state.ExtendBefore = types.StringValue(defaultExtendBefore)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only changes how the reservation is extended, since the numbers and
+// customer reference force a new reservation.
+func (r *NumberReservationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan NumberReservationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state NumberReservationResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reservation, err := r.client.GetNumberReservation(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading number reservation", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(setNumberReservationState(&plan, reservation)...)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *NumberReservationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NumberReservationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteNumberReservation(ctx, state.ID.ValueString())
+	if err != nil && !telnyx.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting number reservation",
+			"Could not delete number reservation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *NumberReservationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// earliestExpiry returns when the first number of the reservation is
+// released, or the zero time if none has an expiry.
+func earliestExpiry(reservation *telnyx.PhoneNumberReservation) time.Time {
+	var earliest time.Time
+	for _, phoneNumber := range reservation.PhoneNumbers {
+		if phoneNumber.ExpiredAt.IsZero() {
+			continue
+		}
+		if earliest.IsZero() || phoneNumber.ExpiredAt.Before(earliest) {
+			earliest = phoneNumber.ExpiredAt
+		}
+	}
+	return earliest
+}
+
+func setNumberReservationState(state *NumberReservationResourceModel, reservation *telnyx.PhoneNumberReservation) diag.Diagnostics {
+	state.ID = types.StringValue(reservation.ID)
+	state.Status = types.StringValue(reservation.Status)
+	state.CreatedAt = types.StringValue(reservation.CreatedAt.Format(time.RFC3339))
+	if reservation.CustomerReference != "" || !state.CustomerReference.IsNull() {
+		state.CustomerReference = types.StringValue(reservation.CustomerReference)
+	}
+
+	state.ExpiredAt = types.StringValue(formatExpiry(earliestExpiry(reservation)))
+
+	elements := make([]attr.Value, len(reservation.PhoneNumbers))
+	for i, phoneNumber := range reservation.PhoneNumbers {
+		elements[i] = types.ObjectValueMust(ReservedPhoneNumberModel{}.AttrTypes(), map[string]attr.Value{
+			"id":           types.StringValue(phoneNumber.ID),
+			"phone_number": types.StringValue(phoneNumber.PhoneNumber),
+			"status":       types.StringValue(phoneNumber.Status),
+			"expired_at":   types.StringValue(formatExpiry(phoneNumber.ExpiredAt)),
+		})
+	}
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: ReservedPhoneNumberModel{}.AttrTypes()}, elements)
+	state.PhoneNumbers = list
+	return diags
+}
+
+// formatExpiry formats an expiry time for state, or returns "" for a
+// reservation that does not expire.
+func formatExpiry(expiredAt time.Time) string {
+	if expiredAt.IsZero() {
+		return ""
+	}
+	return expiredAt.Format(time.RFC3339)
+}
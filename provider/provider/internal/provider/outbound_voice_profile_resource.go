@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -231,7 +232,7 @@ func (r *OutboundVoiceProfileResource) Create(ctx context.Context, req resource.
 		concurrentCallLimitPointer = &value
 	}
 
-	profile, err := r.client.CreateOutboundVoiceProfile(telnyx.OutboundVoiceProfile{
+	profile, err := r.client.CreateOutboundVoiceProfile(ctx, telnyx.OutboundVoiceProfile{
 		Name:                    plan.Name.ValueString(),
 		BillingGroupID:          plan.BillingGroupID.ValueString(),
 		TrafficType:             plan.TrafficType.ValueString(),
@@ -253,7 +254,7 @@ func (r *OutboundVoiceProfileResource) Create(ctx context.Context, req resource.
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating outbound voice profile", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error creating outbound voice profile", err)
 		return
 	}
 
@@ -288,54 +289,13 @@ func (r *OutboundVoiceProfileResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
-	profile, err := r.client.GetOutboundVoiceProfile(state.ID.ValueString())
+	profile, err := r.client.GetOutboundVoiceProfile(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading outbound voice profile", err.Error())
 		return
 	}
 
-	state.Name = types.StringValue(profile.Name)
-	state.BillingGroupID = types.StringValue(profile.BillingGroupID)
-	state.TrafficType = types.StringValue(profile.TrafficType)
-	state.ServicePlan = types.StringValue(profile.ServicePlan)
-
-	if profile.ConcurrentCallLimit == nil {
-		state.ConcurrentCallLimit = types.Int64Null()
-	} else {
-		state.ConcurrentCallLimit = types.Int64Value(int64(*profile.ConcurrentCallLimit))
-	}
-
-	state.Enabled = types.BoolValue(profile.Enabled)
-	state.Tags = convertStringsToList(profile.Tags)
-	state.UsagePaymentMethod = types.StringValue(profile.UsagePaymentMethod)
-	state.WhitelistedDestinations = convertStringsToList(profile.WhitelistedDestinations)
-
-	if profile.MaxDestinationRate == nil {
-		state.MaxDestinationRate = types.Float64Null()
-	} else {
-		state.MaxDestinationRate = types.Float64Value(*profile.MaxDestinationRate)
-	}
-
-	if profile.DailySpendLimit == nil {
-		state.DailySpendLimit = types.StringValue("")
-	} else {
-		state.DailySpendLimit = types.StringValue(*profile.DailySpendLimit)
-	}
-
-	state.DailySpendLimitEnabled = types.BoolValue(profile.DailySpendLimitEnabled)
-
-	state.CallRecording, diags = types.ObjectValue(map[string]attr.Type{
-		"type":                 types.StringType,
-		"caller_phone_numbers": types.ListType{ElemType: types.StringType},
-		"channels":             types.StringType,
-		"format":               types.StringType,
-	}, map[string]attr.Value{
-		"type":                 types.StringValue(profile.CallRecording.Type),
-		"caller_phone_numbers": convertStringsToList(profile.CallRecording.CallerPhoneNumbers),
-		"channels":             types.StringValue(profile.CallRecording.Channels),
-		"format":               types.StringValue(profile.CallRecording.Format),
-	})
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setOutboundVoiceProfileState(&state, profile)...)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -389,7 +349,7 @@ func (r *OutboundVoiceProfileResource) Update(ctx context.Context, req resource.
 		maxDestinationRatePointer = getFloat64Pointer(plan.MaxDestinationRate)
 	}
 
-	profile, err := r.client.UpdateOutboundVoiceProfile(plan.ID.ValueString(), telnyx.OutboundVoiceProfile{
+	profile, err := r.client.UpdateOutboundVoiceProfile(ctx, plan.ID.ValueString(), telnyx.OutboundVoiceProfile{
 		Name:                    plan.Name.ValueString(),
 		BillingGroupID:          plan.BillingGroupID.ValueString(),
 		TrafficType:             plan.TrafficType.ValueString(),
@@ -411,7 +371,7 @@ func (r *OutboundVoiceProfileResource) Update(ctx context.Context, req resource.
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating outbound voice profile", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error updating outbound voice profile", err)
 		return
 	}
 
@@ -445,7 +405,7 @@ func (r *OutboundVoiceProfileResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
-	err := r.client.DeleteOutboundVoiceProfile(state.ID.ValueString())
+	err := r.client.DeleteOutboundVoiceProfile(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting outbound voice profile", err.Error())
 	}
@@ -454,3 +414,60 @@ func (r *OutboundVoiceProfileResource) Delete(ctx context.Context, req resource.
 func (r *OutboundVoiceProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func setOutboundVoiceProfileState(state *OutboundVoiceProfileResourceModel, profile *telnyx.OutboundVoiceProfile) diag.Diagnostics {
+	state.ID = types.StringValue(profile.ID)
+	state.Name = types.StringValue(profile.Name)
+	state.BillingGroupID = types.StringValue(profile.BillingGroupID)
+	state.TrafficType = types.StringValue(profile.TrafficType)
+	state.ServicePlan = types.StringValue(profile.ServicePlan)
+
+	if profile.ConcurrentCallLimit == nil {
+		state.ConcurrentCallLimit = types.Int64Null()
+	} else {
+		state.ConcurrentCallLimit = types.Int64Value(int64(*profile.ConcurrentCallLimit))
+	}
+
+	state.Enabled = types.BoolValue(profile.Enabled)
+	state.Tags = convertStringsToList(profile.Tags)
+	state.UsagePaymentMethod = types.StringValue(profile.UsagePaymentMethod)
+	state.WhitelistedDestinations = convertStringsToList(profile.WhitelistedDestinations)
+
+	if profile.MaxDestinationRate == nil {
+		state.MaxDestinationRate = types.Float64Null()
+	} else {
+		state.MaxDestinationRate = types.Float64Value(*profile.MaxDestinationRate)
+	}
+
+	if profile.DailySpendLimit == nil {
+		state.DailySpendLimit = types.StringNull()
+	} else {
+		state.DailySpendLimit = types.StringValue(*profile.DailySpendLimit)
+	}
+
+	state.DailySpendLimitEnabled = types.BoolValue(profile.DailySpendLimitEnabled)
+
+	var diags diag.Diagnostics
+	state.CallRecording, diags = types.ObjectValue(map[string]attr.Type{
+		"type":                 types.StringType,
+		"caller_phone_numbers": types.ListType{ElemType: types.StringType},
+		"channels":             types.StringType,
+		"format":               types.StringType,
+	}, map[string]attr.Value{
+		"type":                 types.StringValue(profile.CallRecording.Type),
+		"caller_phone_numbers": convertStringsToList(profile.CallRecording.CallerPhoneNumbers),
+		"channels":             types.StringValue(profile.CallRecording.Channels),
+		"format":               types.StringValue(profile.CallRecording.Format),
+	})
+	return diags
+}
+
+// findOutboundVoiceProfile returns the outbound voice profile with the given ID or, when id is null,
+// the one with exactly the given name.
+func findOutboundVoiceProfile(ctx context.Context, client *telnyx.TelnyxClient, id, name types.String, diags *diag.Diagnostics) *telnyx.OutboundVoiceProfile {
+	return lookupByIDOrName(ctx, "outbound voice profile", "name", id, name,
+		client.GetOutboundVoiceProfile, client.ListOutboundVoiceProfiles, "filter[name][contains]",
+		func(record telnyx.OutboundVoiceProfile) (string, string) { return record.ID, record.Name },
+		diags,
+	)
+}
@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
+)
+
+var (
+	_ resource.Resource                = &PhoneNumberMessagingSettingsResource{}
+	_ resource.ResourceWithImportState = &PhoneNumberMessagingSettingsResource{}
+)
+
+func NewPhoneNumberMessagingSettingsResource() resource.Resource {
+	return &PhoneNumberMessagingSettingsResource{}
+}
+
+type PhoneNumberMessagingSettingsResource struct {
+	client *telnyx.TelnyxClient
+}
+
+type PhoneNumberMessagingSettingsResourceModel struct {
+	ID                        types.String `tfsdk:"id"`
+	PhoneNumberID             types.String `tfsdk:"phone_number_id"`
+	MessagingProfileID        types.String `tfsdk:"messaging_profile_id"`
+	MessagingProduct          types.String `tfsdk:"messaging_product"`
+	PhoneNumber               types.String `tfsdk:"phone_number"`
+	CountryCode               types.String `tfsdk:"country_code"`
+	Type                      types.String `tfsdk:"type"`
+	TrafficType               types.String `tfsdk:"traffic_type"`
+	EligibleMessagingProducts types.List   `tfsdk:"eligible_messaging_products"`
+}
+
+func (r *PhoneNumberMessagingSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_phone_number_messaging_settings"
+}
+
+func (r *PhoneNumberMessagingSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for assigning a Telnyx phone number to a messaging profile, including numbers that were ported or bought without one. " +
+			"Reassigning the number elsewhere, for example in the portal, shows up as drift. Destroying the resource unassigns the number from its messaging profile. " +
+			"Import with the number's ID or the number in E.164 format.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the messaging settings, the same as phone_number_id",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"phone_number_id": schema.StringAttribute{
+				Description: "ID of the phone number",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"messaging_profile_id": schema.StringAttribute{
+				Description: "ID of the messaging profile the number is assigned to",
+				Required:    true,
+			},
+			"messaging_product": optionalComputedString("Messaging product of the number, e.g. P2P or A2P. Must be one of eligible_messaging_products"),
+			"phone_number":      computedString("Phone number in E.164 format"),
+			"country_code":      computedString("ISO 3166-1 alpha-2 country code of the number"),
+			"type":              computedString("Type of the number for messaging, e.g. long-code or toll-free"),
+			"traffic_type":      computedString("Traffic type of the number's messaging product"),
+			"eligible_messaging_products": schema.ListAttribute{
+				Description: "Messaging products the number may use",
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PhoneNumberMessagingSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		client, ok := req.ProviderData.(*telnyx.TelnyxClient)
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Unexpected Resource Configure Type",
+				fmt.Sprintf("Expected *telnyx.TelnyxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			)
+			return
+		}
+		r.client = client
+		tflog.Info(ctx, "Configured Telnyx client for PhoneNumberMessagingSettingsResource")
+	}
+}
+
+func (r *PhoneNumberMessagingSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PhoneNumberMessagingSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdatePhoneNumberMessagingSettings(ctx, plan.PhoneNumberID.ValueString(), telnyx.UpdatePhoneNumberMessagingSettingsRequest{
+		MessagingProfileID: telnyx.StringPtr(plan.MessagingProfileID.ValueString()),
+		MessagingProduct:   getString(getStringPointer(plan.MessagingProduct)),
+	})
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error assigning phone number to messaging profile", err)
+		return
+	}
+
+	setPhoneNumberMessagingSettingsState(&plan, settings)
+
+	tflog.Info(ctx, "Assigned Phone Number to Messaging Profile", map[string]interface{}{"phone_number_id": settings.ID, "messaging_profile_id": settings.MessagingProfileID})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *PhoneNumberMessagingSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PhoneNumberMessagingSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetPhoneNumberMessagingSettings(ctx, state.PhoneNumberID.ValueString())
+	if err == nil {
+		setPhoneNumberMessagingSettingsState(&state, settings)
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	if telnyx.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.AddError(
+		"Error reading phone number messaging settings",
+		"Could not read phone number messaging settings, unexpected error: "+err.Error(),
+	)
+}
+
+func (r *PhoneNumberMessagingSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PhoneNumberMessagingSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdatePhoneNumberMessagingSettings(ctx, plan.PhoneNumberID.ValueString(), telnyx.UpdatePhoneNumberMessagingSettingsRequest{
+		MessagingProfileID: telnyx.StringPtr(plan.MessagingProfileID.ValueString()),
+		MessagingProduct:   getString(getStringPointer(plan.MessagingProduct)),
+	})
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error updating phone number messaging settings", err)
+		return
+	}
+
+	setPhoneNumberMessagingSettingsState(&plan, settings)
+
+	tflog.Info(ctx, "Updated Phone Number Messaging Settings", map[string]interface{}{"phone_number_id": settings.ID, "messaging_profile_id": settings.MessagingProfileID})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *PhoneNumberMessagingSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PhoneNumberMessagingSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdatePhoneNumberMessagingSettings(ctx, state.PhoneNumberID.ValueString(), telnyx.UpdatePhoneNumberMessagingSettingsRequest{
+		MessagingProfileID: telnyx.StringPtr(""),
+	})
+	if err != nil && !telnyx.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error unassigning phone number from messaging profile",
+			"Could not unassign phone number from messaging profile, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState accepts the phone number's ID or the number in E.164 format.
+func (r *PhoneNumberMessagingSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	phoneNumberID := importPhoneNumberID(ctx, r.client, req.ID, &resp.Diagnostics)
+	if phoneNumberID == "" {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), phoneNumberID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("phone_number_id"), phoneNumberID)...)
+}
+
+func setPhoneNumberMessagingSettingsState(state *PhoneNumberMessagingSettingsResourceModel, settings *telnyx.PhoneNumberMessagingSettings) {
+	state.ID = types.StringValue(settings.ID)
+	state.PhoneNumberID = types.StringValue(settings.ID)
+	state.MessagingProfileID = types.StringValue(settings.MessagingProfileID)
+	state.MessagingProduct = types.StringValue(settings.MessagingProduct)
+	state.PhoneNumber = types.StringValue(settings.PhoneNumber)
+	state.CountryCode = types.StringValue(settings.CountryCode)
+	state.Type = types.StringValue(settings.Type)
+	state.TrafficType = types.StringValue(settings.TrafficType)
+	state.EligibleMessagingProducts = convertStringsToList(settings.EligibleMessagingProducts)
+}
@@ -0,0 +1,582 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
+)
+
+var (
+	_ resource.Resource                   = &PhoneNumberResource{}
+	_ resource.ResourceWithValidateConfig = &PhoneNumberResource{}
+	_ resource.ResourceWithImportState    = &PhoneNumberResource{}
+)
+
+var e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// emergencyPrivateKey marks, in private state, numbers whose emergency
+// calling was enabled by the resource, so destroying it disables emergency
+// calling again and frees the address.
+const emergencyPrivateKey = "emergency_enabled_by_terraform"
+
+func NewPhoneNumberResource() resource.Resource {
+	return &PhoneNumberResource{}
+}
+
+type PhoneNumberResource struct {
+	client *telnyx.TelnyxClient
+}
+
+type PhoneNumberResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	PhoneNumber             types.String `tfsdk:"phone_number"`
+	ConnectionID            types.String `tfsdk:"connection_id"`
+	BillingGroupID          types.String `tfsdk:"billing_group_id"`
+	CustomerReference       types.String `tfsdk:"customer_reference"`
+	Tags                    types.Set    `tfsdk:"tags"`
+	HDVoiceEnabled          types.Bool   `tfsdk:"hd_voice_enabled"`
+	NumberLevelRouting      types.String `tfsdk:"number_level_routing"`
+	EmergencyAddressID      types.String `tfsdk:"emergency_address_id"`
+	EmergencyEnabled        types.Bool   `tfsdk:"emergency_enabled"`
+	RequireEmergencyAddress types.Bool   `tfsdk:"require_emergency_address"`
+	ReleaseOnDestroy        types.Bool   `tfsdk:"release_on_destroy"`
+	Status                  types.String `tfsdk:"status"`
+	PhoneNumberType         types.String `tfsdk:"phone_number_type"`
+}
+
+func (r *PhoneNumberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_phone_number"
+}
+
+func (r *PhoneNumberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for managing the settings of a phone number already on the Telnyx account, such as one bought with telnyx_number_order. " +
+			"The number is adopted by id or E.164 phone_number. Settings left out of the configuration keep their current values. " +
+			"Destroying the resource leaves the number on the account unless release_on_destroy is set. Import with the number's ID or the number in E.164 format.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the phone number to adopt. Either id or phone_number must be set",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"phone_number": schema.StringAttribute{
+				Description: "Phone number to adopt in E.164 format, e.g. +13125550100. Either id or phone_number must be set",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "ID of the connection or application that handles calls to the number. Set to an empty string to unassign it",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"billing_group_id": schema.StringAttribute{
+				Description: "ID of the billing group the number is billed to",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_reference": schema.StringAttribute{
+				Description: "Customer reference for the number",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags": schema.SetAttribute{
+				Description: "Tags for the number",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hd_voice_enabled": schema.BoolAttribute{
+				Description: "Whether HD voice is enabled for the number",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"number_level_routing": schema.StringAttribute{
+				Description: "Whether number level routing is enabled or disabled",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"emergency_address_id": schema.StringAttribute{
+				Description: "ID of the validated telnyx_address that emergency (E911) calls from the number are registered to. Setting it enables emergency calling and an empty string disables it. " +
+					"Emergency calling is changed before connection_id is assigned, and disabled again when the resource is destroyed if the resource enabled it",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"emergency_enabled": schema.BoolAttribute{
+				Description: "Whether emergency calling is enabled for the number",
+				Computed:    true,
+			},
+			"require_emergency_address": schema.BoolAttribute{
+				Description: "Refuse to apply unless the number has an emergency_address_id that was validated for emergency use, so the number never goes live without an E911 address. Defaults to false",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"release_on_destroy": schema.BoolAttribute{
+				Description: "Release the number from the account when the resource is destroyed. Defaults to false, which only stops managing it",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the phone number",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"phone_number_type": schema.StringAttribute{
+				Description: "Type of the phone number, e.g. local or toll_free",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PhoneNumberResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config PhoneNumberResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ID.IsNull() && config.PhoneNumber.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing phone number",
+			"Set either id or phone_number to choose the phone number to manage.",
+		)
+	}
+	if !config.PhoneNumber.IsNull() && !config.PhoneNumber.IsUnknown() && !e164Pattern.MatchString(config.PhoneNumber.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("phone_number"),
+			"Invalid phone number",
+			fmt.Sprintf("%q is not in E.164 format, e.g. +13125550100.", config.PhoneNumber.ValueString()),
+		)
+	}
+	if routing := config.NumberLevelRouting; !routing.IsNull() && !routing.IsUnknown() && routing.ValueString() != "enabled" && routing.ValueString() != "disabled" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("number_level_routing"),
+			"Invalid number level routing",
+			fmt.Sprintf("Expected \"enabled\" or \"disabled\", got %q.", routing.ValueString()),
+		)
+	}
+	if address := config.EmergencyAddressID; config.RequireEmergencyAddress.ValueBool() && (address.IsNull() || (!address.IsUnknown() && address.ValueString() == "")) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("emergency_address_id"),
+			"Missing emergency address",
+			"require_emergency_address is set, so emergency_address_id must name a validated telnyx_address.",
+		)
+	}
+}
+
+func (r *PhoneNumberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		client, ok := req.ProviderData.(*telnyx.TelnyxClient)
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Unexpected Resource Configure Type",
+				fmt.Sprintf("Expected *telnyx.TelnyxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			)
+			return
+		}
+		r.client = client
+		tflog.Info(ctx, "Configured Telnyx client for PhoneNumberResource")
+	}
+}
+
+func (r *PhoneNumberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PhoneNumberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := r.findPhoneNumber(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, diags := buildPhoneNumberUpdateRequest(ctx, plan, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	emergencyAddressID, diags := r.emergencyAddress(ctx, plan, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Emergency calling changes before connection_id is assigned, so the
+	// number never takes calls without its E911 address.
+	setPhoneNumberState(&plan, *current)
+	resp.Diagnostics.Append(r.applyEmergency(ctx, emergencyAddressID, current, &plan, req.Plan, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdatePhoneNumber(ctx, current.ID, request)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error updating phone number", err)
+		// Save the emergency change, so destroying the resource undoes it
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	setPhoneNumberState(&plan, telnyx.PhoneNumberResponse(*updated))
+
+	tflog.Info(ctx, "Adopted Phone Number", map[string]interface{}{"id": updated.ID, "phone_number": updated.PhoneNumber})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *PhoneNumberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PhoneNumberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	phoneNumber, err := r.client.GetPhoneNumber(ctx, state.ID.ValueString())
+	if err == nil {
+		setPhoneNumberState(&state, *phoneNumber)
+		if state.ReleaseOnDestroy.IsNull() {
+			state.ReleaseOnDestroy = types.BoolValue(false)
+		}
+		if state.RequireEmergencyAddress.IsNull() {
+			state.RequireEmergencyAddress = types.BoolValue(false)
+		}
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	if telnyx.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.AddError(
+		"Error reading phone number",
+		"Could not read phone number, unexpected error: "+err.Error(),
+	)
+}
+
+func (r *PhoneNumberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PhoneNumberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state PhoneNumberResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.client.GetPhoneNumber(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading phone number", err.Error())
+		return
+	}
+
+	request, diags := buildPhoneNumberUpdateRequest(ctx, plan, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	emergencyAddressID, diags := r.emergencyAddress(ctx, plan, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Emergency calling changes before connection_id is assigned, so the
+	// number never takes calls without its E911 address.
+	setPhoneNumberState(&plan, *current)
+	resp.Diagnostics.Append(r.applyEmergency(ctx, emergencyAddressID, current, &plan, req.Plan, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdatePhoneNumber(ctx, state.ID.ValueString(), request)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error updating phone number", err)
+		// Save the emergency change, so destroying the resource undoes it
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	setPhoneNumberState(&plan, telnyx.PhoneNumberResponse(*updated))
+
+	tflog.Info(ctx, "Updated Phone Number", map[string]interface{}{"id": updated.ID, "phone_number": updated.PhoneNumber})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *PhoneNumberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PhoneNumberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.ReleaseOnDestroy.ValueBool() {
+		enabledByTerraform, diags := req.Private.GetKey(ctx, emergencyPrivateKey)
+		resp.Diagnostics.Append(diags...)
+		if state.EmergencyEnabled.ValueBool() && enabledByTerraform != nil {
+			_, err := r.client.EnablePhoneNumberEmergency(ctx, state.ID.ValueString(), telnyx.EnablePhoneNumberEmergencyRequest{EmergencyEnabled: false})
+			if err != nil && !telnyx.IsNotFound(err) {
+				resp.Diagnostics.AddError(
+					"Error disabling emergency calling",
+					"Could not disable emergency calling on the phone number, unexpected error: "+err.Error(),
+				)
+				return
+			}
+		}
+		tflog.Info(ctx, "Leaving Phone Number on the account", map[string]interface{}{"id": state.ID.ValueString(), "phone_number": state.PhoneNumber.ValueString()})
+		return
+	}
+
+	err := r.client.DeletePhoneNumber(ctx, state.ID.ValueString())
+	if err != nil && !telnyx.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error releasing phone number",
+			"Could not release phone number, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Released Phone Number", map[string]interface{}{"id": state.ID.ValueString(), "phone_number": state.PhoneNumber.ValueString()})
+}
+
+// ImportState accepts the phone number's ID or the number in E.164 format.
+func (r *PhoneNumberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if id := importPhoneNumberID(ctx, r.client, req.ID, &resp.Diagnostics); id != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	}
+}
+
+// findPhoneNumber returns the number chosen by id or, failing that, by its
+// E.164 phone_number.
+func (r *PhoneNumberResource) findPhoneNumber(ctx context.Context, plan PhoneNumberResourceModel) (*telnyx.PhoneNumberResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !plan.ID.IsNull() && !plan.ID.IsUnknown() {
+		phoneNumber, err := r.client.GetPhoneNumber(ctx, plan.ID.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("id"), "Error reading phone number", err.Error())
+			return nil, diags
+		}
+		if !plan.PhoneNumber.IsNull() && !plan.PhoneNumber.IsUnknown() && plan.PhoneNumber.ValueString() != phoneNumber.PhoneNumber {
+			diags.AddAttributeError(
+				path.Root("phone_number"),
+				"Conflicting phone number",
+				fmt.Sprintf("Phone number ID %s is %s, not %s.", phoneNumber.ID, phoneNumber.PhoneNumber, plan.PhoneNumber.ValueString()),
+			)
+			return nil, diags
+		}
+		return phoneNumber, diags
+	}
+
+	phoneNumbers, err := r.client.ListPhoneNumbersMatching(ctx, telnyx.PhoneNumberFilters{PhoneNumber: plan.PhoneNumber.ValueString()})
+	if err != nil {
+		diags.AddAttributeError(path.Root("phone_number"), "Error looking up phone number", err.Error())
+		return nil, diags
+	}
+	for _, phoneNumber := range phoneNumbers {
+		if phoneNumber.PhoneNumber == plan.PhoneNumber.ValueString() {
+			return &phoneNumber, diags
+		}
+	}
+	diags.AddAttributeError(
+		path.Root("phone_number"),
+		"Phone number not found",
+		fmt.Sprintf("%s is not on this Telnyx account. Order it first, for example with telnyx_number_order.", plan.PhoneNumber.ValueString()),
+	)
+	return nil, diags
+}
+
+// buildPhoneNumberUpdateRequest takes every managed setting from the plan,
+// falling back to the number's current value where the plan leaves it
+// unknown, so adopting a number does not reset settings the configuration
+// does not mention.
+func buildPhoneNumberUpdateRequest(ctx context.Context, plan PhoneNumberResourceModel, current *telnyx.PhoneNumberResponse) (telnyx.UpdatePhoneNumberRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	request := telnyx.UpdatePhoneNumberRequest{
+		CustomerReference:  stringOrDefault(plan.CustomerReference, current.CustomerReference),
+		ConnectionID:       stringOrDefault(plan.ConnectionID, current.ConnectionID),
+		BillingGroupID:     stringOrDefault(plan.BillingGroupID, current.BillingGroupID),
+		Tags:               current.Tags,
+		HDVoiceEnabled:     current.HDVoiceEnabled,
+		NumberLevelRouting: stringOrDefault(plan.NumberLevelRouting, current.NumberLevelRouting),
+	}
+	if !plan.HDVoiceEnabled.IsNull() && !plan.HDVoiceEnabled.IsUnknown() {
+		request.HDVoiceEnabled = plan.HDVoiceEnabled.ValueBool()
+	}
+	if !plan.Tags.IsNull() && !plan.Tags.IsUnknown() {
+		request.Tags = []string{}
+		diags.Append(plan.Tags.ElementsAs(ctx, &request.Tags, false)...)
+	}
+	if request.Tags == nil {
+		request.Tags = []string{}
+	}
+	return request, diags
+}
+
+// emergencyAddress returns the emergency address the plan asks for. With
+// require_emergency_address it first checks that the address was validated
+// for emergency use, so nothing is changed on the number when it was not.
+func (r *PhoneNumberResource) emergencyAddress(ctx context.Context, plan PhoneNumberResourceModel, current *telnyx.PhoneNumberResponse) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	addressID := stringOrDefault(plan.EmergencyAddressID, current.EmergencyAddressID)
+	if !plan.RequireEmergencyAddress.ValueBool() {
+		return addressID, diags
+	}
+	if addressID == "" {
+		diags.AddAttributeError(
+			path.Root("emergency_address_id"),
+			"Missing emergency address",
+			fmt.Sprintf("%s has no emergency address and require_emergency_address is set. Set emergency_address_id to a validated telnyx_address.", current.PhoneNumber),
+		)
+		return "", diags
+	}
+	address, err := r.client.GetAddress(ctx, addressID)
+	if err != nil {
+		diags.AddAttributeError(path.Root("emergency_address_id"), "Error reading emergency address", err.Error())
+		return "", diags
+	}
+	if !address.ValidateAddress {
+		diags.AddAttributeError(
+			path.Root("emergency_address_id"),
+			"Emergency address not validated",
+			fmt.Sprintf("Address %s was not validated for emergency use and require_emergency_address is set. Create the address with validate_address enabled.", addressID),
+		)
+		return "", diags
+	}
+	return addressID, diags
+}
+
+// applyEmergency enables emergency calling against addressID, or disables it
+// when addressID is empty. It runs before the number's other settings are
+// updated. On success it records the change in state and, straight away, in
+// the private key that lets Delete turn emergency calling off again, so a
+// later failure never leaves emergency calling behind that nothing records.
+func (r *PhoneNumberResource) applyEmergency(ctx context.Context, addressID string, current *telnyx.PhoneNumberResponse, state *PhoneNumberResourceModel, tfPlan tfsdk.Plan, private privateState) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if addressID == current.EmergencyAddressID && (addressID == "") != current.EmergencyEnabled {
+		return diags
+	}
+	_, err := r.client.EnablePhoneNumberEmergency(ctx, current.ID, telnyx.EnablePhoneNumberEmergencyRequest{
+		EmergencyEnabled:   addressID != "",
+		EmergencyAddressID: addressID,
+	})
+	if err != nil {
+		addAPIError(ctx, &diags, tfPlan, "Error changing emergency calling", err)
+		return diags
+	}
+	state.EmergencyAddressID = types.StringValue(addressID)
+	state.EmergencyEnabled = types.BoolValue(addressID != "")
+	diags.Append(private.SetKey(ctx, emergencyPrivateKey, emergencyPrivateValue(addressID))...)
+	return diags
+}
+
+// privateState is the private state of a create or update response.
+type privateState interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// emergencyPrivateValue is the private state value recording whether the
+// resource enabled emergency calling.
+func emergencyPrivateValue(addressID string) []byte {
+	if addressID == "" {
+		return nil
+	}
+	return []byte("true")
+}
+
+func stringOrDefault(value types.String, fallback string) string {
+	if value.IsNull() || value.IsUnknown() {
+		return fallback
+	}
+	return value.ValueString()
+}
+
+func setPhoneNumberState(state *PhoneNumberResourceModel, phoneNumber telnyx.PhoneNumberResponse) {
+	state.ID = types.StringValue(phoneNumber.ID)
+	state.PhoneNumber = types.StringValue(phoneNumber.PhoneNumber)
+	state.ConnectionID = types.StringValue(phoneNumber.ConnectionID)
+	state.BillingGroupID = types.StringValue(phoneNumber.BillingGroupID)
+	state.CustomerReference = types.StringValue(phoneNumber.CustomerReference)
+	state.HDVoiceEnabled = types.BoolValue(phoneNumber.HDVoiceEnabled)
+	state.NumberLevelRouting = types.StringValue(phoneNumber.NumberLevelRouting)
+	state.EmergencyAddressID = types.StringValue(phoneNumber.EmergencyAddressID)
+	state.EmergencyEnabled = types.BoolValue(phoneNumber.EmergencyEnabled)
+	state.Status = types.StringValue(phoneNumber.Status)
+	state.PhoneNumberType = types.StringValue(phoneNumber.PhoneNumberType)
+
+	tags := make([]attr.Value, len(phoneNumber.Tags))
+	for i, tag := range phoneNumber.Tags {
+		tags[i] = types.StringValue(tag)
+	}
+	state.Tags = types.SetValueMust(types.StringType, tags)
+}
@@ -0,0 +1,343 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
+)
+
+var (
+	_ resource.Resource                = &PhoneNumberVoiceSettingsResource{}
+	_ resource.ResourceWithImportState = &PhoneNumberVoiceSettingsResource{}
+)
+
+func NewPhoneNumberVoiceSettingsResource() resource.Resource {
+	return &PhoneNumberVoiceSettingsResource{}
+}
+
+type PhoneNumberVoiceSettingsResource struct {
+	client *telnyx.TelnyxClient
+}
+
+type PhoneNumberVoiceSettingsResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	PhoneNumberID        types.String `tfsdk:"phone_number_id"`
+	TechPrefixEnabled    types.Bool   `tfsdk:"tech_prefix_enabled"`
+	TranslatedNumber     types.String `tfsdk:"translated_number"`
+	CallerIDNameEnabled  types.Bool   `tfsdk:"caller_id_name_enabled"`
+	UsagePaymentMethod   types.String `tfsdk:"usage_payment_method"`
+	InboundCallScreening types.String `tfsdk:"inbound_call_screening"`
+	CallForwarding       types.Object `tfsdk:"call_forwarding"`
+	CNAMListing          types.Object `tfsdk:"cnam_listing"`
+	MediaFeatures        types.Object `tfsdk:"media_features"`
+	CallRecording        types.Object `tfsdk:"call_recording"`
+}
+
+type VoiceCallForwardingModel struct {
+	CallForwardingEnabled types.Bool   `tfsdk:"call_forwarding_enabled"`
+	ForwardsTo            types.String `tfsdk:"forwards_to"`
+	ForwardingType        types.String `tfsdk:"forwarding_type"`
+}
+
+func (m VoiceCallForwardingModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"call_forwarding_enabled": types.BoolType,
+		"forwards_to":             types.StringType,
+		"forwarding_type":         types.StringType,
+	}
+}
+
+type VoiceCNAMListingModel struct {
+	CNAMListingEnabled types.Bool   `tfsdk:"cnam_listing_enabled"`
+	CNAMListingDetails types.String `tfsdk:"cnam_listing_details"`
+}
+
+func (m VoiceCNAMListingModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"cnam_listing_enabled": types.BoolType,
+		"cnam_listing_details": types.StringType,
+	}
+}
+
+type VoiceMediaFeaturesModel struct {
+	RTPAutoAdjustEnabled       types.Bool `tfsdk:"rtp_auto_adjust_enabled"`
+	AcceptAnyRTPPacketsEnabled types.Bool `tfsdk:"accept_any_rtp_packets_enabled"`
+	T38FaxGatewayEnabled       types.Bool `tfsdk:"t38_fax_gateway_enabled"`
+}
+
+func (m VoiceMediaFeaturesModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"rtp_auto_adjust_enabled":        types.BoolType,
+		"accept_any_rtp_packets_enabled": types.BoolType,
+		"t38_fax_gateway_enabled":        types.BoolType,
+	}
+}
+
+type VoiceCallRecordingModel struct {
+	InboundCallRecordingEnabled  types.Bool   `tfsdk:"inbound_call_recording_enabled"`
+	InboundCallRecordingFormat   types.String `tfsdk:"inbound_call_recording_format"`
+	InboundCallRecordingChannels types.String `tfsdk:"inbound_call_recording_channels"`
+}
+
+func (m VoiceCallRecordingModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"inbound_call_recording_enabled":  types.BoolType,
+		"inbound_call_recording_format":   types.StringType,
+		"inbound_call_recording_channels": types.StringType,
+	}
+}
+
+func (r *PhoneNumberVoiceSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_phone_number_voice_settings"
+}
+
+func (r *PhoneNumberVoiceSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for managing the voice settings of a Telnyx phone number. " +
+			"Settings left out of the configuration keep their current values, and any setting that is configured is checked for drift on refresh. " +
+			"Telnyx keeps voice settings for as long as the number exists, so destroying the resource only stops managing them. Import with the number's ID or the number in E.164 format.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the voice settings, the same as phone_number_id",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"phone_number_id": schema.StringAttribute{
+				Description: "ID of the phone number",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tech_prefix_enabled":    optionalComputedBool("Whether a tech prefix is added to calls to the number"),
+			"translated_number":      optionalComputedString("Number sent to the connection in place of the dialed number"),
+			"caller_id_name_enabled": optionalComputedBool("Whether caller ID name lookup (CNAM) is enabled for inbound calls"),
+			"usage_payment_method":   optionalComputedString("How inbound calls are billed, either pay-per-minute or channel"),
+			"inbound_call_screening": optionalComputedString("Screening of inbound calls, one of disabled, reject_calls or flag_calls"),
+			"call_forwarding": optionalComputedObject("Call forwarding settings", map[string]schema.Attribute{
+				"call_forwarding_enabled": optionalComputedBool("Whether calls are forwarded"),
+				"forwards_to":             optionalComputedString("Phone number calls are forwarded to, in E.164 format"),
+				"forwarding_type":         optionalComputedString("When calls are forwarded, either always or on_failure"),
+			}),
+			"cnam_listing": optionalComputedObject("Caller name listing settings", map[string]schema.Attribute{
+				"cnam_listing_enabled": optionalComputedBool("Whether a caller name is listed for outbound calls"),
+				"cnam_listing_details": optionalComputedString("Caller name to list, up to 15 characters"),
+			}),
+			"media_features": optionalComputedObject("Media handling settings", map[string]schema.Attribute{
+				"rtp_auto_adjust_enabled":        optionalComputedBool("Whether RTP is sent to the address media actually arrives from"),
+				"accept_any_rtp_packets_enabled": optionalComputedBool("Whether RTP packets from any source are accepted"),
+				"t38_fax_gateway_enabled":        optionalComputedBool("Whether the T.38 fax gateway is enabled"),
+			}),
+			"call_recording": optionalComputedObject("Inbound call recording settings", map[string]schema.Attribute{
+				"inbound_call_recording_enabled":  optionalComputedBool("Whether inbound calls are recorded"),
+				"inbound_call_recording_format":   optionalComputedString("Recording file format, either wav or mp3"),
+				"inbound_call_recording_channels": optionalComputedString("Recording channels, either single or dual"),
+			}),
+		},
+	}
+}
+
+func (r *PhoneNumberVoiceSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		client, ok := req.ProviderData.(*telnyx.TelnyxClient)
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Unexpected Resource Configure Type",
+				fmt.Sprintf("Expected *telnyx.TelnyxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			)
+			return
+		}
+		r.client = client
+		tflog.Info(ctx, "Configured Telnyx client for PhoneNumberVoiceSettingsResource")
+	}
+}
+
+func (r *PhoneNumberVoiceSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PhoneNumberVoiceSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Configured Phone Number Voice Settings", map[string]interface{}{"phone_number_id": plan.PhoneNumberID.ValueString()})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *PhoneNumberVoiceSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PhoneNumberVoiceSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetPhoneNumberVoiceSettings(ctx, state.PhoneNumberID.ValueString())
+	if err == nil {
+		setPhoneNumberVoiceSettingsState(&state, settings)
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	if telnyx.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.AddError(
+		"Error reading phone number voice settings",
+		"Could not read phone number voice settings, unexpected error: "+err.Error(),
+	)
+}
+
+func (r *PhoneNumberVoiceSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PhoneNumberVoiceSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updated Phone Number Voice Settings", map[string]interface{}{"phone_number_id": plan.PhoneNumberID.ValueString()})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *PhoneNumberVoiceSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PhoneNumberVoiceSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Leaving Phone Number Voice Settings in place", map[string]interface{}{"phone_number_id": state.PhoneNumberID.ValueString()})
+}
+
+// ImportState accepts the phone number's ID or the number in E.164 format.
+func (r *PhoneNumberVoiceSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	phoneNumberID := importPhoneNumberID(ctx, r.client, req.ID, &resp.Diagnostics)
+	if phoneNumberID == "" {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), phoneNumberID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("phone_number_id"), phoneNumberID)...)
+}
+
+// apply sends the planned settings and replaces plan with the result. Nested
+// settings are sent whole, so attributes the plan leaves unknown are filled
+// in from the current settings first.
+func (r *PhoneNumberVoiceSettingsResource) apply(ctx context.Context, plan *PhoneNumberVoiceSettingsResourceModel, tfPlan tfsdk.Plan, diags *diag.Diagnostics) {
+	phoneNumberID := plan.PhoneNumberID.ValueString()
+	current, err := r.client.GetPhoneNumberVoiceSettings(ctx, phoneNumberID)
+	if err != nil {
+		diags.AddAttributeError(path.Root("phone_number_id"), "Error reading phone number voice settings", err.Error())
+		return
+	}
+	var currentState PhoneNumberVoiceSettingsResourceModel
+	setPhoneNumberVoiceSettingsState(&currentState, current)
+
+	request := telnyx.UpdatePhoneNumberVoiceSettingsRequest{
+		TechPrefixEnabled:    getBoolPointer(plan.TechPrefixEnabled),
+		TranslatedNumber:     getStringPointer(plan.TranslatedNumber),
+		CallerIDNameEnabled:  getBoolPointer(plan.CallerIDNameEnabled),
+		UsagePaymentMethod:   getString(getStringPointer(plan.UsagePaymentMethod)),
+		InboundCallScreening: getString(getStringPointer(plan.InboundCallScreening)),
+	}
+
+	var callForwarding VoiceCallForwardingModel
+	diags.Append(fillObject(plan.CallForwarding, currentState.CallForwarding).As(ctx, &callForwarding, basetypes.ObjectAsOptions{})...)
+	request.CallForwarding = &telnyx.VoiceCallForwarding{
+		CallForwardingEnabled: callForwarding.CallForwardingEnabled.ValueBool(),
+		ForwardsTo:            callForwarding.ForwardsTo.ValueString(),
+		ForwardingType:        callForwarding.ForwardingType.ValueString(),
+	}
+
+	var cnamListing VoiceCNAMListingModel
+	diags.Append(fillObject(plan.CNAMListing, currentState.CNAMListing).As(ctx, &cnamListing, basetypes.ObjectAsOptions{})...)
+	request.CNAMListing = &telnyx.VoiceCNAMListing{
+		CNAMListingEnabled: cnamListing.CNAMListingEnabled.ValueBool(),
+		CNAMListingDetails: cnamListing.CNAMListingDetails.ValueString(),
+	}
+
+	var mediaFeatures VoiceMediaFeaturesModel
+	diags.Append(fillObject(plan.MediaFeatures, currentState.MediaFeatures).As(ctx, &mediaFeatures, basetypes.ObjectAsOptions{})...)
+	request.MediaFeatures = &telnyx.VoiceMediaFeatures{
+		RTPAutoAdjustEnabled:       mediaFeatures.RTPAutoAdjustEnabled.ValueBool(),
+		AcceptAnyRTPPacketsEnabled: mediaFeatures.AcceptAnyRTPPacketsEnabled.ValueBool(),
+		T38FaxGatewayEnabled:       mediaFeatures.T38FaxGatewayEnabled.ValueBool(),
+	}
+
+	var callRecording VoiceCallRecordingModel
+	diags.Append(fillObject(plan.CallRecording, currentState.CallRecording).As(ctx, &callRecording, basetypes.ObjectAsOptions{})...)
+	request.CallRecording = &telnyx.VoiceCallRecording{
+		InboundCallRecordingEnabled:  callRecording.InboundCallRecordingEnabled.ValueBool(),
+		InboundCallRecordingFormat:   callRecording.InboundCallRecordingFormat.ValueString(),
+		InboundCallRecordingChannels: callRecording.InboundCallRecordingChannels.ValueString(),
+	}
+	if diags.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdatePhoneNumberVoiceSettings(ctx, phoneNumberID, request)
+	if err != nil {
+		addAPIError(ctx, diags, tfPlan, "Error updating phone number voice settings", err)
+		return
+	}
+	setPhoneNumberVoiceSettingsState(plan, settings)
+}
+
+func setPhoneNumberVoiceSettingsState(state *PhoneNumberVoiceSettingsResourceModel, settings *telnyx.PhoneNumberVoiceSettings) {
+	state.ID = types.StringValue(settings.ID)
+	state.PhoneNumberID = types.StringValue(settings.ID)
+	state.TechPrefixEnabled = types.BoolValue(settings.TechPrefixEnabled)
+	state.TranslatedNumber = types.StringValue(settings.TranslatedNumber)
+	state.CallerIDNameEnabled = types.BoolValue(settings.CallerIDNameEnabled)
+	state.UsagePaymentMethod = types.StringValue(settings.UsagePaymentMethod)
+	state.InboundCallScreening = types.StringValue(settings.InboundCallScreening)
+	state.CallForwarding = types.ObjectValueMust(VoiceCallForwardingModel{}.AttrTypes(), map[string]attr.Value{
+		"call_forwarding_enabled": types.BoolValue(settings.CallForwarding.CallForwardingEnabled),
+		"forwards_to":             types.StringValue(settings.CallForwarding.ForwardsTo),
+		"forwarding_type":         types.StringValue(settings.CallForwarding.ForwardingType),
+	})
+	state.CNAMListing = types.ObjectValueMust(VoiceCNAMListingModel{}.AttrTypes(), map[string]attr.Value{
+		"cnam_listing_enabled": types.BoolValue(settings.CNAMListing.CNAMListingEnabled),
+		"cnam_listing_details": types.StringValue(settings.CNAMListing.CNAMListingDetails),
+	})
+	state.MediaFeatures = types.ObjectValueMust(VoiceMediaFeaturesModel{}.AttrTypes(), map[string]attr.Value{
+		"rtp_auto_adjust_enabled":        types.BoolValue(settings.MediaFeatures.RTPAutoAdjustEnabled),
+		"accept_any_rtp_packets_enabled": types.BoolValue(settings.MediaFeatures.AcceptAnyRTPPacketsEnabled),
+		"t38_fax_gateway_enabled":        types.BoolValue(settings.MediaFeatures.T38FaxGatewayEnabled),
+	})
+	state.CallRecording = types.ObjectValueMust(VoiceCallRecordingModel{}.AttrTypes(), map[string]attr.Value{
+		"inbound_call_recording_enabled":  types.BoolValue(settings.CallRecording.InboundCallRecordingEnabled),
+		"inbound_call_recording_format":   types.StringValue(settings.CallRecording.InboundCallRecordingFormat),
+		"inbound_call_recording_channels": types.StringValue(settings.CallRecording.InboundCallRecordingChannels),
+	})
+}
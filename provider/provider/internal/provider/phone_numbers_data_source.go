@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
+)
+
+var (
+	_ datasource.DataSource                   = &PhoneNumbersDataSource{}
+	_ datasource.DataSourceWithConfigure      = &PhoneNumbersDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &PhoneNumbersDataSource{}
+)
+
+var (
+	phoneNumberStatuses = []string{"purchase-pending", "purchase-failed", "port-pending", "active", "deleted", "port-failed", "emergency-only", "ported-out", "port-out-pending"}
+	phoneNumberTypes    = []string{"local", "toll_free", "mobile", "national", "shared_cost", "landline"}
+)
+
+func NewPhoneNumbersDataSource() datasource.DataSource {
+	return &PhoneNumbersDataSource{}
+}
+
+type PhoneNumbersDataSource struct {
+	client *telnyx.TelnyxClient
+}
+
+type PhoneNumbersDataSourceModel struct {
+	Tag                    types.String `tfsdk:"tag"`
+	PhoneNumber            types.String `tfsdk:"phone_number"`
+	Status                 types.String `tfsdk:"status"`
+	ConnectionID           types.String `tfsdk:"connection_id"`
+	ConnectionName         types.String `tfsdk:"connection_name"`
+	CountryCode            types.String `tfsdk:"country_code"`
+	NumberType             types.String `tfsdk:"number_type"`
+	CustomerReference      types.String `tfsdk:"customer_reference"`
+	BillingGroupID         types.String `tfsdk:"billing_group_id"`
+	MessagingProfileID     types.String `tfsdk:"messaging_profile_id"`
+	OutboundVoiceProfileID types.String `tfsdk:"outbound_voice_profile_id"`
+	PhoneNumbers           types.List   `tfsdk:"phone_numbers"`
+}
+
+var ownedPhoneNumberType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"id":                     types.StringType,
+	"phone_number":           types.StringType,
+	"status":                 types.StringType,
+	"tags":                   types.ListType{ElemType: types.StringType},
+	"connection_id":          types.StringType,
+	"connection_name":        types.StringType,
+	"customer_reference":     types.StringType,
+	"messaging_profile_id":   types.StringType,
+	"messaging_profile_name": types.StringType,
+	"billing_group_id":       types.StringType,
+	"phone_number_type":      types.StringType,
+	"country_code":           types.StringType,
+	"emergency_enabled":      types.BoolType,
+	"emergency_address_id":   types.StringType,
+	"purchased_at":           types.StringType,
+}}
+
+func (d *PhoneNumbersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_phone_numbers"
+}
+
+func (d *PhoneNumbersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the phone numbers on the Telnyx account that match every filter that is set, sorted by phone number. " +
+			"Turn the list into a map, for example { for n in data.telnyx_phone_numbers.x.phone_numbers : n.phone_number => n }, to use it with for_each.",
+		Attributes: map[string]schema.Attribute{
+			"tag": schema.StringAttribute{
+				Description: "Only list numbers carrying this tag",
+				Optional:    true,
+			},
+			"phone_number": schema.StringAttribute{
+				Description: "Only list this number, in E.164 format",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Only list numbers in this status. One of " + strings.Join(phoneNumberStatuses, ", "),
+				Optional:    true,
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "Only list numbers assigned to this connection or application",
+				Optional:    true,
+			},
+			"connection_name": schema.StringAttribute{
+				Description: "Only list numbers whose connection name contains this text",
+				Optional:    true,
+			},
+			"country_code": schema.StringAttribute{
+				Description: "Only list numbers in this ISO 3166-1 alpha-2 country, e.g. US",
+				Optional:    true,
+			},
+			"number_type": schema.StringAttribute{
+				Description: "Only list numbers of this type. One of " + strings.Join(phoneNumberTypes, ", "),
+				Optional:    true,
+			},
+			"customer_reference": schema.StringAttribute{
+				Description: "Only list numbers with this customer reference",
+				Optional:    true,
+			},
+			"billing_group_id": schema.StringAttribute{
+				Description: "Only list numbers in this billing group",
+				Optional:    true,
+			},
+			"messaging_profile_id": schema.StringAttribute{
+				Description: "Only list numbers assigned to this messaging profile",
+				Optional:    true,
+			},
+			"outbound_voice_profile_id": schema.StringAttribute{
+				Description: "Only list numbers whose connection uses this outbound voice profile",
+				Optional:    true,
+			},
+			"phone_numbers": schema.ListNestedAttribute{
+				Description: "Numbers matching the filters",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the phone number",
+							Computed:    true,
+						},
+						"phone_number": schema.StringAttribute{
+							Description: "Number in E.164 format",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the number",
+							Computed:    true,
+						},
+						"tags": schema.ListAttribute{
+							Description: "Tags on the number",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"connection_id": schema.StringAttribute{
+							Description: "ID of the connection or application the number is assigned to",
+							Computed:    true,
+						},
+						"connection_name": schema.StringAttribute{
+							Description: "Name of the connection or application the number is assigned to",
+							Computed:    true,
+						},
+						"customer_reference": schema.StringAttribute{
+							Description: "Customer reference of the number",
+							Computed:    true,
+						},
+						"messaging_profile_id": schema.StringAttribute{
+							Description: "ID of the messaging profile the number is assigned to",
+							Computed:    true,
+						},
+						"messaging_profile_name": schema.StringAttribute{
+							Description: "Name of the messaging profile the number is assigned to",
+							Computed:    true,
+						},
+						"billing_group_id": schema.StringAttribute{
+							Description: "ID of the billing group of the number",
+							Computed:    true,
+						},
+						"phone_number_type": schema.StringAttribute{
+							Description: "Type of the number, e.g. local or toll_free",
+							Computed:    true,
+						},
+						"country_code": schema.StringAttribute{
+							Description: "ISO 3166-1 alpha-2 country of the number",
+							Computed:    true,
+						},
+						"emergency_enabled": schema.BoolAttribute{
+							Description: "Whether emergency services are enabled on the number",
+							Computed:    true,
+						},
+						"emergency_address_id": schema.StringAttribute{
+							Description: "ID of the emergency address of the number",
+							Computed:    true,
+						},
+						"purchased_at": schema.StringAttribute{
+							Description: "When the number was bought, in RFC 3339 format",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PhoneNumbersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = dataSourceClient(ctx, req, resp, "PhoneNumbersDataSource")
+}
+
+func (d *PhoneNumbersDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config PhoneNumbersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for attribute, check := range map[string]struct {
+		value   types.String
+		allowed []string
+	}{
+		"status":      {config.Status, phoneNumberStatuses},
+		"number_type": {config.NumberType, phoneNumberTypes},
+	} {
+		if check.value.IsNull() || check.value.IsUnknown() || slices.Contains(check.allowed, check.value.ValueString()) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			"Invalid "+attribute,
+			fmt.Sprintf("%s must be one of %s, got %q.", attribute, strings.Join(check.allowed, ", "), check.value.ValueString()),
+		)
+	}
+}
+
+func (d *PhoneNumbersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state PhoneNumbersDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	phoneNumbers, err := d.client.ListPhoneNumbersMatching(ctx, telnyx.PhoneNumberFilters{
+		Tag:                    state.Tag.ValueString(),
+		PhoneNumber:            state.PhoneNumber.ValueString(),
+		Status:                 state.Status.ValueString(),
+		ConnectionID:           state.ConnectionID.ValueString(),
+		ConnectionName:         state.ConnectionName.ValueString(),
+		CountryCode:            state.CountryCode.ValueString(),
+		NumberType:             state.NumberType.ValueString(),
+		CustomerReference:      state.CustomerReference.ValueString(),
+		BillingGroupID:         state.BillingGroupID.ValueString(),
+		MessagingProfileID:     state.MessagingProfileID.ValueString(),
+		OutboundVoiceProfileID: state.OutboundVoiceProfileID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing phone numbers", err.Error())
+		return
+	}
+	sort.Slice(phoneNumbers, func(i, j int) bool { return phoneNumbers[i].PhoneNumber < phoneNumbers[j].PhoneNumber })
+
+	values := make([]attr.Value, len(phoneNumbers))
+	for i, phoneNumber := range phoneNumbers {
+		purchasedAt := types.StringNull()
+		if !phoneNumber.PurchasedAt.IsZero() {
+			purchasedAt = types.StringValue(phoneNumber.PurchasedAt.Format(time.RFC3339))
+		}
+		values[i] = types.ObjectValueMust(ownedPhoneNumberType.AttrTypes, map[string]attr.Value{
+			"id":                     types.StringValue(phoneNumber.ID),
+			"phone_number":           types.StringValue(phoneNumber.PhoneNumber),
+			"status":                 types.StringValue(phoneNumber.Status),
+			"tags":                   convertStringsToList(phoneNumber.Tags),
+			"connection_id":          types.StringValue(phoneNumber.ConnectionID),
+			"connection_name":        types.StringValue(phoneNumber.ConnectionName),
+			"customer_reference":     types.StringValue(phoneNumber.CustomerReference),
+			"messaging_profile_id":   types.StringValue(phoneNumber.MessagingProfileID),
+			"messaging_profile_name": types.StringValue(phoneNumber.MessagingProfileName),
+			"billing_group_id":       types.StringValue(phoneNumber.BillingGroupID),
+			"phone_number_type":      types.StringValue(phoneNumber.PhoneNumberType),
+			"country_code":           types.StringValue(phoneNumber.CountryISOAlpha2),
+			"emergency_enabled":      types.BoolValue(phoneNumber.EmergencyEnabled),
+			"emergency_address_id":   types.StringValue(phoneNumber.EmergencyAddressID),
+			"purchased_at":           purchasedAt,
+		})
+	}
+	state.PhoneNumbers = types.ListValueMust(ownedPhoneNumberType, values)
+
+	tflog.Info(ctx, "Listed phone numbers", map[string]interface{}{"results": len(phoneNumbers)})
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,825 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
+)
+
+var (
+	_ resource.Resource                   = &PortingOrderResource{}
+	_ resource.ResourceWithConfigure      = &PortingOrderResource{}
+	_ resource.ResourceWithValidateConfig = &PortingOrderResource{}
+	_ resource.ResourceWithModifyPlan     = &PortingOrderResource{}
+	_ resource.ResourceWithImportState    = &PortingOrderResource{}
+)
+
+const (
+	defaultPortingWaitTimeout  = 30 * time.Minute
+	defaultPortingPollInterval = 30 * time.Second
+)
+
+// portingOrderProgress ranks the statuses a porting order moves through on
+// its way to ported. Orders in exception, cancel-pending or cancelled have
+// left that path.
+var portingOrderProgress = map[string]int{
+	"draft":              0,
+	"submitted":          1,
+	"in-process":         2,
+	"foc-date-confirmed": 3,
+	"ported":             4,
+}
+
+// portingWaitStatuses are the wait_for_status values, in the order a
+// submitted porting order reaches them.
+var portingWaitStatuses = []string{"submitted", "in-process", "foc-date-confirmed", "ported"}
+
+func NewPortingOrderResource() resource.Resource {
+	return &PortingOrderResource{}
+}
+
+type PortingOrderResource struct {
+	client *telnyx.TelnyxClient
+}
+
+type PortingOrderResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	PhoneNumbers         types.List   `tfsdk:"phone_numbers"`
+	CustomerReference    types.String `tfsdk:"customer_reference"`
+	WebhookURL           types.String `tfsdk:"webhook_url"`
+	EndUser              types.Object `tfsdk:"end_user"`
+	LOADocumentID        types.String `tfsdk:"loa_document_id"`
+	InvoiceDocumentID    types.String `tfsdk:"invoice_document_id"`
+	FOCDatetimeRequested types.String `tfsdk:"foc_datetime_requested"`
+	ConnectionID         types.String `tfsdk:"connection_id"`
+	MessagingProfileID   types.String `tfsdk:"messaging_profile_id"`
+	BillingGroupID       types.String `tfsdk:"billing_group_id"`
+	Submit               types.Bool   `tfsdk:"submit"`
+	WaitForStatus        types.String `tfsdk:"wait_for_status"`
+	WaitTimeout          types.String `tfsdk:"wait_timeout"`
+	PollInterval         types.String `tfsdk:"poll_interval"`
+	Status               types.String `tfsdk:"status"`
+	StatusDetails        types.List   `tfsdk:"status_details"`
+	SupportKey           types.String `tfsdk:"support_key"`
+	FastPortEligible     types.Bool   `tfsdk:"fast_port_eligible"`
+	FOCDatetimeActual    types.String `tfsdk:"foc_datetime_actual"`
+	PortingPhoneNumbers  types.List   `tfsdk:"porting_phone_numbers"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+	UpdatedAt            types.String `tfsdk:"updated_at"`
+}
+
+func endUserAdminAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"entity_name":          types.StringType,
+		"auth_person_name":     types.StringType,
+		"billing_phone_number": types.StringType,
+		"account_number":       types.StringType,
+		"tax_identifier":       types.StringType,
+		"pin_passcode":         types.StringType,
+		"business_identifier":  types.StringType,
+	}
+}
+
+func endUserLocationAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"street_address":      types.StringType,
+		"extended_address":    types.StringType,
+		"locality":            types.StringType,
+		"administrative_area": types.StringType,
+		"postal_code":         types.StringType,
+		"country_code":        types.StringType,
+	}
+}
+
+func endUserAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"admin":    types.ObjectType{AttrTypes: endUserAdminAttrTypes()},
+		"location": types.ObjectType{AttrTypes: endUserLocationAttrTypes()},
+	}
+}
+
+func portingPhoneNumberAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"phone_number":        types.StringType,
+		"activation_status":   types.StringType,
+		"portability_status":  types.StringType,
+		"requirements_status": types.StringType,
+		"phone_number_type":   types.StringType,
+	}
+}
+
+func (r *PortingOrderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_porting_order"
+}
+
+func (r *PortingOrderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	optionalString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{Description: description, Optional: true}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Resource for managing Telnyx Porting Orders, which move phone numbers from another carrier to Telnyx. " +
+			"The order is created as a draft and sent to the losing carrier once submit is set. " +
+			"With wait_for_status, apply then waits until the order reaches that status. " +
+			"If the wait times out, the order is kept as it is and the next apply resumes waiting. " +
+			"Destroying a draft deletes it, destroying a submitted order cancels it, and destroying a ported order only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the porting order",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"phone_numbers": schema.ListAttribute{
+				Description: "Phone numbers to port, in E.164 format. They must all be ported from the same carrier, otherwise Telnyx splits them into several orders and creation fails",
+				Required:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"customer_reference": optionalString("Customer reference for the porting order"),
+			"webhook_url":        optionalString("URL Telnyx sends porting order events to"),
+			"end_user": schema.SingleNestedAttribute{
+				Description: "Current owner of the phone numbers, as known to the losing carrier",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"admin": schema.SingleNestedAttribute{
+						Description: "Account holder details",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"entity_name":          optionalString("Name of the person or business owning the account"),
+							"auth_person_name":     optionalString("Name of the person authorized to port the numbers"),
+							"billing_phone_number": optionalString("Billing telephone number of the account with the losing carrier"),
+							"account_number":       optionalString("Account number with the losing carrier"),
+							"tax_identifier":       optionalString("Tax identifier of the account holder"),
+							"pin_passcode": schema.StringAttribute{
+								Description: "PIN or passcode of the account with the losing carrier",
+								Optional:    true,
+								Sensitive:   true,
+							},
+							"business_identifier": optionalString("Business identifier of the account holder"),
+						},
+					},
+					"location": schema.SingleNestedAttribute{
+						Description: "Service address of the phone numbers",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"street_address":      optionalString("Street address"),
+							"extended_address":    optionalString("Additional address line, such as a suite number"),
+							"locality":            optionalString("City or locality"),
+							"administrative_area": optionalString("State, province or region"),
+							"postal_code":         optionalString("Postal code"),
+							"country_code":        optionalString("ISO 3166-1 alpha-2 country code"),
+						},
+					},
+				},
+			},
+			"loa_document_id":        optionalString("ID of the uploaded letter of authorization (LOA) signed by the end user"),
+			"invoice_document_id":    optionalString("ID of the uploaded latest invoice from the losing carrier"),
+			"foc_datetime_requested": optionalString("Requested firm order commitment (FOC) date, when the numbers move to Telnyx, as an RFC 3339 timestamp"),
+			"connection_id":          optionalString("Connection the numbers are assigned to once ported"),
+			"messaging_profile_id":   optionalString("Messaging profile the numbers are assigned to once ported"),
+			"billing_group_id":       optionalString("Billing group the numbers are assigned to once ported"),
+			"submit": schema.BoolAttribute{
+				Description: "Whether to submit the order to the losing carrier. The end user, documents and FOC date must be set first. A submitted order cannot return to draft. Defaults to false",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"wait_for_status": schema.StringAttribute{
+				Description: "Status to wait for after submitting: one of " + strings.Join(portingWaitStatuses, ", ") + ". Waiting stops early when the order hits an exception or is cancelled",
+				Optional:    true,
+			},
+			"wait_timeout": schema.StringAttribute{
+				Description: fmt.Sprintf("How long to wait for wait_for_status, as a Go duration string. Defaults to %q", defaultPortingWaitTimeout.String()),
+				Optional:    true,
+			},
+			"poll_interval": schema.StringAttribute{
+				Description: fmt.Sprintf("How often to check the order status while waiting, as a Go duration string. Defaults to %q", defaultPortingPollInterval.String()),
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the porting order: draft, submitted, in-process, exception, foc-date-confirmed, cancel-pending, ported or cancelled",
+				Computed:    true,
+			},
+			"status_details": schema.ListAttribute{
+				Description: "Explanations of the status, such as the reasons for an exception",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"support_key": schema.StringAttribute{
+				Description: "Key to quote when contacting Telnyx support about the order",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fast_port_eligible": schema.BoolAttribute{
+				Description: "Whether the numbers can be ported on an expedited schedule",
+				Computed:    true,
+			},
+			"foc_datetime_actual": schema.StringAttribute{
+				Description: "FOC date confirmed by the losing carrier, as an RFC 3339 timestamp",
+				Computed:    true,
+			},
+			"porting_phone_numbers": schema.ListNestedAttribute{
+				Description: "Status of each phone number in the order",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"phone_number": schema.StringAttribute{
+							Description: "Phone number in E.164 format",
+							Computed:    true,
+						},
+						"activation_status": schema.StringAttribute{
+							Description: "Activation status of the number, such as New, Pending, Activate RDY or Active",
+							Computed:    true,
+						},
+						"portability_status": schema.StringAttribute{
+							Description: "Whether the losing carrier confirmed the number can be ported",
+							Computed:    true,
+						},
+						"requirements_status": schema.StringAttribute{
+							Description: "Whether the information required to port the number is complete",
+							Computed:    true,
+						},
+						"phone_number_type": schema.StringAttribute{
+							Description: "Type of the number, such as local or toll-free",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "ISO 8601 formatted date indicating when the resource was created",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "ISO 8601 formatted date indicating when the resource was updated",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *PortingOrderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		client, ok := req.ProviderData.(*telnyx.TelnyxClient)
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Unexpected Resource Configure Type",
+				fmt.Sprintf("Expected *telnyx.TelnyxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			)
+			return
+		}
+		r.client = client
+		tflog.Info(ctx, "Configured Telnyx client for PortingOrderResource")
+	}
+}
+
+func (r *PortingOrderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config PortingOrderResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.PhoneNumbers.IsUnknown() && !config.PhoneNumbers.IsNull() {
+		for i, element := range config.PhoneNumbers.Elements() {
+			phoneNumber, ok := element.(types.String)
+			if !ok || phoneNumber.IsUnknown() || e164Pattern.MatchString(phoneNumber.ValueString()) {
+				continue
+			}
+			resp.Diagnostics.AddAttributeError(
+				path.Root("phone_numbers").AtListIndex(i),
+				"Invalid phone number",
+				fmt.Sprintf("Phone numbers must be in E.164 format, e.g. +13125550100, got %q.", phoneNumber.ValueString()),
+			)
+		}
+	}
+
+	if !config.FOCDatetimeRequested.IsNull() && !config.FOCDatetimeRequested.IsUnknown() {
+		if _, ok := parseTimestamp(config.FOCDatetimeRequested); !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("foc_datetime_requested"),
+				"Invalid foc_datetime_requested",
+				fmt.Sprintf("foc_datetime_requested must be an RFC 3339 timestamp such as \"2030-01-02T15:04:05Z\", got %q.", config.FOCDatetimeRequested.ValueString()),
+			)
+		}
+	}
+
+	if !config.WaitForStatus.IsNull() && !config.WaitForStatus.IsUnknown() {
+		waitForStatus := config.WaitForStatus.ValueString()
+		if !slices.Contains(portingWaitStatuses, waitForStatus) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("wait_for_status"),
+				"Invalid wait_for_status",
+				fmt.Sprintf("wait_for_status must be one of %s, got %q.", strings.Join(portingWaitStatuses, ", "), waitForStatus),
+			)
+		} else if !config.Submit.IsUnknown() && !config.Submit.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("wait_for_status"),
+				"Invalid wait_for_status",
+				"wait_for_status needs submit = true, since only submitted orders change status.",
+			)
+		}
+	}
+
+	if !config.WaitTimeout.IsUnknown() {
+		parseDurationAttribute(config.WaitTimeout, "wait_timeout", defaultPortingWaitTimeout, &resp.Diagnostics)
+	}
+	if !config.PollInterval.IsUnknown() {
+		parseDurationAttribute(config.PollInterval, "poll_interval", defaultPortingPollInterval, &resp.Diagnostics)
+	}
+}
+
+// ModifyPlan refuses to take a submitted order back to draft, and plans an
+// update that resumes waiting when an earlier wait for wait_for_status ended
+// before the order got there.
+func (r *PortingOrderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state PortingOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Submit.ValueBool() && !plan.Submit.IsUnknown() && !plan.Submit.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("submit"),
+			"Porting order already submitted",
+			fmt.Sprintf("Porting order %s has been submitted and cannot return to draft. Destroy the resource to cancel it instead.", state.ID.ValueString()),
+		)
+		return
+	}
+
+	if plan.WaitForStatus.IsNull() || plan.WaitForStatus.IsUnknown() || !plan.Submit.ValueBool() {
+		return
+	}
+	status := state.Status.ValueString()
+	if _, onPath := portingOrderProgress[status]; !onPath || portingOrderReached(status, plan.WaitForStatus.ValueString()) {
+		return
+	}
+	tflog.Info(ctx, "Porting order has not reached wait_for_status yet, planning to wait again", map[string]interface{}{"id": state.ID.ValueString(), "status": status})
+	plan.Status = types.StringUnknown()
+	plan.StatusDetails = types.ListUnknown(types.StringType)
+	plan.FOCDatetimeActual = types.StringUnknown()
+	plan.PortingPhoneNumbers = types.ListUnknown(types.ObjectType{AttrTypes: portingPhoneNumberAttrTypes()})
+	plan.UpdatedAt = types.StringUnknown()
+
+	diags := resp.Plan.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *PortingOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PortingOrderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	phoneNumbers, diags := convertListToStrings(ctx, plan.PhoneNumbers)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check portability first, so numbers that cannot be ported are
+	// reported with the reason instead of a bare validation error
+	results, err := r.client.CheckPortability(ctx, phoneNumbers)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error checking portability", err)
+		return
+	}
+	var notPortable []string
+	for _, result := range results {
+		if !result.Portable {
+			notPortable = append(notPortable, fmt.Sprintf("%s: %s", result.PhoneNumber, result.NotPortableReason))
+		}
+	}
+	if len(notPortable) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("phone_numbers"),
+			"Phone numbers cannot be ported",
+			strings.Join(notPortable, "\n"),
+		)
+		return
+	}
+
+	orders, err := r.client.CreatePortingOrders(ctx, telnyx.CreatePortingOrderRequest{
+		PhoneNumbers:      phoneNumbers,
+		CustomerReference: plan.CustomerReference.ValueString(),
+	})
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error creating porting order", err)
+		return
+	}
+	if len(orders) != 1 {
+		r.deleteSplitOrders(ctx, orders, &resp.Diagnostics)
+		return
+	}
+	order := &orders[0]
+
+	tflog.Info(ctx, "Created Porting Order", map[string]interface{}{"id": order.ID, "support_key": order.SupportKey})
+
+	// From here on the draft exists, so failures still record it in the
+	// state; Terraform then replaces the draft on the next apply.
+	defer func() {
+		resp.Diagnostics.Append(r.setState(ctx, &plan, order)...)
+		diags := resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+	}()
+
+	updated, err := r.client.UpdatePortingOrder(ctx, order.ID, portingOrderRequest(plan))
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error updating porting order", err)
+		return
+	}
+	order = updated
+
+	order = r.submitAndWait(ctx, order, plan, &resp.Diagnostics)
+}
+
+func (r *PortingOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PortingOrderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := r.client.GetPortingOrder(ctx, state.ID.ValueString())
+	if telnyx.IsNotFound(err) || (err == nil && order.Status.Value == "cancelled") {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading porting order", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.setState(ctx, &state, order)...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *PortingOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state PortingOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := r.client.GetPortingOrder(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading porting order", err.Error())
+		return
+	}
+
+	// Telnyx only accepts changes to drafts and orders in exception, so only
+	// send them when the order details actually changed
+	if portingOrderDetailsChanged(plan, state) {
+		order, err = r.client.UpdatePortingOrder(ctx, state.ID.ValueString(), portingOrderRequest(plan))
+		if err != nil {
+			addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error updating porting order", err)
+			return
+		}
+	}
+
+	order = r.submitAndWait(ctx, order, plan, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(r.setState(ctx, &plan, order)...)
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *PortingOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PortingOrderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	order, err := r.client.GetPortingOrder(ctx, id)
+	if telnyx.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading porting order", err.Error())
+		return
+	}
+
+	switch order.Status.Value {
+	case "draft":
+		err = r.client.DeletePortingOrder(ctx, id)
+	case "ported":
+		resp.Diagnostics.AddWarning(
+			"Porting order already completed",
+			fmt.Sprintf("Porting order %s has already ported its numbers, so it cannot be cancelled. The numbers stay on the account; manage them with telnyx_phone_number.", id),
+		)
+	case "cancel-pending", "cancelled":
+	default:
+		_, err = r.client.CancelPortingOrder(ctx, id)
+	}
+	if err != nil && !telnyx.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting porting order", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Porting Order", map[string]interface{}{"id": id, "status": order.Status.Value})
+}
+
+func (r *PortingOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// deleteSplitOrders removes the drafts Telnyx created when it split the
+// phone numbers across carriers, and explains how to group them instead.
+func (r *PortingOrderResource) deleteSplitOrders(ctx context.Context, orders []telnyx.PortingOrder, diags *diag.Diagnostics) {
+	var groups []string
+	for _, order := range orders {
+		var phoneNumbers []string
+		if numbers, err := r.client.ListPortingOrderPhoneNumbers(ctx, order.ID); err == nil {
+			for _, number := range numbers {
+				phoneNumbers = append(phoneNumbers, number.PhoneNumber)
+			}
+		}
+		groups = append(groups, "["+strings.Join(phoneNumbers, ", ")+"]")
+		if err := r.client.DeletePortingOrder(ctx, order.ID); err != nil && !telnyx.IsNotFound(err) {
+			diags.AddError("Error deleting porting order", fmt.Sprintf("ID: %s, Error: %s", order.ID, err.Error()))
+		}
+	}
+	diags.AddAttributeError(
+		path.Root("phone_numbers"),
+		"Phone numbers need more than one porting order",
+		fmt.Sprintf("Telnyx split the phone numbers into %d porting orders, one per losing carrier. Declare one telnyx_porting_order for each group: %s.", len(orders), strings.Join(groups, ", ")),
+	)
+}
+
+// submitAndWait submits the order when the plan asks for it, then waits for
+// wait_for_status. It returns the latest copy of the order; problems are
+// added to diags.
+func (r *PortingOrderResource) submitAndWait(ctx context.Context, order *telnyx.PortingOrder, plan PortingOrderResourceModel, diags *diag.Diagnostics) *telnyx.PortingOrder {
+	if !plan.Submit.ValueBool() {
+		return order
+	}
+	if status := order.Status.Value; status == "draft" || status == "exception" {
+		submitted, err := r.client.SubmitPortingOrder(ctx, order.ID)
+		if err != nil {
+			diags.AddError("Error submitting porting order", err.Error())
+			return order
+		}
+		tflog.Info(ctx, "Submitted Porting Order", map[string]interface{}{"id": order.ID})
+		order = submitted
+	}
+	if plan.WaitForStatus.IsNull() {
+		return order
+	}
+
+	timeout, ok := parseDurationAttribute(plan.WaitTimeout, "wait_timeout", defaultPortingWaitTimeout, diags)
+	if !ok {
+		return order
+	}
+	interval, ok := parseDurationAttribute(plan.PollInterval, "poll_interval", defaultPortingPollInterval, diags)
+	if !ok {
+		return order
+	}
+	return r.waitForStatus(ctx, order, plan.WaitForStatus.ValueString(), timeout, interval, diags)
+}
+
+// waitForStatus polls the order until it reaches target. Running out of
+// time, or the order leaving the path to ported, ends the wait with a
+// warning rather than an error, so the order is not marked for replacement.
+func (r *PortingOrderResource) waitForStatus(ctx context.Context, order *telnyx.PortingOrder, target string, timeout, interval time.Duration, diags *diag.Diagnostics) *telnyx.PortingOrder {
+	deadline := time.Now().Add(timeout)
+	for !portingOrderReached(order.Status.Value, target) {
+		if _, onPath := portingOrderProgress[order.Status.Value]; !onPath {
+			diags.AddWarning(
+				"Porting order stopped before "+target,
+				fmt.Sprintf("Porting order %s is %s.%s", order.ID, order.Status.Value, formatStatusDetails(order.Status)),
+			)
+			return order
+		}
+		if time.Now().Add(interval).After(deadline) {
+			diags.AddWarning(
+				"Timed out waiting for porting order",
+				fmt.Sprintf("Porting order %s is still %s after %s. The next apply resumes waiting for %s.", order.ID, order.Status.Value, timeout, target),
+			)
+			return order
+		}
+
+		tflog.Debug(ctx, "Waiting for porting order status", map[string]interface{}{"id": order.ID, "status": order.Status.Value, "target": target})
+		select {
+		case <-ctx.Done():
+			diags.AddWarning("Stopped waiting for porting order", ctx.Err().Error())
+			return order
+		case <-time.After(interval):
+		}
+
+		current, err := r.client.GetPortingOrder(ctx, order.ID)
+		if err != nil {
+			diags.AddError("Error reading porting order", err.Error())
+			return order
+		}
+		order = current
+	}
+	tflog.Info(ctx, "Porting order reached status", map[string]interface{}{"id": order.ID, "status": order.Status.Value})
+	return order
+}
+
+func portingOrderReached(status, target string) bool {
+	rank, ok := portingOrderProgress[status]
+	return ok && rank >= portingOrderProgress[target]
+}
+
+func formatStatusDetails(status telnyx.PortingOrderStatus) string {
+	var details string
+	for _, detail := range status.Details {
+		details += fmt.Sprintf(" %s: %s.", detail.Code, detail.Description)
+	}
+	return details
+}
+
+func portingOrderDetailsChanged(plan, state PortingOrderResourceModel) bool {
+	return !plan.CustomerReference.Equal(state.CustomerReference) ||
+		!plan.WebhookURL.Equal(state.WebhookURL) ||
+		!plan.EndUser.Equal(state.EndUser) ||
+		!plan.LOADocumentID.Equal(state.LOADocumentID) ||
+		!plan.InvoiceDocumentID.Equal(state.InvoiceDocumentID) ||
+		!plan.FOCDatetimeRequested.Equal(state.FOCDatetimeRequested) ||
+		!plan.ConnectionID.Equal(state.ConnectionID) ||
+		!plan.MessagingProfileID.Equal(state.MessagingProfileID) ||
+		!plan.BillingGroupID.Equal(state.BillingGroupID)
+}
+
+func portingOrderRequest(plan PortingOrderResourceModel) telnyx.UpdatePortingOrderRequest {
+	request := telnyx.UpdatePortingOrderRequest{
+		CustomerReference: telnyx.StringPtr(plan.CustomerReference.ValueString()),
+		WebhookURL:        telnyx.StringPtr(plan.WebhookURL.ValueString()),
+		EndUser:           buildPortingEndUser(plan.EndUser),
+		Documents: &telnyx.PortingOrderDocuments{
+			LOA:     plan.LOADocumentID.ValueString(),
+			Invoice: plan.InvoiceDocumentID.ValueString(),
+		},
+		PhoneNumberConfiguration: &telnyx.PortingOrderPhoneNumberConfiguration{
+			ConnectionID:       plan.ConnectionID.ValueString(),
+			MessagingProfileID: plan.MessagingProfileID.ValueString(),
+			BillingGroupID:     plan.BillingGroupID.ValueString(),
+		},
+	}
+	if focDate, ok := parseTimestamp(plan.FOCDatetimeRequested); ok {
+		request.ActivationSettings = &telnyx.PortingOrderActivationSettings{FOCDatetimeRequested: &focDate}
+	}
+	return request
+}
+
+// buildPortingEndUser returns the end user to send, with empty values for
+// the fields left unset so removing them from the configuration clears them.
+func buildPortingEndUser(endUser types.Object) *telnyx.PortingOrderEndUser {
+	result := &telnyx.PortingOrderEndUser{}
+	if endUser.IsNull() || endUser.IsUnknown() {
+		return result
+	}
+	value := func(object attr.Value, name string) string {
+		typed, ok := object.(types.Object)
+		if !ok || typed.IsNull() || typed.IsUnknown() {
+			return ""
+		}
+		return typed.Attributes()[name].(types.String).ValueString()
+	}
+	admin := endUser.Attributes()["admin"]
+	location := endUser.Attributes()["location"]
+	result.Admin = telnyx.PortingOrderEndUserAdmin{
+		EntityName:         value(admin, "entity_name"),
+		AuthPersonName:     value(admin, "auth_person_name"),
+		BillingPhoneNumber: value(admin, "billing_phone_number"),
+		AccountNumber:      value(admin, "account_number"),
+		TaxIdentifier:      value(admin, "tax_identifier"),
+		PINPasscode:        value(admin, "pin_passcode"),
+		BusinessIdentifier: value(admin, "business_identifier"),
+	}
+	result.Location = telnyx.PortingOrderEndUserLocation{
+		StreetAddress:      value(location, "street_address"),
+		ExtendedAddress:    value(location, "extended_address"),
+		Locality:           value(location, "locality"),
+		AdministrativeArea: value(location, "administrative_area"),
+		PostalCode:         value(location, "postal_code"),
+		CountryCode:        value(location, "country_code"),
+	}
+	return result
+}
+
+// portingEndUserValue returns the end user as state, with unset fields and
+// empty sections as null to match a configuration that leaves them out.
+func portingEndUserValue(endUser telnyx.PortingOrderEndUser) types.Object {
+	admin := types.ObjectNull(endUserAdminAttrTypes())
+	if endUser.Admin != (telnyx.PortingOrderEndUserAdmin{}) {
+		admin = types.ObjectValueMust(endUserAdminAttrTypes(), map[string]attr.Value{
+			"entity_name":          stringOrNull(endUser.Admin.EntityName),
+			"auth_person_name":     stringOrNull(endUser.Admin.AuthPersonName),
+			"billing_phone_number": stringOrNull(endUser.Admin.BillingPhoneNumber),
+			"account_number":       stringOrNull(endUser.Admin.AccountNumber),
+			"tax_identifier":       stringOrNull(endUser.Admin.TaxIdentifier),
+			"pin_passcode":         stringOrNull(endUser.Admin.PINPasscode),
+			"business_identifier":  stringOrNull(endUser.Admin.BusinessIdentifier),
+		})
+	}
+	location := types.ObjectNull(endUserLocationAttrTypes())
+	if endUser.Location != (telnyx.PortingOrderEndUserLocation{}) {
+		location = types.ObjectValueMust(endUserLocationAttrTypes(), map[string]attr.Value{
+			"street_address":      stringOrNull(endUser.Location.StreetAddress),
+			"extended_address":    stringOrNull(endUser.Location.ExtendedAddress),
+			"locality":            stringOrNull(endUser.Location.Locality),
+			"administrative_area": stringOrNull(endUser.Location.AdministrativeArea),
+			"postal_code":         stringOrNull(endUser.Location.PostalCode),
+			"country_code":        stringOrNull(endUser.Location.CountryCode),
+		})
+	}
+	if admin.IsNull() && location.IsNull() {
+		return types.ObjectNull(endUserAttrTypes())
+	}
+	return types.ObjectValueMust(endUserAttrTypes(), map[string]attr.Value{"admin": admin, "location": location})
+}
+
+// setState copies the order and the status of its numbers into state. The
+// configured phone_numbers are kept as ordered in the configuration; they
+// are only read back on import.
+func (r *PortingOrderResource) setState(ctx context.Context, state *PortingOrderResourceModel, order *telnyx.PortingOrder) diag.Diagnostics {
+	var diags diag.Diagnostics
+	numbers, err := r.client.ListPortingOrderPhoneNumbers(ctx, order.ID)
+	if err != nil {
+		diags.AddError("Error reading porting order phone numbers", err.Error())
+		return diags
+	}
+
+	state.ID = types.StringValue(order.ID)
+	state.CustomerReference = stringOrNull(order.CustomerReference)
+	state.WebhookURL = stringOrNull(order.WebhookURL)
+	state.EndUser = portingEndUserValue(order.EndUser)
+	state.LOADocumentID = stringOrNull(order.Documents.LOA)
+	state.InvoiceDocumentID = stringOrNull(order.Documents.Invoice)
+	state.FOCDatetimeRequested = timestampValue(state.FOCDatetimeRequested, order.ActivationSettings.FOCDatetimeRequested)
+	state.FOCDatetimeActual = timestampValue(state.FOCDatetimeActual, order.ActivationSettings.FOCDatetimeActual)
+	state.ConnectionID = stringOrNull(order.PhoneNumberConfiguration.ConnectionID)
+	state.MessagingProfileID = stringOrNull(order.PhoneNumberConfiguration.MessagingProfileID)
+	state.BillingGroupID = stringOrNull(order.PhoneNumberConfiguration.BillingGroupID)
+	state.Submit = types.BoolValue(order.Status.Value != "draft")
+	state.Status = types.StringValue(order.Status.Value)
+	state.SupportKey = types.StringValue(order.SupportKey)
+	state.FastPortEligible = types.BoolValue(order.ActivationSettings.FastPortEligible)
+	state.CreatedAt = types.StringValue(order.CreatedAt.String())
+	state.UpdatedAt = types.StringValue(order.UpdatedAt.String())
+
+	details := make([]string, 0, len(order.Status.Details))
+	for _, detail := range order.Status.Details {
+		details = append(details, fmt.Sprintf("%s: %s", detail.Code, detail.Description))
+	}
+	state.StatusDetails = convertStringsToList(details)
+
+	phoneNumbers := make([]string, 0, len(numbers))
+	statuses := make([]attr.Value, 0, len(numbers))
+	for _, number := range numbers {
+		phoneNumbers = append(phoneNumbers, number.PhoneNumber)
+		statuses = append(statuses, types.ObjectValueMust(portingPhoneNumberAttrTypes(), map[string]attr.Value{
+			"phone_number":        types.StringValue(number.PhoneNumber),
+			"activation_status":   types.StringValue(number.ActivationStatus),
+			"portability_status":  types.StringValue(number.PortabilityStatus),
+			"requirements_status": types.StringValue(number.RequirementsStatus),
+			"phone_number_type":   types.StringValue(number.PhoneNumberType),
+		}))
+	}
+	if state.PhoneNumbers.IsNull() {
+		state.PhoneNumbers = convertStringsToList(phoneNumbers)
+	}
+	state.PortingPhoneNumbers, diags = types.ListValue(types.ObjectType{AttrTypes: portingPhoneNumberAttrTypes()}, statuses)
+	return diags
+}
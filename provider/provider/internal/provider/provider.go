@@ -2,16 +2,26 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/petsinc/telnyx-rest-client/pkg/cassette"
 	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
 )
 
 var (
-	_ provider.Provider = &TelnyxProvider{}
+	_ provider.Provider                   = &TelnyxProvider{}
+	_ provider.ProviderWithValidateConfig = &TelnyxProvider{}
 )
 
 func New(version string) func() provider.Provider {
@@ -26,6 +36,21 @@ type TelnyxProvider struct {
 	version string
 }
 
+type TelnyxProviderModel struct {
+	APIKey                    types.String  `tfsdk:"api_key"`
+	BaseURL                   types.String  `tfsdk:"base_url"`
+	MaxRetries                types.Int64   `tfsdk:"max_retries"`
+	MinRetryBackoff           types.String  `tfsdk:"min_retry_backoff"`
+	MaxRetryBackoff           types.String  `tfsdk:"max_retry_backoff"`
+	RequestTimeout            types.String  `tfsdk:"request_timeout"`
+	UserAgentSuffix           types.String  `tfsdk:"user_agent_suffix"`
+	SkipCredentialsValidation types.Bool    `tfsdk:"skip_credentials_validation"`
+	HTTPDebug                 types.String  `tfsdk:"http_debug"`
+	RateLimit                 types.Float64 `tfsdk:"rate_limit"`
+	RateLimitBurst            types.Int64   `tfsdk:"rate_limit_burst"`
+	EndpointRateLimits        types.Map     `tfsdk:"endpoint_rate_limits"`
+}
+
 func (p *TelnyxProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "telnyx"
 	resp.Version = p.version
@@ -33,28 +58,296 @@ func (p *TelnyxProvider) Metadata(_ context.Context, _ provider.MetadataRequest,
 
 func (p *TelnyxProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Telnyx Provider",
+		Description: "Telnyx Provider. Each provider block creates its own API client, so aliased providers can manage different Telnyx accounts in the same configuration.",
+		Attributes: map[string]schema.Attribute{
+			"api_key": schema.StringAttribute{
+				Description: "Telnyx API key. Can also be set with the TELNYX_API_KEY environment variable",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"base_url": schema.StringAttribute{
+				Description: "Base URL of the Telnyx API. Can also be set with the TELNYX_BASE_URL environment variable. Defaults to " + telnyx.DefaultBaseURL,
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of times a failed request is retried. Defaults to %d", telnyx.DefaultMaxRetries),
+				Optional:    true,
+			},
+			"min_retry_backoff": schema.StringAttribute{
+				Description: fmt.Sprintf("Upper bound of the randomized wait before the first retry, as a Go duration string. Each later retry may wait up to twice as long as the previous one. A Retry-After header from the API takes precedence. Defaults to %q", telnyx.DefaultMinRetryBackoff.String()),
+				Optional:    true,
+			},
+			"max_retry_backoff": schema.StringAttribute{
+				Description: fmt.Sprintf("Longest randomized wait between retries, as a Go duration string. Defaults to %q", telnyx.DefaultMaxRetryBackoff.String()),
+				Optional:    true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Time limit for a single HTTP request as a Go duration string, for example \"30s\" or \"2m\". Defaults to no limit",
+				Optional:    true,
+			},
+			"user_agent_suffix": schema.StringAttribute{
+				Description: "Text appended to the User-Agent header sent with every request",
+				Optional:    true,
+			},
+			"rate_limit": schema.Float64Attribute{
+				Description: "Maximum average number of requests per second sent to each endpoint family, such as fqdns or phone_numbers. Requests beyond the limit wait instead of failing with 429. Defaults to 0, which leaves requests unthrottled apart from pauses requested by the Telnyx rate limit headers",
+				Optional:    true,
+			},
+			"rate_limit_burst": schema.Int64Attribute{
+				Description: "Number of requests that may be sent at once before rate_limit and endpoint_rate_limits apply. Requires one of them to be set. Defaults to the rate rounded up",
+				Optional:    true,
+			},
+			"endpoint_rate_limits": schema.MapAttribute{
+				Description: "Requests per second for individual endpoint families, keyed by the first segment of the API path, for example { number_orders = 1 }. Overrides rate_limit for those families",
+				ElementType: types.Float64Type,
+				Optional:    true,
+			},
+			"http_debug": schema.StringAttribute{
+				Description: "Log each Telnyx API request at the DEBUG level, visible with TF_LOG=DEBUG. One of \"off\", \"headers\" or \"bodies\". Credentials such as the Authorization header and password fields are redacted. Can also be set with the TELNYX_REST_CLIENT_HTTP_DEBUG environment variable. Defaults to \"off\"",
+				Optional:    true,
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				Description: "Skip checking the API key against the Telnyx API when the provider is configured. Defaults to false",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig rejects a rate_limit_burst that would be silently ignored
+// because no rate limit is set for it to apply to.
+func (p *TelnyxProvider) ValidateConfig(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var config TelnyxProviderModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.RateLimitBurst.IsNull() && config.RateLimit.IsNull() && config.EndpointRateLimits.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rate_limit_burst"),
+			"Invalid rate_limit_burst",
+			"rate_limit_burst only applies together with rate_limit or endpoint_rate_limits. Set rate_limit as well, or remove rate_limit_burst.",
+		)
 	}
 }
 
 func (p *TelnyxProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
-	client := telnyx.NewClient()
+	var config TelnyxProviderModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Checked in schema order, so several unknown values are always
+	// reported in the same order
+	unknownAttributes := []struct {
+		name    string
+		unknown bool
+	}{
+		{"api_key", config.APIKey.IsUnknown()},
+		{"base_url", config.BaseURL.IsUnknown()},
+		{"max_retries", config.MaxRetries.IsUnknown()},
+		{"min_retry_backoff", config.MinRetryBackoff.IsUnknown()},
+		{"max_retry_backoff", config.MaxRetryBackoff.IsUnknown()},
+		{"request_timeout", config.RequestTimeout.IsUnknown()},
+		{"user_agent_suffix", config.UserAgentSuffix.IsUnknown()},
+		{"rate_limit", config.RateLimit.IsUnknown()},
+		{"rate_limit_burst", config.RateLimitBurst.IsUnknown()},
+		{"endpoint_rate_limits", config.EndpointRateLimits.IsUnknown()},
+		{"http_debug", config.HTTPDebug.IsUnknown()},
+		{"skip_credentials_validation", config.SkipCredentialsValidation.IsUnknown()},
+	}
+	for _, attribute := range unknownAttributes {
+		if attribute.unknown {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute.name),
+				"Unknown Telnyx provider configuration value",
+				fmt.Sprintf("The provider cannot create the Telnyx API client because %s is not known until apply. Set it to a static value or use the matching environment variable.", attribute.name),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	tflog.Info(ctx, "Configured Telnyx provider")
+	apiKey := os.Getenv("TELNYX_API_KEY")
+	if !config.APIKey.IsNull() {
+		apiKey = config.APIKey.ValueString()
+	}
+	if apiKey == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key"),
+			"Missing Telnyx API key",
+			"Set api_key in the provider block or the TELNYX_API_KEY environment variable.",
+		)
+		return
+	}
+
+	baseURL := telnyx.DefaultBaseURL
+	if envBaseURL := os.Getenv("TELNYX_BASE_URL"); envBaseURL != "" {
+		baseURL = envBaseURL
+	}
+	if !config.BaseURL.IsNull() {
+		baseURL = config.BaseURL.ValueString()
+	}
+
+	userAgent := fmt.Sprintf("terraform-provider-telnyx/%s (+https://registry.terraform.io/providers/petsinc/telnyx) Terraform/%s %s", p.version, req.TerraformVersion, telnyx.DefaultUserAgent)
+	if suffix := strings.TrimSpace(config.UserAgentSuffix.ValueString()); suffix != "" {
+		userAgent += " " + suffix
+	}
+
+	opts := []telnyx.Option{
+		telnyx.WithAPIKey(apiKey),
+		telnyx.WithBaseURL(baseURL),
+		telnyx.WithUserAgent(userAgent),
+		telnyx.WithHTTPDebugFunc(func(ctx context.Context, message string, fields map[string]interface{}) {
+			tflog.Debug(ctx, message, fields)
+		}),
+	}
+	if !config.HTTPDebug.IsNull() {
+		httpDebug, err := telnyx.ParseHTTPDebugLevel(config.HTTPDebug.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("http_debug"), "Invalid http_debug", err.Error())
+			return
+		}
+		opts = append(opts, telnyx.WithHTTPDebug(httpDebug))
+	}
+	if !config.MaxRetries.IsNull() {
+		if config.MaxRetries.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("max_retries"), "Invalid max_retries", "max_retries must be zero or greater.")
+			return
+		}
+		opts = append(opts, telnyx.WithMaxRetries(int(config.MaxRetries.ValueInt64())))
+	}
+	if !config.MinRetryBackoff.IsNull() || !config.MaxRetryBackoff.IsNull() {
+		minBackoff, ok := parseDurationAttribute(config.MinRetryBackoff, "min_retry_backoff", telnyx.DefaultMinRetryBackoff, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		maxBackoff, ok := parseDurationAttribute(config.MaxRetryBackoff, "max_retry_backoff", telnyx.DefaultMaxRetryBackoff, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		if minBackoff <= 0 || maxBackoff < minBackoff {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retry_backoff"),
+				"Invalid retry backoff",
+				fmt.Sprintf("min_retry_backoff (%s) must be greater than zero and must not exceed max_retry_backoff (%s).", minBackoff, maxBackoff),
+			)
+			return
+		}
+		opts = append(opts, telnyx.WithRetryBackoff(minBackoff, maxBackoff))
+	}
+	if !config.RateLimit.IsNull() {
+		if config.RateLimit.ValueFloat64() < 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("rate_limit"), "Invalid rate_limit", "rate_limit must be zero or greater.")
+			return
+		}
+		opts = append(opts, telnyx.WithRateLimit(config.RateLimit.ValueFloat64(), int(config.RateLimitBurst.ValueInt64())))
+	}
+	if !config.EndpointRateLimits.IsNull() {
+		var endpointRateLimits map[string]float64
+		resp.Diagnostics.Append(config.EndpointRateLimits.ElementsAs(ctx, &endpointRateLimits, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for family, rate := range endpointRateLimits {
+			if rate < 0 {
+				resp.Diagnostics.AddAttributeError(path.Root("endpoint_rate_limits").AtMapKey(family), "Invalid endpoint_rate_limits", "Rate limits must be zero or greater.")
+				return
+			}
+			opts = append(opts, telnyx.WithEndpointRateLimit(family, rate, int(config.RateLimitBurst.ValueInt64())))
+		}
+	}
+	if !config.RequestTimeout.IsNull() {
+		timeout, err := time.ParseDuration(config.RequestTimeout.ValueString())
+		if err != nil || timeout < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid request_timeout",
+				fmt.Sprintf("request_timeout must be a non-negative Go duration such as \"30s\", got %q.", config.RequestTimeout.ValueString()),
+			)
+			return
+		}
+		opts = append(opts, telnyx.WithTimeout(timeout))
+	}
+
+	// TELNYX_REST_CLIENT_CASSETTE records the run's API traffic to a fixture
+	// or replays it from one, see the README
+	recording, err := cassette.FromEnv()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to load Telnyx API cassette", err.Error())
+		return
+	}
+	opts = append(opts, telnyx.WithCassette(recording))
+
+	client, err := telnyx.NewClient(opts...)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create Telnyx client", err.Error())
+		return
+	}
+
+	if !config.SkipCredentialsValidation.ValueBool() {
+		if _, err := client.GetBalance(ctx); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_key"),
+				"Unable to validate Telnyx credentials",
+				"The Telnyx API rejected the configured credentials or could not be reached. "+
+					"Check api_key and base_url, or set skip_credentials_validation to true to skip this check.\n\n"+
+					"Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Configured Telnyx provider", map[string]interface{}{"base_url": baseURL})
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
 
+// parseDurationAttribute parses a Go duration string attribute, returning
+// fallback when it is null and adding an attribute error when it is invalid.
+func parseDurationAttribute(value types.String, name string, fallback time.Duration, diags *diag.Diagnostics) (time.Duration, bool) {
+	if value.IsNull() {
+		return fallback, true
+	}
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil || duration < 0 {
+		diags.AddAttributeError(
+			path.Root(name),
+			"Invalid "+name,
+			fmt.Sprintf("%s must be a non-negative Go duration such as \"30s\", got %q.", name, value.ValueString()),
+		)
+		return 0, false
+	}
+	return duration, true
+}
+
 func (p *TelnyxProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewBillingGroupResource,
 		NewOutboundVoiceProfileResource,
 		NewMessagingProfileResource,
 		NewCredentialConnectionResource,
+		NewTelephonyCredentialResource,
 		NewFQDNConnectionResource,
 		NewFQDNResource,
+		NewIPConnectionResource,
+		NewIPResource,
 		NewNumberOrderResource,
+		NewPhoneNumberResource,
+		NewPhoneNumberVoiceSettingsResource,
+		NewPhoneNumberMessagingSettingsResource,
+		NewAddressResource,
+		NewNotificationProfileResource,
+		NewNotificationChannelResource,
+		NewNotificationSettingResource,
+		NewNumberReservationResource,
+		NewPortingOrderResource,
 		NewTeXMLApplicationResource,
 		NewPhoneNumberLookupResource,
 		NewCallControlApplicationResource,
@@ -62,5 +355,16 @@ func (p *TelnyxProvider) Resources(_ context.Context) []func() resource.Resource
 }
 
 func (p *TelnyxProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewBillingGroupDataSource,
+		NewOutboundVoiceProfileDataSource,
+		NewMessagingProfileDataSource,
+		NewCredentialConnectionDataSource,
+		NewFQDNConnectionDataSource,
+		NewTeXMLApplicationDataSource,
+		NewCallControlApplicationDataSource,
+		NewAvailablePhoneNumbersDataSource,
+		NewPhoneNumbersDataSource,
+		NewNumberLookupDataSource,
+	}
 }
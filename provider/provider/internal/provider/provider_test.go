@@ -1,13 +1,21 @@
 package provider
 
 import (
+	"context"
 	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
+	"github.com/petsinc/telnyx-rest-client/pkg/telnyxtest"
 )
 
 const (
@@ -19,6 +27,11 @@ provider "telnyx" {
 
 var (
 	includeNumberOrder bool
+	live               bool
+
+	// fakeServer is the fake Telnyx API the tests run against unless live
+	// is set. Steps that need to change server side state use it directly.
+	fakeServer *telnyxtest.Server
 
 	testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 		"telnyx": providerserver.NewProtocol6WithError(New("test")()),
@@ -28,19 +41,42 @@ var (
 func init() {
 	// Define flags
 	flag.BoolVar(&includeNumberOrder, "include-number-order", false, "Include number order test")
+	flag.BoolVar(&live, "live", false, "Run against the Telnyx API configured by TELNYX_API_KEY instead of an in-memory fake")
 }
 
 func TestMain(m *testing.M) {
 	// Parse the flags for testing
 	flag.Parse()
+
+	// A cassette, selected with TELNYX_REST_CLIENT_CASSETTE and loaded by the
+	// provider, either records a live run or replays one, so the fake is not
+	// needed. Replays never reach Telnyx and only need some API key to
+	// satisfy the provider.
+	if os.Getenv("TELNYX_REST_CLIENT_CASSETTE") != "" {
+		if os.Getenv("TELNYX_API_KEY") == "" {
+			os.Setenv("TELNYX_API_KEY", "KEYCASSETTEREPLAY")
+		}
+		live = true
+	}
+
+	// Unless asked to hit a real account, point the provider at a fake API
+	if !live {
+		fakeServer = telnyxtest.NewServer()
+		os.Setenv("TELNYX_BASE_URL", fakeServer.URL)
+		os.Setenv("TELNYX_API_KEY", fakeServer.APIKey)
+		code := m.Run()
+		fakeServer.Close()
+		os.Exit(code)
+	}
+
 	// Run the tests
-	m.Run()
+	os.Exit(m.Run())
 }
 
 func TestAccTelnyxResources(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		Steps: []resource.TestStep{
+		Steps: append([]resource.TestStep{
 			{
 				Config: providerConfig + `
 resource "telnyx_billing_group" "test" {
@@ -102,6 +138,23 @@ resource "telnyx_fqdn" "test" {
   port            = 5060
 }
 
+resource "telnyx_ip_connection" "test" {
+  connection_name = "Test IP Connection Terraform"
+  inbound = {
+    codecs                         = ["G722", "G711U", "G711A", "G729", "OPUS", "H.264"]
+    sip_subdomain_receive_settings = "only_my_connections"
+  }
+  outbound = {
+    outbound_voice_profile_id = telnyx_outbound_voice_profile.test.id
+  }
+}
+
+resource "telnyx_ip" "test" {
+  connection_id = telnyx_ip_connection.test.id
+  ip_address    = "192.0.2.10"
+  port          = 5060
+}
+
 resource "telnyx_texml_application" "test" {
   friendly_name    = "Test TeXML Application Terraform"
   voice_url        = "https://example.com/voice"
@@ -122,7 +175,7 @@ resource "telnyx_phone_number_lookup" "test" {
   limit        = 1
   features     = ["sms", "voice"]
 }
-` + getOptionalNumberOrderConfig(),
+` + getOptionalNumberOrderConfig("terraform-test-number", false) + getOptionalVoiceSettingsConfig("wav") + getOptionalMessagingSettingsConfig("P2P") + getNotificationConfig("Test Notification Profile Terraform", "ops@example.com", "10"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("telnyx_billing_group.test", "name", "Test Billing Group Terraform"),
 					resource.TestCheckResourceAttr("telnyx_outbound_voice_profile.test", "name", "Test Outbound Voice Profile Terraform"),
@@ -132,7 +185,15 @@ resource "telnyx_phone_number_lookup" "test" {
 					resource.TestCheckResourceAttr("telnyx_fqdn.test", "fqdn", "terraform.test.sip.livekit.cloud"),
 					resource.TestCheckResourceAttr("telnyx_fqdn.test", "dns_record_type", "a"),
 					resource.TestCheckResourceAttr("telnyx_fqdn.test", "port", "5060"),
+					resource.TestCheckResourceAttr("telnyx_ip_connection.test", "connection_name", "Test IP Connection Terraform"),
+					resource.TestCheckResourceAttrPair("telnyx_ip.test", "connection_id", "telnyx_ip_connection.test", "id"),
+					resource.TestCheckResourceAttr("telnyx_ip.test", "ip_address", "192.0.2.10"),
+					resource.TestCheckResourceAttr("telnyx_ip.test", "port", "5060"),
 					resource.TestCheckResourceAttr("telnyx_texml_application.test", "friendly_name", "Test TeXML Application Terraform"),
+					checkOptionalPhoneNumber("terraform-test-number", false),
+					checkOptionalVoiceSettings("wav"),
+					checkOptionalMessagingSettings("P2P"),
+					checkNotifications("Test Notification Profile Terraform", "ops@example.com", "10"),
 					resource.TestCheckResourceAttr("telnyx_texml_application.test", "voice_url", "https://example.com/voice"),
 					resource.TestCheckResourceAttr("telnyx_texml_application.test", "voice_fallback_url", ""),
 					resource.TestCheckResourceAttr("telnyx_texml_application.test", "voice_method", "post"),
@@ -209,6 +270,23 @@ resource "telnyx_fqdn" "test" {
   port           = 5060
 }
 
+resource "telnyx_ip_connection" "test" {
+  connection_name = "Updated Test IP Connection Terraform"
+  inbound = {
+    codecs                         = ["G722", "G711U", "G711A", "G729", "OPUS", "H.264"]
+    sip_subdomain_receive_settings = "only_my_connections"
+  }
+  outbound = {
+    outbound_voice_profile_id = telnyx_outbound_voice_profile.test.id
+  }
+}
+
+resource "telnyx_ip" "test" {
+  connection_id = telnyx_ip_connection.test.id
+  ip_address    = "192.0.2.20"
+  port          = 5061
+}
+
 resource "telnyx_texml_application" "test" {
   friendly_name            = "Updated Test TeXML Application Terraform"
   voice_url                = "https://example.com/voice"
@@ -243,7 +321,7 @@ resource "telnyx_phone_number_lookup" "test" {
   limit        = 1
   features     = ["sms", "voice"]
 }
-` + getOptionalNumberOrderConfig(),
+` + getOptionalNumberOrderConfig("terraform-test-number-updated", true) + getOptionalVoiceSettingsConfig("mp3") + getOptionalMessagingSettingsConfig("A2P") + getNotificationConfig("Updated Test Notification Profile Terraform", "alerts@example.com", "5"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("telnyx_billing_group.test", "name", "Updated Billing Group Terraform"),
 					resource.TestCheckResourceAttr("telnyx_outbound_voice_profile.test", "name", "Updated Test Outbound Voice Profile Terraform"),
@@ -253,7 +331,15 @@ resource "telnyx_phone_number_lookup" "test" {
 					resource.TestCheckResourceAttr("telnyx_fqdn.test", "fqdn", "updated.terraform.test.sip.livekit.cloud"),
 					resource.TestCheckResourceAttr("telnyx_fqdn.test", "dns_record_type", "a"),
 					resource.TestCheckResourceAttr("telnyx_fqdn.test", "port", "5060"),
+					resource.TestCheckResourceAttr("telnyx_ip_connection.test", "connection_name", "Updated Test IP Connection Terraform"),
+					resource.TestCheckResourceAttrPair("telnyx_ip.test", "connection_id", "telnyx_ip_connection.test", "id"),
+					resource.TestCheckResourceAttr("telnyx_ip.test", "ip_address", "192.0.2.20"),
+					resource.TestCheckResourceAttr("telnyx_ip.test", "port", "5061"),
 					resource.TestCheckResourceAttr("telnyx_texml_application.test", "friendly_name", "Updated Test TeXML Application Terraform"),
+					checkOptionalPhoneNumber("terraform-test-number-updated", true),
+					checkOptionalVoiceSettings("mp3"),
+					checkOptionalMessagingSettings("A2P"),
+					checkNotifications("Updated Test Notification Profile Terraform", "alerts@example.com", "5"),
 					resource.TestCheckResourceAttr("telnyx_texml_application.test", "voice_url", "https://example.com/voice"),
 					resource.TestCheckResourceAttr("telnyx_texml_application.test", "voice_fallback_url", ""),
 					resource.TestCheckResourceAttr("telnyx_texml_application.test", "voice_method", "post"),
@@ -264,7 +350,75 @@ resource "telnyx_phone_number_lookup" "test" {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
-		},
+			{
+				ResourceName:      "telnyx_outbound_voice_profile.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "telnyx_messaging_profile.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "telnyx_credential_connection.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "telnyx_fqdn_connection.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "telnyx_fqdn.test",
+				ImportState:       true,
+				ImportStateIdFunc: importStateIDFromAttributes("telnyx_fqdn.test", "connection_id", "fqdn"),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "telnyx_ip_connection.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "telnyx_ip.test",
+				ImportState:       true,
+				ImportStateIdFunc: importStateIDFromAttributes("telnyx_ip.test", "connection_id", "ip_address"),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "telnyx_texml_application.test",
+				ImportState:       true,
+				ImportStateId:     "Updated Test TeXML Application Terraform",
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "telnyx_notification_profile.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "telnyx_notification_channel.email",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "telnyx_notification_channel.webhook",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "telnyx_notification_setting.low_balance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "telnyx_notification_setting.fqdn_connection",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		}, getOptionalNumberOrderImportSteps()...),
 	})
 }
 
@@ -374,25 +528,1363 @@ resource "telnyx_call_control_application" "test" {
 					resource.TestCheckResourceAttr(resourceName, "webhook_timeout_secs", "15"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "Updated Call Control App",
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
-func getOptionalNumberOrderConfig() string {
-	if includeNumberOrder {
-		return `
-resource "telnyx_number_order" "this" {
-  connection_id       = telnyx_texml_application.test.id
-  billing_group_id    = telnyx_billing_group.test.id
-  customer_reference  = "terraform-test-api-number"
-  messaging_profile_id = telnyx_messaging_profile.test.id
+func TestAccNumberReservationResource(t *testing.T) {
+	config := func(autoExtend bool, extendBefore string) string {
+		return providerConfig + fmt.Sprintf(`
+data "telnyx_available_phone_numbers" "reservation" {
+  starts_with  = "415"
+  country_code = "US"
+  limit        = 1
+  features     = ["voice"]
+}
+
+# Reserved numbers drop out of the search, so keep the first result
+resource "terraform_data" "reservation" {
+  input = data.telnyx_available_phone_numbers.reservation.phone_numbers[0].phone_number
+
+  lifecycle {
+    ignore_changes = [input]
+  }
+}
+
+resource "telnyx_number_reservation" "test" {
+  customer_reference = "terraform-test-reservation"
+  auto_extend        = %t
+  extend_before      = %q
   phone_numbers = [
     {
-      phone_number = telnyx_phone_number_lookup.test.phone_numbers[0].phone_number
+      phone_number = terraform_data.reservation.output
     }
   ]
 }
+`, autoExtend, extendBefore)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(false, "10m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("telnyx_number_reservation.test", "id"),
+					resource.TestCheckResourceAttr("telnyx_number_reservation.test", "customer_reference", "terraform-test-reservation"),
+					resource.TestCheckResourceAttr("telnyx_number_reservation.test", "phone_numbers.#", "1"),
+					resource.TestCheckResourceAttrPair("telnyx_number_reservation.test", "phone_numbers.0.phone_number", "terraform_data.reservation", "output"),
+					resource.TestCheckResourceAttrSet("telnyx_number_reservation.test", "phone_numbers.0.expired_at"),
+					resource.TestCheckResourceAttrSet("telnyx_number_reservation.test", "expired_at"),
+				),
+			},
+			{
+				// Reservations last 30 minutes, so a longer window extends
+				// the reservation on every refresh
+				Config: config(true, "31m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("telnyx_number_reservation.test", "auto_extend", "true"),
+					resource.TestCheckResourceAttr("telnyx_number_reservation.test", "extend_before", "31m"),
+					resource.TestCheckResourceAttrSet("telnyx_number_reservation.test", "expired_at"),
+				),
+			},
+			{
+				ResourceName:            "telnyx_number_reservation.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auto_extend", "extend_before", "expired_at", "phone_numbers.0.expired_at"},
+			},
+			{
+				// Clear the expiry behind Terraform's back, which only the
+				// fake allows, and fail any extension: a reservation with
+				// no expiry must not be extended on refresh
+				SkipFunc: func() (bool, error) { return live, nil },
+				PreConfig: func() {
+					for _, reservation := range fakeServer.List("number_reservations") {
+						for _, number := range reservation["phone_numbers"].([]interface{}) {
+							delete(number.(map[string]interface{}), "expired_at")
+						}
+						id := fakeServer.Put("number_reservations", reservation)
+						fakeServer.Fail("POST", "/number_reservations/"+id+"/actions/extend", http.StatusInternalServerError, 1)
+					}
+				},
+				Config: config(true, "31m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("telnyx_number_reservation.test", "auto_extend", "true"),
+					resource.TestCheckResourceAttr("telnyx_number_reservation.test", "expired_at", ""),
+					resource.TestCheckResourceAttr("telnyx_number_reservation.test", "phone_numbers.0.expired_at", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAddressResource(t *testing.T) {
+	config := func(streetAddress, postalCode string, ignoreSuggestions bool) string {
+		return providerConfig + fmt.Sprintf(`
+resource "telnyx_address" "test" {
+  first_name          = "Terraform"
+  last_name           = "Test"
+  business_name       = "Petsinc"
+  street_address      = %q
+  locality            = "Chicago"
+  administrative_area = "IL"
+  postal_code         = %q
+  country_code        = "US"
+  customer_reference  = "terraform-test-address"
+  ignore_suggestions  = %t
+}
+`, streetAddress, postalCode, ignoreSuggestions)
+	}
+
+	steps := []resource.TestStep{
+		{
+			Config:      config("W Superior St", "6065", false),
+			ExpectError: regexp.MustCompile("Invalid address"),
+		},
+	}
+	if !live {
+		// The suggestions Telnyx makes are up to its address database, so
+		// only the fake's are predictable
+		steps = append(steps, resource.TestStep{
+			Config:      config("311 W Superior Street", "60654", false),
+			ExpectError: regexp.MustCompile("(?s)suggested corrections.*street_address"),
+		})
+	}
+	steps = append(steps,
+		resource.TestStep{
+			Config: config("311 W Superior St", "60654", true),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrSet("telnyx_address.test", "id"),
+				resource.TestCheckResourceAttr("telnyx_address.test", "street_address", "311 W Superior St"),
+				resource.TestCheckResourceAttr("telnyx_address.test", "postal_code", "60654"),
+				resource.TestCheckResourceAttr("telnyx_address.test", "customer_reference", "terraform-test-address"),
+				resource.TestCheckResourceAttr("telnyx_address.test", "validate_address", "true"),
+				resource.TestCheckResourceAttr("telnyx_address.test", "address_book", "true"),
+				resource.TestCheckResourceAttr("telnyx_address.test", "extended_address", ""),
+			),
+		},
+		resource.TestStep{
+			ResourceName:            "telnyx_address.test",
+			ImportState:             true,
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{"ignore_suggestions"},
+		},
+	)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps:                    steps,
+	})
+}
+
+func TestAccTelephonyCredentialResource(t *testing.T) {
+	config := func(name, tag string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "telnyx_billing_group" "telephony" {
+  name = "Test Telephony Credential Billing Group Terraform"
+}
+
+resource "telnyx_outbound_voice_profile" "telephony" {
+  name             = "Test Telephony Credential Outbound Voice Profile Terraform"
+  billing_group_id = telnyx_billing_group.telephony.id
+}
+
+resource "telnyx_credential_connection" "telephony" {
+  connection_name            = "Test Telephony Credential Connection Terraform"
+  username                   = "terraformtelephonycredentials"
+  password                   = "terraformtelephonycredentials"
+  webhook_event_url          = ""
+  webhook_event_failover_url = ""
+  webhook_api_version        = "2"
+  inbound = {
+    codecs                         = ["G722", "G711U", "G711A", "G729", "OPUS", "H.264"]
+    sip_subdomain_receive_settings = "from_anyone"
+  }
+  outbound = {
+    ani_override              = "+12345678901"
+    ani_override_type         = "always"
+    outbound_voice_profile_id = telnyx_outbound_voice_profile.telephony.id
+  }
+}
+
+resource "telnyx_telephony_credential" "test" {
+  connection_id = telnyx_credential_connection.telephony.id
+  name          = %q
+  tag           = %q
+  expires_at    = "2035-01-01T00:00:00Z"
+}
+`, name, tag)
+	}
+
+	var sipUsername string
+	rememberSIPUsername := func(s *terraform.State) error {
+		sipUsername = s.RootModule().Resources["telnyx_telephony_credential.test"].Primary.Attributes["sip_username"]
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("Test Telephony Credential Terraform", "terraform-test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("telnyx_telephony_credential.test", "connection_id", "telnyx_credential_connection.telephony", "id"),
+					resource.TestCheckResourceAttr("telnyx_telephony_credential.test", "name", "Test Telephony Credential Terraform"),
+					resource.TestCheckResourceAttr("telnyx_telephony_credential.test", "expires_at", "2035-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("telnyx_telephony_credential.test", "expired", "false"),
+					resource.TestCheckResourceAttrSet("telnyx_telephony_credential.test", "sip_username"),
+					resource.TestCheckResourceAttrSet("telnyx_telephony_credential.test", "sip_password"),
+					rememberSIPUsername,
+				),
+			},
+			{
+				// Renaming keeps the generated SIP credentials
+				Config: config("Updated Test Telephony Credential Terraform", "terraform-test-updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("telnyx_telephony_credential.test", "name", "Updated Test Telephony Credential Terraform"),
+					resource.TestCheckResourceAttr("telnyx_telephony_credential.test", "tag", "terraform-test-updated"),
+					func(s *terraform.State) error {
+						return resource.TestCheckResourceAttr("telnyx_telephony_credential.test", "sip_username", sipUsername)(s)
+					},
+				),
+			},
+			{
+				// Expire the credential behind Terraform's back, which only
+				// the fake allows, and expect a fresh one
+				SkipFunc: func() (bool, error) { return live, nil },
+				PreConfig: func() {
+					for _, credential := range fakeServer.List("telephony_credentials") {
+						credential["expired"] = true
+						fakeServer.Put("telephony_credentials", credential)
+					}
+				},
+				Config: config("Updated Test Telephony Credential Terraform", "terraform-test-updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("telnyx_telephony_credential.test", "expired", "false"),
+					func(s *terraform.State) error {
+						replaced := s.RootModule().Resources["telnyx_telephony_credential.test"].Primary.Attributes["sip_username"]
+						if replaced == sipUsername {
+							return fmt.Errorf("expected the expired credential %s to be replaced", sipUsername)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "telnyx_telephony_credential.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNotificationChannelResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "telnyx_notification_profile" "test" {
+  name = "Test Notification Channel Profile Terraform"
+}
+
+resource "telnyx_notification_channel" "sms" {
+  notification_profile_id = telnyx_notification_profile.test.id
+  channel_type_id         = "sms"
+  channel_destination     = "312-555-0100"
+}
+`,
+				ExpectError: regexp.MustCompile(`sms channels need a phone number in E.164 format`),
+			},
+		},
+	})
+}
+
+func TestAccPortingOrderResource(t *testing.T) {
+	if live {
+		// Submitting a port moves real numbers away from their carrier
+		t.Skip("porting orders only run against the fake API")
+	}
+
+	config := func(submit bool, wait string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "telnyx_billing_group" "porting" {
+  name = "Test Porting Order Billing Group Terraform"
+}
+
+resource "telnyx_porting_order" "test" {
+  phone_numbers      = ["+13125550142", "+13125550143"]
+  customer_reference = "terraform-test-porting"
+  end_user = {
+    admin = {
+      entity_name          = "Petsinc"
+      auth_person_name     = "Terraform Test"
+      billing_phone_number = "+13125550142"
+      account_number       = "123456789"
+      pin_passcode         = "1234"
+    }
+    location = {
+      street_address      = "311 W Superior St"
+      locality            = "Chicago"
+      administrative_area = "IL"
+      postal_code         = "60654"
+      country_code        = "US"
+    }
+  }
+  loa_document_id        = "6a09cdc3-8948-47f0-aa62-74ac943d6c58"
+  invoice_document_id    = "3b5f1f6e-7c37-4d3a-a1b2-6f0e9c1d2a3b"
+  foc_datetime_requested = "2030-01-15T16:00:00Z"
+  billing_group_id       = telnyx_billing_group.porting.id
+  submit                 = %t
+  %s
+}
+`, submit, wait)
+	}
+	wait := `
+  wait_for_status = "foc-date-confirmed"
+  wait_timeout    = "1m"
+  poll_interval   = "10ms"
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			for _, order := range fakeServer.List("porting_orders") {
+				if status := order["status"].(map[string]interface{})["value"]; status != "cancel-pending" && status != "cancelled" {
+					return fmt.Errorf("expected porting order %s to be cancelled, it is %s", order["id"], status)
+				}
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      config(false, wait),
+				ExpectError: regexp.MustCompile(`wait_for_status needs submit = true`),
+			},
+			{
+				Config: config(false, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("telnyx_porting_order.test", "status", "draft"),
+					resource.TestCheckResourceAttr("telnyx_porting_order.test", "submit", "false"),
+					resource.TestCheckResourceAttr("telnyx_porting_order.test", "fast_port_eligible", "true"),
+					resource.TestCheckResourceAttr("telnyx_porting_order.test", "end_user.location.locality", "Chicago"),
+					resource.TestCheckNoResourceAttr("telnyx_porting_order.test", "foc_datetime_actual"),
+					resource.TestCheckResourceAttr("telnyx_porting_order.test", "porting_phone_numbers.#", "2"),
+					resource.TestCheckResourceAttr("telnyx_porting_order.test", "porting_phone_numbers.0.phone_number", "+13125550142"),
+					resource.TestCheckResourceAttr("telnyx_porting_order.test", "porting_phone_numbers.0.activation_status", "New"),
+					resource.TestCheckResourceAttrSet("telnyx_porting_order.test", "support_key"),
+				),
+			},
+			{
+				// Submitting waits until the losing carrier confirms the FOC date
+				Config: config(true, wait),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("telnyx_porting_order.test", "status", "foc-date-confirmed"),
+					resource.TestCheckResourceAttr("telnyx_porting_order.test", "submit", "true"),
+					resource.TestCheckResourceAttr("telnyx_porting_order.test", "foc_datetime_actual", "2030-01-15T16:00:00Z"),
+					resource.TestCheckResourceAttr("telnyx_porting_order.test", "porting_phone_numbers.1.activation_status", "Activate RDY"),
+					resource.TestCheckResourceAttr("telnyx_porting_order.test", "porting_phone_numbers.1.requirements_status", "approved"),
+				),
+			},
+			{
+				ResourceName:            "telnyx_porting_order.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_status", "wait_timeout", "poll_interval"},
+			},
+		},
+	})
+}
+
+func TestAccPhoneNumberEmergencyFailures(t *testing.T) {
+	if live {
+		t.Skip("failing the phone number update needs the fake Telnyx API")
+	}
+	phoneNumberID := fakeServer.Put("phone_numbers", map[string]interface{}{
+		"phone_number":         "+13125550150",
+		"status":               "active",
+		"tags":                 []interface{}{},
+		"emergency_enabled":    false,
+		"emergency_address_id": "",
+		"number_level_routing": "disabled",
+		"phone_number_type":    "local",
+	})
+	address := `
+resource "telnyx_address" "e911" {
+  first_name          = "Terraform"
+  last_name           = "Test"
+  business_name       = "Petsinc"
+  street_address      = "311 W Superior St"
+  locality            = "Chicago"
+  administrative_area = "IL"
+  postal_code         = "60654"
+  country_code        = "US"
+}
 `
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// A failed emergency change must stop the number from being
+				// routed, so it never takes calls without E911
+				PreConfig: func() {
+					fakeServer.Fail("POST", "/phone_numbers/"+phoneNumberID+"/actions/enable_emergency", http.StatusUnprocessableEntity, 1)
+				},
+				Config: providerConfig + address + `
+resource "telnyx_texml_application" "emergency" {
+  friendly_name      = "Test Emergency TeXML Application Terraform"
+  voice_url          = "https://example.com/voice"
+  voice_fallback_url = ""
+}
+
+resource "telnyx_phone_number" "test" {
+  phone_number              = "+13125550150"
+  connection_id             = telnyx_texml_application.emergency.id
+  emergency_address_id      = telnyx_address.e911.id
+  require_emergency_address = true
+}
+`,
+				ExpectError: regexp.MustCompile("Error changing emergency calling"),
+			},
+			{
+				Config: providerConfig + address,
+				Check: func(*terraform.State) error {
+					phoneNumber, _ := fakeServer.Get("phone_numbers", phoneNumberID)
+					if connectionID, _ := phoneNumber["connection_id"].(string); connectionID != "" {
+						return fmt.Errorf("expected %s to have no connection after emergency calling failed, got %s", phoneNumberID, connectionID)
+					}
+					return nil
+				},
+			},
+			{
+				// A failed update must not leave emergency calling enabled
+				// once Terraform no longer manages the number
+				PreConfig: func() {
+					fakeServer.Fail("PATCH", "/phone_numbers/"+phoneNumberID, http.StatusUnprocessableEntity, 1)
+				},
+				Config: providerConfig + address + `
+resource "telnyx_phone_number" "test" {
+  phone_number         = "+13125550150"
+  emergency_address_id = telnyx_address.e911.id
+}
+`,
+				ExpectError: regexp.MustCompile("Error updating phone number"),
+			},
+			{
+				Config: providerConfig + address,
+				Check: func(*terraform.State) error {
+					phoneNumber, _ := fakeServer.Get("phone_numbers", phoneNumberID)
+					if phoneNumber["emergency_enabled"] == true {
+						return fmt.Errorf("expected emergency calling to stay disabled on %s after the failed update", phoneNumberID)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccAPIErrorOnAttribute(t *testing.T) {
+	if live {
+		t.Skip("the error's source.pointer is only guaranteed by the fake Telnyx API")
 	}
-	return ""
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The 422's source.pointer, /billing_group_id, puts the
+				// error on that line of the configuration
+				Config: providerConfig + `
+resource "telnyx_outbound_voice_profile" "missing_group" {
+  name             = "Test Missing Billing Group Terraform"
+  billing_group_id = "1293384261075731499"
+}
+`,
+				ExpectError: regexp.MustCompile(`(?s)Error: Error creating outbound voice profile\s+with telnyx_outbound_voice_profile.missing_group,\s+on \S+ line \d+, in resource "telnyx_outbound_voice_profile" "missing_group":\s+\d+:\s+billing_group_id = "1293384261075731499"\s+10015 Invalid value: The billing_group_id "1293384261075731499" does not\s+exist.\s+\(/billing_group_id\)`),
+			},
+		},
+	})
+}
+
+func TestAccNumberOrderRegulatoryRequirements(t *testing.T) {
+	if live {
+		// UK numbers need a real proof of address
+		t.Skip("regulatory requirements only run against the fake API")
+	}
+
+	ctx := context.Background()
+	client, err := fakeServer.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof, err := client.UploadDocument(ctx, "utility_bill.pdf", []byte("%PDF-1.4 terraform test"), "terraform-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	group, err := client.CreateRequirementGroup(ctx, telnyx.CreateRequirementGroupRequest{
+		CountryCode:     "GB",
+		PhoneNumberType: "local",
+		Action:          "ordering",
+		RegulatoryRequirements: []telnyx.RequirementGroupRequirement{
+			{RequirementID: telnyxtest.RequirementGBAddress, FieldValue: "10 Downing Street, London, SW1A 2AA"},
+			{RequirementID: telnyxtest.RequirementGBProofOfAddress, FieldValue: proof.ID},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := func(values string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "telnyx_billing_group" "regulatory" {
+  name = "Test Regulatory Billing Group Terraform"
+}
+
+resource "telnyx_outbound_voice_profile" "regulatory" {
+  name             = "Test Regulatory Outbound Voice Profile Terraform"
+  billing_group_id = telnyx_billing_group.regulatory.id
+}
+
+resource "telnyx_texml_application" "regulatory" {
+  friendly_name      = "Test Regulatory TeXML Application Terraform"
+  voice_url          = "https://example.com/voice"
+  voice_fallback_url = ""
+  voice_method       = "post"
+  inbound = {
+    codecs                         = ["G722", "G711U", "G711A", "G729", "OPUS", "H.264"]
+    sip_subdomain_receive_settings = "from_anyone"
+  }
+  outbound = {
+    outbound_voice_profile_id = telnyx_outbound_voice_profile.regulatory.id
+  }
+}
+
+resource "telnyx_number_order" "values" {
+  connection_id    = telnyx_texml_application.regulatory.id
+  billing_group_id = telnyx_billing_group.regulatory.id
+  phone_numbers = [
+    {
+      phone_number = "+442071838750"
+    }
+  ]
+  requirement_values = {
+    %s
+  }
+}
+
+resource "telnyx_number_order" "group" {
+  connection_id    = telnyx_texml_application.regulatory.id
+  billing_group_id = telnyx_billing_group.regulatory.id
+  phone_numbers = [
+    {
+      phone_number         = "+442071838751"
+      requirement_group_id = %q
+    }
+  ]
+}
+`, values, group.ID)
+	}
+	address := fmt.Sprintf("%q = \"10 Downing Street, London, SW1A 2AA\"", telnyxtest.RequirementGBAddress)
+	proofOfAddress := fmt.Sprintf("%q = %q", telnyxtest.RequirementGBProofOfAddress, proof.ID)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(`"d6b7bd39-0000-4000-8000-000000000000" = "unused"`),
+				ExpectError: regexp.MustCompile(`None of the ordered numbers has the regulatory requirement`),
+			},
+			{
+				// Without the proof of address the number is held back
+				Config: config(address),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("telnyx_number_order.values", "status", "pending"),
+					resource.TestCheckResourceAttr("telnyx_number_order.values", "phone_numbers.0.status", "pending"),
+					resource.TestCheckResourceAttr("telnyx_number_order.values", "phone_numbers.0.regulatory_requirements.#", "1"),
+					resource.TestCheckResourceAttr("telnyx_number_order.values", "phone_numbers.0.regulatory_requirements.0.field_type", "textual"),
+					resource.TestCheckResourceAttr("telnyx_number_order.group", "status", "success"),
+					resource.TestCheckResourceAttr("telnyx_number_order.group", "phone_numbers.0.requirement_group_id", group.ID),
+					func(s *terraform.State) error {
+						for _, number := range fakeServer.List("phone_numbers") {
+							if number["phone_number"] == "+442071838750" {
+								return fmt.Errorf("expected +442071838750 to be held until its requirements are met")
+							}
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: config(address + "\n    " + proofOfAddress),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("telnyx_number_order.values", "status", "success"),
+					resource.TestCheckResourceAttr("telnyx_number_order.values", "phone_numbers.0.status", "success"),
+					resource.TestCheckResourceAttr("telnyx_number_order.values", "phone_numbers.0.regulatory_requirements.#", "2"),
+					resource.TestCheckResourceAttr("telnyx_number_order.values", "phone_numbers.0.regulatory_requirements.1.field_value", proof.ID),
+				),
+			},
+		},
+	})
+}
+
+// numberOrderIncluded reports whether the tests buy a phone number. Orders
+// against the fake API cost nothing, so they always run offline.
+func numberOrderIncluded() bool {
+	return includeNumberOrder || !live
+}
+
+func getOptionalNumberOrderConfig(customerReference string, hdVoiceEnabled bool) string {
+	if numberOrderIncluded() {
+		return fmt.Sprintf(`
+resource "telnyx_number_order" "this" {
+  connection_id       = telnyx_texml_application.test.id
+  billing_group_id    = telnyx_billing_group.test.id
+  customer_reference  = "terraform-test-api-number"
+  messaging_profile_id = telnyx_messaging_profile.test.id
+  phone_numbers = [
+    {
+      phone_number = telnyx_phone_number_lookup.test.phone_numbers[0].phone_number
+    }
+  ]
+}
+
+resource "telnyx_address" "e911" {
+  first_name          = "Terraform"
+  last_name           = "Test"
+  business_name       = "Petsinc"
+  street_address      = "311 W Superior St"
+  locality            = "Chicago"
+  administrative_area = "IL"
+  postal_code         = "60654"
+  country_code        = "US"
+}
+
+resource "telnyx_phone_number" "this" {
+  phone_number              = telnyx_number_order.this.phone_numbers[0].phone_number
+  connection_id             = telnyx_fqdn_connection.test.id
+  billing_group_id          = telnyx_billing_group.test.id
+  customer_reference        = %q
+  tags                      = ["terraform", %q]
+  hd_voice_enabled          = %t
+  emergency_address_id      = telnyx_address.e911.id
+  require_emergency_address = true
+}
+`, customerReference, customerReference, hdVoiceEnabled)
+	}
+	return ""
+}
+
+// getOptionalNumberOrderImportSteps imports the ordered number's resources,
+// looking the number up by E.164 rather than by ID.
+func getOptionalNumberOrderImportSteps() []resource.TestStep {
+	if !numberOrderIncluded() {
+		return nil
+	}
+	return []resource.TestStep{
+		{
+			ResourceName:      "telnyx_number_order.this",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+		{
+			ResourceName:            "telnyx_phone_number.this",
+			ImportState:             true,
+			ImportStateIdFunc:       importStateIDFromAttributes("telnyx_phone_number.this", "phone_number"),
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{"require_emergency_address", "release_on_destroy"},
+		},
+		{
+			ResourceName:            "telnyx_address.e911",
+			ImportState:             true,
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{"ignore_suggestions"},
+		},
+		{
+			ResourceName:      "telnyx_phone_number_voice_settings.this",
+			ImportState:       true,
+			ImportStateIdFunc: importStateIDFromAttributes("telnyx_phone_number.this", "phone_number"),
+			ImportStateVerify: true,
+		},
+		{
+			ResourceName:      "telnyx_phone_number_messaging_settings.this",
+			ImportState:       true,
+			ImportStateIdFunc: importStateIDFromAttributes("telnyx_phone_number.this", "phone_number"),
+			ImportStateVerify: true,
+		},
+	}
+}
+
+// importStateIDFromAttributes builds an import ID by joining attributes of
+// a resource in state with slashes.
+func importStateIDFromAttributes(resourceName string, attributes ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", resourceName)
+		}
+		values := make([]string, len(attributes))
+		for i, attribute := range attributes {
+			values[i] = rs.Primary.Attributes[attribute]
+		}
+		return strings.Join(values, "/"), nil
+	}
+}
+
+func checkOptionalPhoneNumber(customerReference string, hdVoiceEnabled bool) resource.TestCheckFunc {
+	if !numberOrderIncluded() {
+		return func(*terraform.State) error { return nil }
+	}
+	return resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttrPair("telnyx_phone_number.this", "phone_number", "telnyx_number_order.this", "phone_numbers.0.phone_number"),
+		resource.TestCheckResourceAttrPair("telnyx_phone_number.this", "connection_id", "telnyx_fqdn_connection.test", "id"),
+		resource.TestCheckResourceAttrPair("telnyx_phone_number.this", "billing_group_id", "telnyx_billing_group.test", "id"),
+		resource.TestCheckResourceAttr("telnyx_phone_number.this", "customer_reference", customerReference),
+		resource.TestCheckResourceAttr("telnyx_phone_number.this", "tags.#", "2"),
+		resource.TestCheckTypeSetElemAttr("telnyx_phone_number.this", "tags.*", customerReference),
+		resource.TestCheckResourceAttr("telnyx_phone_number.this", "hd_voice_enabled", fmt.Sprintf("%t", hdVoiceEnabled)),
+		resource.TestCheckResourceAttr("telnyx_phone_number.this", "release_on_destroy", "false"),
+		resource.TestCheckResourceAttrPair("telnyx_phone_number.this", "emergency_address_id", "telnyx_address.e911", "id"),
+		resource.TestCheckResourceAttr("telnyx_phone_number.this", "emergency_enabled", "true"),
+		resource.TestCheckResourceAttrSet("telnyx_phone_number.this", "id"),
+	)
+}
+
+func getOptionalVoiceSettingsConfig(recordingFormat string) string {
+	if numberOrderIncluded() {
+		return fmt.Sprintf(`
+resource "telnyx_phone_number_voice_settings" "this" {
+  phone_number_id = telnyx_phone_number.this.id
+
+  call_forwarding = {
+    call_forwarding_enabled = true
+    forwards_to             = "+13125550199"
+    forwarding_type         = "on_failure"
+  }
+
+  cnam_listing = {
+    cnam_listing_enabled = true
+    cnam_listing_details = "PETSINC"
+  }
+
+  call_recording = {
+    inbound_call_recording_enabled = true
+    inbound_call_recording_format  = %q
+  }
+}
+`, recordingFormat)
+	}
+	return ""
+}
+
+func checkOptionalVoiceSettings(recordingFormat string) resource.TestCheckFunc {
+	if !numberOrderIncluded() {
+		return func(*terraform.State) error { return nil }
+	}
+	return resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttrPair("telnyx_phone_number_voice_settings.this", "id", "telnyx_phone_number.this", "id"),
+		resource.TestCheckResourceAttr("telnyx_phone_number_voice_settings.this", "call_forwarding.forwards_to", "+13125550199"),
+		resource.TestCheckResourceAttr("telnyx_phone_number_voice_settings.this", "call_forwarding.forwarding_type", "on_failure"),
+		resource.TestCheckResourceAttr("telnyx_phone_number_voice_settings.this", "cnam_listing.cnam_listing_details", "PETSINC"),
+		resource.TestCheckResourceAttr("telnyx_phone_number_voice_settings.this", "call_recording.inbound_call_recording_enabled", "true"),
+		resource.TestCheckResourceAttr("telnyx_phone_number_voice_settings.this", "call_recording.inbound_call_recording_format", recordingFormat),
+		// Left out of the configuration, so the current value is kept
+		resource.TestCheckResourceAttr("telnyx_phone_number_voice_settings.this", "call_recording.inbound_call_recording_channels", "single"),
+		resource.TestCheckResourceAttr("telnyx_phone_number_voice_settings.this", "usage_payment_method", "pay-per-minute"),
+	)
+}
+
+func getOptionalMessagingSettingsConfig(messagingProduct string) string {
+	if numberOrderIncluded() {
+		return fmt.Sprintf(`
+resource "telnyx_phone_number_messaging_settings" "this" {
+  phone_number_id      = telnyx_phone_number.this.id
+  messaging_profile_id = telnyx_messaging_profile.test.id
+  messaging_product    = %q
+}
+`, messagingProduct)
+	}
+	return ""
+}
+
+func checkOptionalMessagingSettings(messagingProduct string) resource.TestCheckFunc {
+	if !numberOrderIncluded() {
+		return func(*terraform.State) error { return nil }
+	}
+	return resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttrPair("telnyx_phone_number_messaging_settings.this", "id", "telnyx_phone_number.this", "id"),
+		resource.TestCheckResourceAttrPair("telnyx_phone_number_messaging_settings.this", "messaging_profile_id", "telnyx_messaging_profile.test", "id"),
+		resource.TestCheckResourceAttrPair("telnyx_phone_number_messaging_settings.this", "phone_number", "telnyx_phone_number.this", "phone_number"),
+		resource.TestCheckResourceAttr("telnyx_phone_number_messaging_settings.this", "messaging_product", messagingProduct),
+		resource.TestCheckResourceAttr("telnyx_phone_number_messaging_settings.this", "type", "long-code"),
+		resource.TestCheckResourceAttrSet("telnyx_phone_number_messaging_settings.this", "eligible_messaging_products.#"),
+	)
+}
+
+// getNotificationConfig alerts ops by email and webhook. The settings need
+// event condition IDs, which differ between accounts, so they are only
+// created against the fake.
+func getNotificationConfig(profileName, email, lowBalanceAmount string) string {
+	config := fmt.Sprintf(`
+resource "telnyx_notification_profile" "test" {
+  name = %q
+}
+
+resource "telnyx_notification_channel" "email" {
+  notification_profile_id = telnyx_notification_profile.test.id
+  channel_type_id         = "email"
+  channel_destination     = %q
+}
+
+resource "telnyx_notification_channel" "webhook" {
+  notification_profile_id = telnyx_notification_profile.test.id
+  channel_type_id         = "webhook"
+  channel_destination     = "https://example.com/telnyx/alerts"
+}
+`, profileName, email)
+	if live {
+		return config
+	}
+	return config + fmt.Sprintf(`
+resource "telnyx_notification_setting" "low_balance" {
+  notification_event_condition_id = %q
+  notification_profile_id         = telnyx_notification_profile.test.id
+  notification_channel_id         = telnyx_notification_channel.email.id
+  parameters = {
+    amount = %q
+  }
+}
+
+resource "telnyx_notification_setting" "fqdn_connection" {
+  notification_event_condition_id = %q
+  notification_profile_id         = telnyx_notification_profile.test.id
+  notification_channel_id         = telnyx_notification_channel.webhook.id
+  associated_record_type          = "connection"
+  associated_record_type_value    = telnyx_fqdn_connection.test.id
+}
+`, notificationEventConditionID("Low balance"), lowBalanceAmount, notificationEventConditionID("Connection registration lost"))
+}
+
+func checkNotifications(profileName, email, lowBalanceAmount string) resource.TestCheckFunc {
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr("telnyx_notification_profile.test", "name", profileName),
+		resource.TestCheckResourceAttrPair("telnyx_notification_channel.email", "notification_profile_id", "telnyx_notification_profile.test", "id"),
+		resource.TestCheckResourceAttr("telnyx_notification_channel.email", "channel_destination", email),
+		resource.TestCheckResourceAttr("telnyx_notification_channel.webhook", "channel_type_id", "webhook"),
+	}
+	if !live {
+		checks = append(checks,
+			resource.TestCheckResourceAttr("telnyx_notification_setting.low_balance", "associated_record_type", "account"),
+			resource.TestCheckResourceAttr("telnyx_notification_setting.low_balance", "parameters.amount", lowBalanceAmount),
+			resource.TestCheckResourceAttr("telnyx_notification_setting.low_balance", "status", "enabled"),
+			resource.TestCheckResourceAttrPair("telnyx_notification_setting.fqdn_connection", "associated_record_type_value", "telnyx_fqdn_connection.test", "id"),
+			resource.TestCheckResourceAttrPair("telnyx_notification_setting.fqdn_connection", "notification_channel_id", "telnyx_notification_channel.webhook", "id"),
+		)
+	}
+	return resource.ComposeAggregateTestCheckFunc(checks...)
+}
+
+// notificationEventConditionID looks up one of the fake's event conditions
+// by name.
+func notificationEventConditionID(name string) string {
+	for _, condition := range fakeServer.List("notification_event_conditions") {
+		if condition["name"] == name {
+			return condition["id"].(string)
+		}
+	}
+	panic(fmt.Sprintf("no notification event condition named %q", name))
+}
+
+func TestAccLookupDataSources(t *testing.T) {
+	resources := providerConfig + `
+resource "telnyx_billing_group" "lookup" {
+  name = "Test Lookup Billing Group Terraform"
+}
+
+resource "telnyx_outbound_voice_profile" "lookup" {
+  name             = "Test Lookup Outbound Voice Profile Terraform"
+  billing_group_id = telnyx_billing_group.lookup.id
+  tags             = ["test-lookup"]
+}
+
+resource "telnyx_messaging_profile" "lookup" {
+  name                = "Test Lookup Messaging Profile Terraform"
+  enabled             = true
+  webhook_url         = ""
+  webhook_api_version = "2"
+}
+
+resource "telnyx_credential_connection" "lookup" {
+  connection_name            = "Test Lookup Credential Connection Terraform"
+  username                   = "terraformlookupcredentials"
+  password                   = "terraformlookupcredentials"
+  webhook_event_url          = ""
+  webhook_event_failover_url = ""
+  webhook_api_version        = "2"
+  inbound = {
+    codecs                         = ["G722", "G711U"]
+    sip_subdomain_receive_settings = "from_anyone"
+  }
+  outbound = {
+    outbound_voice_profile_id = telnyx_outbound_voice_profile.lookup.id
+  }
+}
+
+resource "telnyx_fqdn_connection" "lookup" {
+  connection_name = "Test Lookup FQDN Connection Terraform"
+  username        = "terraformlookupfqdn"
+  password        = "terraformlookupfqdn"
+  inbound = {
+    sip_subdomain                  = "terraform.test.lookup.uniqueexample.sip.telnyx.com"
+    codecs                         = ["G722", "G711U"]
+    sip_subdomain_receive_settings = "from_anyone"
+  }
+  outbound = {
+    outbound_voice_profile_id = telnyx_outbound_voice_profile.lookup.id
+  }
+}
+
+resource "telnyx_texml_application" "lookup" {
+  friendly_name      = "Test Lookup TeXML Application Terraform"
+  voice_url          = "https://example.com/voice"
+  voice_fallback_url = ""
+  voice_method       = "post"
+  inbound = {
+    codecs                         = ["G722", "G711U", "G711A", "G729", "OPUS", "H.264"]
+    sip_subdomain_receive_settings = "from_anyone"
+  }
+  outbound = {
+    outbound_voice_profile_id = telnyx_outbound_voice_profile.lookup.id
+  }
+}
+
+resource "telnyx_call_control_application" "lookup" {
+  application_name = "Test Lookup Call Control App Terraform"
+  active           = true
+  inbound = {
+    channel_limit                  = 5
+    shaken_stir_enabled            = true
+    sip_subdomain                  = "terraform.test.lookup.callcontrol.sip.telnyx.com"
+    sip_subdomain_receive_settings = "from_anyone"
+  }
+  outbound = {
+    channel_limit             = 10
+    outbound_voice_profile_id = telnyx_outbound_voice_profile.lookup.id
+  }
+  webhook_api_version        = "2"
+  webhook_event_url          = "https://example.com/webhook"
+  webhook_event_failover_url = "https://example.com/webhook-failover"
+  webhook_timeout_secs       = 30
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resources + `
+data "telnyx_billing_group" "by_name" {
+  name = telnyx_billing_group.lookup.name
+}
+
+data "telnyx_outbound_voice_profile" "by_id" {
+  id = telnyx_outbound_voice_profile.lookup.id
+}
+
+data "telnyx_outbound_voice_profile" "by_name" {
+  name = telnyx_outbound_voice_profile.lookup.name
+}
+
+data "telnyx_messaging_profile" "by_name" {
+  name = telnyx_messaging_profile.lookup.name
+}
+
+data "telnyx_credential_connection" "by_name" {
+  connection_name = telnyx_credential_connection.lookup.connection_name
+}
+
+data "telnyx_fqdn_connection" "by_name" {
+  connection_name = telnyx_fqdn_connection.lookup.connection_name
+}
+
+data "telnyx_texml_application" "by_name" {
+  friendly_name = telnyx_texml_application.lookup.friendly_name
+}
+
+data "telnyx_call_control_application" "by_id" {
+  id = telnyx_call_control_application.lookup.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.telnyx_billing_group.by_name", "id", "telnyx_billing_group.lookup", "id"),
+					resource.TestCheckResourceAttrPair("data.telnyx_outbound_voice_profile.by_id", "name", "telnyx_outbound_voice_profile.lookup", "name"),
+					resource.TestCheckResourceAttrPair("data.telnyx_outbound_voice_profile.by_id", "billing_group_id", "telnyx_billing_group.lookup", "id"),
+					resource.TestCheckResourceAttr("data.telnyx_outbound_voice_profile.by_id", "tags.0", "test-lookup"),
+					resource.TestCheckResourceAttrPair("data.telnyx_outbound_voice_profile.by_name", "id", "telnyx_outbound_voice_profile.lookup", "id"),
+					resource.TestCheckResourceAttrPair("data.telnyx_messaging_profile.by_name", "id", "telnyx_messaging_profile.lookup", "id"),
+					resource.TestCheckResourceAttr("data.telnyx_messaging_profile.by_name", "enabled", "true"),
+					resource.TestCheckResourceAttrPair("data.telnyx_credential_connection.by_name", "id", "telnyx_credential_connection.lookup", "id"),
+					resource.TestCheckResourceAttr("data.telnyx_credential_connection.by_name", "username", "terraformlookupcredentials"),
+					resource.TestCheckResourceAttrPair("data.telnyx_fqdn_connection.by_name", "id", "telnyx_fqdn_connection.lookup", "id"),
+					resource.TestCheckResourceAttr("data.telnyx_fqdn_connection.by_name", "inbound.sip_subdomain", "terraform.test.lookup.uniqueexample.sip.telnyx.com"),
+					resource.TestCheckResourceAttrPair("data.telnyx_texml_application.by_name", "id", "telnyx_texml_application.lookup", "id"),
+					resource.TestCheckResourceAttr("data.telnyx_texml_application.by_name", "voice_url", "https://example.com/voice"),
+					resource.TestCheckResourceAttrPair("data.telnyx_call_control_application.by_id", "application_name", "telnyx_call_control_application.lookup", "application_name"),
+					resource.TestCheckResourceAttrPair("data.telnyx_call_control_application.by_id", "outbound.outbound_voice_profile_id", "telnyx_outbound_voice_profile.lookup", "id"),
+				),
+			},
+			{
+				Config: resources + `
+data "telnyx_billing_group" "neither" {
+}
+`,
+				ExpectError: regexp.MustCompile(`Set either id or name`),
+			},
+			{
+				Config: resources + `
+data "telnyx_messaging_profile" "missing" {
+  name = "Test Lookup Messaging Profile Terraform That Does Not Exist"
+}
+`,
+				ExpectError: regexp.MustCompile(`There is no messaging profile named`),
+			},
+			{
+				// Names are not unique in Telnyx, so a duplicate makes the
+				// lookup ambiguous
+				Config: resources + `
+resource "telnyx_billing_group" "duplicate" {
+  name = "Test Lookup Billing Group Terraform"
+}
+
+data "telnyx_billing_group" "ambiguous" {
+  name       = "Test Lookup Billing Group Terraform"
+  depends_on = [telnyx_billing_group.lookup, telnyx_billing_group.duplicate]
+}
+`,
+				ExpectError: regexp.MustCompile(`2 billing groups are named "Test Lookup Billing Group\s+Terraform"`),
+			},
+		},
+	})
+}
+
+func TestAccAvailablePhoneNumbersDataSource(t *testing.T) {
+	var lookedUp string
+	rememberLookup := func(s *terraform.State) error {
+		lookedUp = s.RootModule().Resources["telnyx_phone_number_lookup.migrate"].Primary.Attributes["phone_numbers.0.phone_number"]
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "telnyx_available_phone_numbers" "test" {
+  starts_with  = "312"
+  country_code = "US"
+  limit        = 2
+  features     = ["sms", "voice"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.telnyx_available_phone_numbers.test", "phone_numbers.#", "2"),
+					resource.TestMatchResourceAttr("data.telnyx_available_phone_numbers.test", "phone_numbers.0.phone_number", regexp.MustCompile(`^\+1312\d{7}$`)),
+					resource.TestCheckResourceAttrSet("data.telnyx_available_phone_numbers.test", "phone_numbers.0.monthly_cost"),
+					resource.TestCheckResourceAttrSet("data.telnyx_available_phone_numbers.test", "phone_numbers.0.currency"),
+					resource.TestCheckResourceAttrSet("data.telnyx_available_phone_numbers.test", "phone_numbers.0.quickship"),
+					resource.TestCheckResourceAttrSet("data.telnyx_available_phone_numbers.test", "phone_numbers.0.best_effort"),
+					resource.TestCheckResourceAttrSet("data.telnyx_available_phone_numbers.test", "phone_numbers.0.region_information.0.region_type"),
+					resource.TestCheckTypeSetElemAttr("data.telnyx_available_phone_numbers.test", "phone_numbers.0.features.*", "voice"),
+					resource.TestCheckResourceAttrSet("data.telnyx_available_phone_numbers.test", "total_results"),
+				),
+			},
+			{
+				Config: providerConfig + `
+data "telnyx_available_phone_numbers" "test" {
+  country_code = "US"
+  limit        = 0
+}
+`,
+				ExpectError: regexp.MustCompile(`limit must be at least 1`),
+			},
+			{
+				// Searches with the deprecated resource can be swapped for the
+				// data source; removing the resource makes no API call
+				SkipFunc: func() (bool, error) { return live, nil },
+				Config: providerConfig + `
+resource "telnyx_phone_number_lookup" "migrate" {
+  starts_with  = "773"
+  country_code = "US"
+  limit        = 1
+}
+`,
+				Check: rememberLookup,
+			},
+			{
+				SkipFunc: func() (bool, error) { return live, nil },
+				Config: providerConfig + `
+data "telnyx_available_phone_numbers" "migrate" {
+  starts_with  = "773"
+  country_code = "US"
+  limit        = 1
+}
+`,
+				Check: func(s *terraform.State) error {
+					return resource.TestCheckResourceAttr("data.telnyx_available_phone_numbers.migrate", "phone_numbers.0.phone_number", lookedUp)(s)
+				},
+			},
+		},
+	})
+}
+
+func TestAccPhoneNumbersDataSource(t *testing.T) {
+	if !numberOrderIncluded() {
+		t.Skip("listing owned phone numbers needs numbers bought with -include-number-order")
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "telnyx_phone_numbers" "invalid" {
+  status = "ported"
+}
+`,
+				ExpectError: regexp.MustCompile(`status must be one of`),
+			},
+			{
+				Config: providerConfig + `
+resource "telnyx_billing_group" "inventory" {
+  name = "Test Inventory Billing Group Terraform"
+}
+
+resource "telnyx_outbound_voice_profile" "inventory" {
+  name             = "Test Inventory Outbound Voice Profile Terraform"
+  billing_group_id = telnyx_billing_group.inventory.id
+}
+
+resource "telnyx_messaging_profile" "inventory" {
+  name                = "Test Inventory Messaging Profile Terraform"
+  enabled             = true
+  webhook_url         = ""
+  webhook_api_version = "2"
+}
+
+resource "telnyx_texml_application" "inventory" {
+  friendly_name      = "Test Inventory TeXML Application Terraform"
+  voice_url          = "https://example.com/voice"
+  voice_fallback_url = ""
+  voice_method       = "post"
+  inbound = {
+    codecs                         = ["G722", "G711U", "G711A", "G729", "OPUS", "H.264"]
+    sip_subdomain_receive_settings = "from_anyone"
+  }
+  outbound = {
+    outbound_voice_profile_id = telnyx_outbound_voice_profile.inventory.id
+  }
+}
+
+resource "telnyx_number_order" "inventory" {
+  connection_id        = telnyx_texml_application.inventory.id
+  billing_group_id     = telnyx_billing_group.inventory.id
+  messaging_profile_id = telnyx_messaging_profile.inventory.id
+  customer_reference   = "terraform-test-inventory"
+  phone_numbers = [
+    {
+      phone_number = "+16465550181"
+    },
+    {
+      phone_number = "+16465550182"
+    }
+  ]
+}
+
+resource "telnyx_phone_number" "tagged" {
+  phone_number = telnyx_number_order.inventory.phone_numbers[0].phone_number
+  tags         = ["terraform-test-inventory"]
+}
+
+data "telnyx_phone_numbers" "tagged" {
+  tag        = "terraform-test-inventory"
+  depends_on = [telnyx_phone_number.tagged]
+}
+
+data "telnyx_phone_numbers" "messaging_profile" {
+  messaging_profile_id = telnyx_messaging_profile.inventory.id
+  depends_on           = [telnyx_phone_number.tagged]
+}
+
+data "telnyx_phone_numbers" "billing_group" {
+  billing_group_id = telnyx_billing_group.inventory.id
+  status           = "active"
+  country_code     = "US"
+  number_type      = "local"
+  connection_name  = "inventory texml"
+  depends_on       = [telnyx_phone_number.tagged]
+}
+
+data "telnyx_phone_numbers" "none" {
+  billing_group_id = telnyx_billing_group.inventory.id
+  status           = "port-pending"
+  depends_on       = [telnyx_phone_number.tagged]
+}
+
+resource "telnyx_outbound_voice_profile" "unused" {
+  name             = "Test Unused Outbound Voice Profile Terraform"
+  billing_group_id = telnyx_billing_group.inventory.id
+}
+
+data "telnyx_phone_numbers" "outbound_voice_profile" {
+  outbound_voice_profile_id = telnyx_outbound_voice_profile.inventory.id
+  depends_on                = [telnyx_phone_number.tagged]
+}
+
+data "telnyx_phone_numbers" "unused_outbound_voice_profile" {
+  outbound_voice_profile_id = telnyx_outbound_voice_profile.unused.id
+  depends_on                = [telnyx_phone_number.tagged]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.telnyx_phone_numbers.tagged", "phone_numbers.#", "1"),
+					resource.TestCheckResourceAttr("data.telnyx_phone_numbers.tagged", "phone_numbers.0.phone_number", "+16465550181"),
+					resource.TestCheckResourceAttr("data.telnyx_phone_numbers.tagged", "phone_numbers.0.tags.0", "terraform-test-inventory"),
+					resource.TestCheckResourceAttrPair("data.telnyx_phone_numbers.tagged", "phone_numbers.0.id", "telnyx_phone_number.tagged", "id"),
+					resource.TestCheckResourceAttr("data.telnyx_phone_numbers.messaging_profile", "phone_numbers.#", "2"),
+					resource.TestCheckResourceAttr("data.telnyx_phone_numbers.messaging_profile", "phone_numbers.1.phone_number", "+16465550182"),
+					resource.TestCheckResourceAttr("data.telnyx_phone_numbers.messaging_profile", "phone_numbers.1.messaging_profile_name", "Test Inventory Messaging Profile Terraform"),
+					resource.TestCheckResourceAttr("data.telnyx_phone_numbers.billing_group", "phone_numbers.#", "2"),
+					resource.TestCheckResourceAttr("data.telnyx_phone_numbers.billing_group", "phone_numbers.0.country_code", "US"),
+					resource.TestCheckResourceAttr("data.telnyx_phone_numbers.billing_group", "phone_numbers.0.customer_reference", "terraform-test-inventory"),
+					resource.TestCheckResourceAttrPair("data.telnyx_phone_numbers.billing_group", "phone_numbers.0.connection_id", "telnyx_texml_application.inventory", "id"),
+					resource.TestCheckResourceAttrSet("data.telnyx_phone_numbers.billing_group", "phone_numbers.0.purchased_at"),
+					resource.TestCheckResourceAttr("data.telnyx_phone_numbers.none", "phone_numbers.#", "0"),
+					resource.TestCheckResourceAttr("data.telnyx_phone_numbers.outbound_voice_profile", "phone_numbers.#", "2"),
+					resource.TestCheckResourceAttr("data.telnyx_phone_numbers.outbound_voice_profile", "phone_numbers.0.phone_number", "+16465550181"),
+					resource.TestCheckResourceAttrPair("data.telnyx_phone_numbers.outbound_voice_profile", "phone_numbers.1.connection_id", "telnyx_texml_application.inventory", "id"),
+					resource.TestCheckResourceAttr("data.telnyx_phone_numbers.unused_outbound_voice_profile", "phone_numbers.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNumberLookupDataSource(t *testing.T) {
+	if live {
+		// Telnyx bills every lookup
+		t.Skip("number lookups only run against the fake API")
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "telnyx_number_lookup" "invalid" {
+  phone_number = %q
+  lookup_types = ["carrier", "fraud"]
+}
+`, telnyxtest.LookupMobileNumber),
+				ExpectError: regexp.MustCompile(`lookup_types must only contain carrier and caller-name, got "fraud"`),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "telnyx_number_lookup" "sms" {
+  phone_number = %q
+  lookup_types = ["carrier"]
+
+  lifecycle {
+    postcondition {
+      condition     = self.line_type != "fixed line"
+      error_message = "Landlines cannot receive SMS."
+    }
+  }
+}
+`, telnyxtest.LookupLandlineNumber),
+				ExpectError: regexp.MustCompile(`Landlines cannot receive SMS`),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "telnyx_number_lookup" "mobile" {
+  phone_number = %q
+  lookup_types = ["carrier", "caller-name"]
+}
+
+data "telnyx_number_lookup" "ported" {
+  phone_number = %q
+}
+`, telnyxtest.LookupMobileNumber, telnyxtest.LookupPortedNumber),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.telnyx_number_lookup.mobile", "country_code", "US"),
+					resource.TestCheckResourceAttr("data.telnyx_number_lookup.mobile", "national_format", "(312) 555-0101"),
+					resource.TestCheckResourceAttr("data.telnyx_number_lookup.mobile", "line_type", "mobile"),
+					resource.TestCheckResourceAttr("data.telnyx_number_lookup.mobile", "ported", "false"),
+					resource.TestCheckResourceAttrSet("data.telnyx_number_lookup.mobile", "carrier.name"),
+					resource.TestCheckResourceAttr("data.telnyx_number_lookup.mobile", "carrier.type", "mobile"),
+					resource.TestCheckResourceAttr("data.telnyx_number_lookup.mobile", "caller_name.caller_name", "WIRELESS CALLER"),
+					resource.TestCheckResourceAttr("data.telnyx_number_lookup.ported", "ported", "true"),
+					resource.TestCheckResourceAttr("data.telnyx_number_lookup.ported", "portability.ported_status", "Y"),
+					resource.TestCheckResourceAttr("data.telnyx_number_lookup.ported", "line_type", "mobile"),
+					resource.TestCheckNoResourceAttr("data.telnyx_number_lookup.ported", "carrier.name"),
+					resource.TestCheckNoResourceAttr("data.telnyx_number_lookup.ported", "caller_name.caller_name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "telnyx" {
+  rate_limit_burst = 5
+}
+
+resource "telnyx_billing_group" "test" {
+  name = "Test Provider Config Terraform"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`rate_limit_burst only applies together with rate_limit or\s+endpoint_rate_limits`),
+			},
+			{
+				Config: `
+resource "terraform_data" "suffix" {
+  input = "pipeline"
+}
+
+provider "telnyx" {
+  rate_limit                  = 5
+  rate_limit_burst            = 5
+  user_agent_suffix           = terraform_data.suffix.id
+  skip_credentials_validation = terraform_data.suffix.id != ""
+}
+
+resource "telnyx_billing_group" "test" {
+  name = "Test Provider Config Terraform"
+}
+`,
+				PlanOnly: true,
+				// Both are reported, in schema order, each on its own line
+				ExpectError: regexp.MustCompile(`(?s)Unknown Telnyx provider configuration value.*\d+:\s+user_agent_suffix\s+= terraform_data.suffix.id.*user_agent_suffix is\s+not known until apply.*Unknown Telnyx provider configuration value.*\d+:\s+skip_credentials_validation = .*skip_credentials_validation is\s+not known until apply`),
+			},
+		},
+	})
 }
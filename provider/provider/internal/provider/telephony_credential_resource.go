@@ -0,0 +1,325 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
+)
+
+var (
+	_ resource.Resource                   = &TelephonyCredentialResource{}
+	_ resource.ResourceWithConfigure      = &TelephonyCredentialResource{}
+	_ resource.ResourceWithValidateConfig = &TelephonyCredentialResource{}
+	_ resource.ResourceWithModifyPlan     = &TelephonyCredentialResource{}
+	_ resource.ResourceWithImportState    = &TelephonyCredentialResource{}
+)
+
+func NewTelephonyCredentialResource() resource.Resource {
+	return &TelephonyCredentialResource{}
+}
+
+type TelephonyCredentialResource struct {
+	client *telnyx.TelnyxClient
+}
+
+type TelephonyCredentialResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	ConnectionID types.String `tfsdk:"connection_id"`
+	Name         types.String `tfsdk:"name"`
+	Tag          types.String `tfsdk:"tag"`
+	ExpiresAt    types.String `tfsdk:"expires_at"`
+	Expired      types.Bool   `tfsdk:"expired"`
+	SIPUsername  types.String `tfsdk:"sip_username"`
+	SIPPassword  types.String `tfsdk:"sip_password"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+}
+
+func (r *TelephonyCredentialResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_telephony_credential"
+}
+
+func (r *TelephonyCredentialResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for managing Telnyx telephony credentials, on-demand SIP credentials generated under a credential connection for WebRTC clients and softphones. " +
+			"Telnyx generates the SIP username and password. A credential that has expired is replaced on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the telephony credential",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "ID of the credential connection the credential belongs to",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the telephony credential",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"tag": schema.StringAttribute{
+				Description: "Tag used to group and filter telephony credentials",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "RFC 3339 timestamp after which the credential stops working. Omit it for a credential that never expires. Removing it replaces the credential",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.IsNull() && req.PlanValue.IsNull()
+						},
+						"Telnyx cannot clear the expiry of a credential, so removing expires_at replaces it.",
+						"Telnyx cannot clear the expiry of a credential, so removing `expires_at` replaces it.",
+					),
+				},
+			},
+			"expired": schema.BoolAttribute{
+				Description: "Whether the credential has expired",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sip_username": schema.StringAttribute{
+				Description: "SIP username generated by Telnyx",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sip_password": schema.StringAttribute{
+				Description: "SIP password generated by Telnyx",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "ISO 8601 formatted date indicating when the resource was created",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "ISO 8601 formatted date indicating when the resource was updated",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *TelephonyCredentialResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		client, ok := req.ProviderData.(*telnyx.TelnyxClient)
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Unexpected Resource Configure Type",
+				fmt.Sprintf("Expected *telnyx.TelnyxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			)
+			return
+		}
+		r.client = client
+		tflog.Info(ctx, "Configured Telnyx client for TelephonyCredentialResource")
+	}
+}
+
+func (r *TelephonyCredentialResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config TelephonyCredentialResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ExpiresAt.IsNull() || config.ExpiresAt.IsUnknown() {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, config.ExpiresAt.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expires_at"),
+			"Invalid expires_at",
+			fmt.Sprintf("expires_at must be an RFC 3339 timestamp such as \"2030-01-02T15:04:05Z\", got %q.", config.ExpiresAt.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan replaces a credential once it has expired, since an expired
+// credential can no longer register, and refuses to create one that would
+// be expired on arrival.
+func (r *TelephonyCredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan TelephonyCredentialResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		if expiresAt, ok := parseTimestamp(plan.ExpiresAt); ok && !expiresAt.After(time.Now()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expires_at"),
+				"Telephony credential would already be expired",
+				fmt.Sprintf("expires_at %s is in the past. Move it into the future to create the credential.", plan.ExpiresAt.ValueString()),
+			)
+		}
+		return
+	}
+
+	var state TelephonyCredentialResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expiresAt, ok := parseTimestamp(state.ExpiresAt)
+	if state.Expired.ValueBool() || (ok && !expiresAt.After(time.Now())) {
+		tflog.Info(ctx, "Telephony credential has expired, planning its replacement", map[string]interface{}{"id": state.ID.ValueString()})
+		plan.Expired = types.BoolValue(false)
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expired"))
+	} else if !plan.ExpiresAt.Equal(state.ExpiresAt) {
+		plan.Expired = types.BoolUnknown()
+	}
+
+	diags = resp.Plan.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TelephonyCredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TelephonyCredentialResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credential, err := r.client.CreateTelephonyCredential(ctx, telephonyCredentialRequest(plan))
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error creating telephony credential", err)
+		return
+	}
+
+	tflog.Info(ctx, "Created Telephony Credential", map[string]interface{}{"id": credential.ID, "sip_username": credential.SIPUsername})
+
+	setTelephonyCredentialState(&plan, credential)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TelephonyCredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TelephonyCredentialResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credential, err := r.client.GetTelephonyCredential(ctx, state.ID.ValueString())
+	if err == nil {
+		setTelephonyCredentialState(&state, credential)
+		diags = resp.State.Set(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	if telnyx.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.AddError("Error reading telephony credential", err.Error())
+}
+
+func (r *TelephonyCredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TelephonyCredentialResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state TelephonyCredentialResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credential, err := r.client.UpdateTelephonyCredential(ctx, state.ID.ValueString(), telephonyCredentialRequest(plan))
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error updating telephony credential", err)
+		return
+	}
+
+	setTelephonyCredentialState(&plan, credential)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TelephonyCredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TelephonyCredentialResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteTelephonyCredential(ctx, state.ID.ValueString())
+	if err != nil && !telnyx.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting telephony credential", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Telephony Credential", map[string]interface{}{"id": state.ID.ValueString()})
+}
+
+func (r *TelephonyCredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func telephonyCredentialRequest(plan TelephonyCredentialResourceModel) telnyx.TelephonyCredentialRequest {
+	return telnyx.TelephonyCredentialRequest{
+		ConnectionID: plan.ConnectionID.ValueString(),
+		Name:         telnyx.StringPtr(plan.Name.ValueString()),
+		Tag:          telnyx.StringPtr(plan.Tag.ValueString()),
+		ExpiresAt:    getStringPointer(plan.ExpiresAt),
+	}
+}
+
+func setTelephonyCredentialState(state *TelephonyCredentialResourceModel, credential *telnyx.TelephonyCredential) {
+	state.ID = types.StringValue(credential.ID)
+	state.ConnectionID = types.StringValue(credential.ConnectionID())
+	state.Name = types.StringValue(credential.Name)
+	state.Tag = types.StringValue(credential.Tag)
+	state.Expired = types.BoolValue(credential.Expired)
+	state.SIPUsername = types.StringValue(credential.SIPUsername)
+	state.SIPPassword = types.StringValue(credential.SIPPassword)
+	state.CreatedAt = types.StringValue(credential.CreatedAt.String())
+	state.UpdatedAt = types.StringValue(credential.UpdatedAt.String())
+
+	state.ExpiresAt = timestampValue(state.ExpiresAt, credential.ExpiresAt)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -20,8 +21,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &TeXMLApplicationResource{}
-	_ resource.ResourceWithConfigure = &TeXMLApplicationResource{}
+	_ resource.Resource                = &TeXMLApplicationResource{}
+	_ resource.ResourceWithConfigure   = &TeXMLApplicationResource{}
+	_ resource.ResourceWithImportState = &TeXMLApplicationResource{}
 )
 
 func NewTeXMLApplicationResource() resource.Resource {
@@ -57,7 +59,7 @@ func (r *TeXMLApplicationResource) Metadata(ctx context.Context, req resource.Me
 
 func (r *TeXMLApplicationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Resource for managing Telnyx TeXML applications",
+		Description: "Resource for managing Telnyx TeXML applications. Import with the application's ID or its exact friendly_name.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Unique identifier of the TeXML application",
@@ -445,9 +447,9 @@ func (r *TeXMLApplicationResource) Create(ctx context.Context, req resource.Crea
 		},
 	}
 
-	application, err := r.client.CreateTeXMLApplication(applicationRequest)
+	application, err := r.client.CreateTeXMLApplication(ctx, applicationRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating TeXML application", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error creating TeXML application", err)
 		return
 	}
 
@@ -466,14 +468,14 @@ func (r *TeXMLApplicationResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	application, err := r.client.GetTeXMLApplication(state.ID.ValueString())
+	application, err := r.client.GetTeXMLApplication(ctx, state.ID.ValueString())
 	if err == nil {
 		setStateFromTeXMLApplicationResponse(&state, application)
 		diags = resp.State.Set(ctx, state)
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-	if telnyxErr, ok := err.(*telnyx.TelnyxError); ok && telnyxErr.IsResourceNotFound() {
+	if telnyx.IsNotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -542,9 +544,9 @@ func (r *TeXMLApplicationResource) Update(ctx context.Context, req resource.Upda
 		},
 	}
 
-	application, err := r.client.UpdateTeXMLApplication(plan.ID.ValueString(), applicationRequest)
+	application, err := r.client.UpdateTeXMLApplication(ctx, plan.ID.ValueString(), applicationRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating TeXML application", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, req.Plan, "Error updating TeXML application", err)
 		return
 	}
 
@@ -563,7 +565,7 @@ func (r *TeXMLApplicationResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	err := r.client.DeleteTeXMLApplication(state.ID.ValueString())
+	err := r.client.DeleteTeXMLApplication(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting TeXML application", err.Error())
 	}
@@ -571,6 +573,15 @@ func (r *TeXMLApplicationResource) Delete(ctx context.Context, req resource.Dele
 	resp.State.RemoveResource(ctx)
 }
 
+func (r *TeXMLApplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importByName(ctx, req, resp, func(id, name types.String) string {
+		if application := findTeXMLApplication(ctx, r.client, id, name, &resp.Diagnostics); application != nil {
+			return application.ID
+		}
+		return ""
+	})
+}
+
 func setStateFromTeXMLApplicationResponse(state *TeXMLApplicationResourceModel, application *telnyx.TeXMLApplication) {
 	state.ID = types.StringValue(application.ID)
 	state.FriendlyName = types.StringValue(application.FriendlyName)
@@ -651,3 +662,13 @@ func setStateFromTeXMLApplicationResponse(state *TeXMLApplicationResourceModel,
 	state.CreatedAt = types.StringValue(application.CreatedAt.String())
 	state.UpdatedAt = types.StringValue(application.UpdatedAt.String())
 }
+
+// findTeXMLApplication returns the TeXML application with the given ID or,
+// when id is null, the one with exactly the given friendly name.
+func findTeXMLApplication(ctx context.Context, client *telnyx.TelnyxClient, id, name types.String, diags *diag.Diagnostics) *telnyx.TeXMLApplication {
+	return lookupByIDOrName(ctx, "TeXML application", "friendly_name", id, name,
+		client.GetTeXMLApplication, client.ListTeXMLApplications, "filter[friendly_name]",
+		func(record telnyx.TeXMLApplication) (string, string) { return record.ID, record.FriendlyName },
+		diags,
+	)
+}
@@ -2,8 +2,15 @@ package provider
 
 import (
 	"context"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
 )
@@ -22,28 +29,29 @@ func convertStringsToList(strings []string) types.List {
 	return types.ListValueMust(types.StringType, elements)
 }
 
-// ConvertListToPhoneNumbers converts a types.List to a slice of PhoneNumberResourceModel.
-func ConvertListToPhoneNumbers(ctx context.Context, list types.List) ([]PhoneNumberResourceModel, diag.Diagnostics) {
-	var phoneNumbers []PhoneNumberResourceModel
+// ConvertListToPhoneNumbers converts a types.List to a slice of NumberOrderPhoneNumberModel.
+func ConvertListToPhoneNumbers(ctx context.Context, list types.List) ([]NumberOrderPhoneNumberModel, diag.Diagnostics) {
+	var phoneNumbers []NumberOrderPhoneNumberModel
 	diags := list.ElementsAs(ctx, &phoneNumbers, false)
 	return phoneNumbers, diags
 }
 
-// ConvertPhoneNumbersToList converts a slice of PhoneNumberResourceModel to a types.List.
-func ConvertPhoneNumbersToList(ctx context.Context, phoneNumbers []PhoneNumberResourceModel) (types.List, diag.Diagnostics) {
+// ConvertPhoneNumbersToList converts a slice of NumberOrderPhoneNumberModel to a types.List.
+func ConvertPhoneNumbersToList(ctx context.Context, phoneNumbers []NumberOrderPhoneNumberModel) (types.List, diag.Diagnostics) {
 	elements := make([]attr.Value, len(phoneNumbers))
 	for i, pn := range phoneNumbers {
 		elements[i] = types.ObjectValueMust(
-			PhoneNumberResourceModel{}.AttrTypes(),
+			NumberOrderPhoneNumberModel{}.AttrTypes(),
 			map[string]attr.Value{
 				"id":                      pn.ID,
 				"phone_number":            pn.PhoneNumber,
 				"status":                  pn.Status,
+				"requirement_group_id":    pn.RequirementGroupID,
 				"regulatory_requirements": pn.RegulatoryRequirements,
 			},
 		)
 	}
-	return types.ListValueMust(types.ObjectType{AttrTypes: PhoneNumberResourceModel{}.AttrTypes()}, elements), nil
+	return types.ListValueMust(types.ObjectType{AttrTypes: NumberOrderPhoneNumberModel{}.AttrTypes()}, elements), nil
 }
 
 // ConvertListToRegulatoryRequirements converts a types.List to a slice of RegulatoryRequirementResourceModel.
@@ -127,3 +135,105 @@ func getFloat64Pointer(value types.Float64) *float64 {
 	val := value.ValueFloat64()
 	return &val
 }
+
+// fillObject returns plan with attributes that are null or unknown taken from
+// current, so a partially configured nested object keeps the remote values of
+// the attributes it leaves out.
+func fillObject(plan, current types.Object) types.Object {
+	if plan.IsNull() || plan.IsUnknown() {
+		return current
+	}
+	currentAttributes := current.Attributes()
+	attributes := make(map[string]attr.Value, len(currentAttributes))
+	for name, value := range plan.Attributes() {
+		if value.IsNull() || value.IsUnknown() {
+			value = currentAttributes[name]
+		}
+		attributes[name] = value
+	}
+	return types.ObjectValueMust(current.AttributeTypes(context.Background()), attributes)
+}
+
+// optionalComputedBool is a setting that keeps its remote value when the
+// configuration leaves it out.
+func optionalComputedBool(description string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// optionalComputedString is a setting that keeps its remote value when the
+// configuration leaves it out.
+func optionalComputedString(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// computedString is a read-only attribute that keeps its value across plans
+// until the next refresh.
+func computedString(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description,
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// optionalComputedObject is a group of settings that keeps its remote values
+// when the configuration leaves it, or any of its attributes, out.
+func optionalComputedObject(description string, attributes map[string]schema.Attribute) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		Attributes:  attributes,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// parseTimestamp parses an RFC 3339 timestamp attribute, reporting false when
+// it is unset, unknown or malformed.
+func parseTimestamp(value types.String) (time.Time, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return time.Time{}, false
+	}
+	parsed, err := time.Parse(time.RFC3339, value.ValueString())
+	return parsed, err == nil
+}
+
+// timestampValue returns the state value of a timestamp Telnyx reported,
+// keeping the current spelling when it names the same instant in a
+// different format.
+func timestampValue(current types.String, reported *time.Time) types.String {
+	if reported == nil {
+		return types.StringNull()
+	}
+	if parsed, ok := parseTimestamp(current); ok && parsed.Equal(*reported) {
+		return current
+	}
+	return types.StringValue(reported.Format(time.RFC3339))
+}
+
+// stringOrNull returns the state value of an optional string Telnyx reports
+// as empty when unset.
+func stringOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
@@ -1,24 +1,74 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+
 	"github.com/petsinc/telnyx-rest-client/internal/test_runner"
+	"github.com/petsinc/telnyx-rest-client/pkg/cassette"
 	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
+	"github.com/petsinc/telnyx-rest-client/pkg/telnyxtest"
 	"go.uber.org/zap"
-	"os"
 )
 
 func main() {
+	fake := flag.Bool("fake", false, "Run the create, update and delete operations against an in-memory fake Telnyx API")
+	flag.Parse()
+
 	logger, _ := zap.NewProduction()
 	defer logger.Sync()
-	client := telnyx.NewClient()
+
+	// Cancel in-flight requests and retry backoffs on Ctrl-C
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if *fake {
+		server := telnyxtest.NewServer()
+		defer server.Close()
+
+		client, err := server.NewClient(telnyx.WithLogger(logger))
+		if err != nil {
+			logger.Fatal("Error creating Telnyx client", zap.Error(err))
+		}
+		runner := test_runner.NewTestRunner(client, logger)
+		runner.PerformCreates(ctx)
+		runner.PerformUpdates(ctx)
+		runner.PerformCascadingDeletes(ctx)
+		return
+	}
+
+	// TELNYX_REST_CLIENT_CASSETTE records the flows to a fixture or replays
+	// them from one, see package cassette
+	recording, err := cassette.FromEnv()
+	if err != nil {
+		logger.Fatal("Error loading cassette", zap.Error(err))
+	}
+	client, err := telnyx.NewClient(telnyx.WithLogger(logger), telnyx.WithCassette(recording))
+	if err != nil {
+		logger.Fatal("Error creating Telnyx client", zap.Error(err))
+	}
 	runner := test_runner.NewTestRunner(client, logger)
 
+	// With a cassette the flows are either replayed from a fixture or
+	// recorded on purpose, so they are safe to run
+	if recording != nil {
+		runner.PerformCreates(ctx)
+		runner.PerformUpdates(ctx)
+		runner.PerformCascadingDeletes(ctx)
+		return
+	}
+
 	// Perform create operations
-	// runner.PerformCreates()
+	// runner.PerformCreates(ctx)
 
 	// // Perform update operations
-	// runner.PerformUpdates()
+	// runner.PerformUpdates(ctx)
 
 	// // Perform cascading delete operations
-	// runner.PerformCascadingDeletes()
+	// runner.PerformCascadingDeletes(ctx)
+
+	// The operations above hit a live account, so they stay opt-in
+	_, _ = ctx, runner
 }
@@ -0,0 +1,129 @@
+// Package redact masks credentials in HTTP headers and JSON bodies before
+// they are logged or written to disk.
+package redact
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Placeholder replaces every redacted value.
+const Placeholder = "REDACTED"
+
+// sensitiveFields are JSON keys whose values are always masked, compared
+// case-insensitively.
+var sensitiveFields = map[string]bool{
+	"password":                true,
+	"ip_authentication_token": true,
+	"v1_secret":               true,
+	"api_key":                 true,
+	"token":                   true,
+	"secret":                  true,
+	"sip_password":            true,
+	"pin":                     true,
+	"pin_passcode":            true,
+}
+
+// sensitiveHeaders are header names whose values are always masked.
+var sensitiveHeaders = map[string]bool{
+	"Authorization": true,
+	"Cookie":        true,
+	"Set-Cookie":    true,
+	"X-Api-Key":     true,
+}
+
+// secretResponsePaths are suffixes of the paths of endpoints that answer
+// with a bare secret instead of JSON, such as telephony credential tokens,
+// or with the contents of an uploaded document.
+var secretResponsePaths = []string{"/token", "/download"}
+
+// IsSecretResponse reports whether the whole response body of a request to
+// path is a secret and must be masked as a unit.
+func IsSecretResponse(path string) bool {
+	for _, suffix := range secretResponsePaths {
+		if strings.HasSuffix(path, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsMultipart reports whether header describes a multipart body, such as a
+// document upload.
+func IsMultipart(header http.Header) bool {
+	return strings.HasPrefix(header.Get("Content-Type"), "multipart/")
+}
+
+// Multipart summarises a multipart body by its size and digest. Uploaded
+// files may hold personal data and are never logged or recorded, but the
+// summary still tells different uploads apart.
+func Multipart(body []byte) string {
+	return fmt.Sprintf("multipart body of %d bytes, sha256 %x", len(body), sha256.Sum256(body))
+}
+
+// IsSensitiveField reports whether values stored under the given JSON key
+// are masked.
+func IsSensitiveField(name string) bool {
+	return sensitiveFields[strings.ToLower(name)]
+}
+
+// Headers returns a copy of header with sensitive values masked.
+func Headers(header http.Header) http.Header {
+	redacted := header.Clone()
+	for name := range redacted {
+		if sensitiveHeaders[http.CanonicalHeaderKey(name)] {
+			redacted[name] = []string{Placeholder}
+		}
+	}
+	return redacted
+}
+
+// JSON returns body with the values of sensitive fields masked at any depth.
+// Numbers are kept exact, so 19 digit IDs are not rounded. Bodies that are
+// not valid JSON are returned unchanged, since they cannot be inspected field
+// by field.
+func JSON(body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+	var decoded interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&decoded); err != nil {
+		return body
+	}
+	redacted, err := json.Marshal(Value(decoded))
+	if err != nil {
+		return body
+	}
+	return redacted
+}
+
+// Value masks sensitive fields in a decoded JSON value, as produced by
+// json.Unmarshal into an interface{}.
+func Value(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(v))
+		for key, field := range v {
+			if IsSensitiveField(key) && field != nil {
+				redacted[key] = Placeholder
+				continue
+			}
+			redacted[key] = Value(field)
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, item := range v {
+			redacted[i] = Value(item)
+		}
+		return redacted
+	default:
+		return value
+	}
+}
@@ -0,0 +1,110 @@
+package redact
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "empty", body: "", want: ""},
+		{name: "no secrets", body: `{"id":"1","name":"office"}`, want: `{"id":"1","name":"office"}`},
+		{name: "top level", body: `{"password":"hunter2","user_name":"pets"}`, want: `{"password":"REDACTED","user_name":"pets"}`},
+		{name: "case insensitive", body: `{"Password":"hunter2","API_KEY":"KEY123"}`, want: `{"API_KEY":"REDACTED","Password":"REDACTED"}`},
+		{name: "nested", body: `{"data":{"inbound":{"sip_password":"s1"},"outbound":{"ip_authentication_token":"t1"}}}`, want: `{"data":{"inbound":{"sip_password":"REDACTED"},"outbound":{"ip_authentication_token":"REDACTED"}}}`},
+		{name: "array", body: `{"data":[{"id":"1","token":"t1"},{"id":"2","v1_secret":"v1"}]}`, want: `{"data":[{"id":"1","token":"REDACTED"},{"id":"2","v1_secret":"REDACTED"}]}`},
+		{name: "top level array", body: `[{"pin":"1234"},{"pin_passcode":"5678"}]`, want: `[{"pin":"REDACTED"},{"pin_passcode":"REDACTED"}]`},
+		{name: "secret object", body: `{"secret":{"value":"s1"}}`, want: `{"secret":"REDACTED"}`},
+		{name: "null secret kept", body: `{"password":null}`, want: `{"password":null}`},
+		{name: "large numbers kept exact", body: `{"id":1293384261075731499,"token":"t1"}`, want: `{"id":1293384261075731499,"token":"REDACTED"}`},
+		{name: "not JSON", body: `password=hunter2`, want: `password=hunter2`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := string(JSON([]byte(test.body))); got != test.want {
+				t.Errorf("JSON(%s) = %s, want %s", test.body, got, test.want)
+			}
+		})
+	}
+}
+
+func TestHeaders(t *testing.T) {
+	header := http.Header{
+		"Authorization": {"Bearer KEY123"},
+		"Cookie":        {"session=abc"},
+		"Set-Cookie":    {"session=abc", "other=def"},
+		"X-Api-Key":     {"KEY123"},
+		"Content-Type":  {"application/json"},
+		"X-Request-Id":  {"req-1"},
+	}
+	want := http.Header{
+		"Authorization": {Placeholder},
+		"Cookie":        {Placeholder},
+		"Set-Cookie":    {Placeholder},
+		"X-Api-Key":     {Placeholder},
+		"Content-Type":  {"application/json"},
+		"X-Request-Id":  {"req-1"},
+	}
+
+	if got := Headers(header); !reflect.DeepEqual(got, want) {
+		t.Errorf("Headers() = %v, want %v", got, want)
+	}
+	if header.Get("Authorization") != "Bearer KEY123" {
+		t.Error("Headers() modified its argument")
+	}
+	// Header maps built by hand need not use canonical keys
+	if got := Headers(http.Header{"authorization": {"Bearer KEY123"}}); got["authorization"][0] != Placeholder {
+		t.Errorf("Headers() left a non-canonical Authorization header: %v", got)
+	}
+}
+
+func TestMultipart(t *testing.T) {
+	tests := []struct {
+		contentType string
+		want        bool
+	}{
+		{contentType: "multipart/form-data; boundary=abc", want: true},
+		{contentType: "multipart/mixed", want: true},
+		{contentType: "application/json"},
+		{contentType: ""},
+	}
+	for _, test := range tests {
+		if got := IsMultipart(http.Header{"Content-Type": {test.contentType}}); got != test.want {
+			t.Errorf("IsMultipart(%q) = %t, want %t", test.contentType, got, test.want)
+		}
+	}
+
+	body := []byte("--abc\r\nContent-Disposition: form-data; name=\"file\"\r\n\r\npassport scan\r\n--abc--\r\n")
+	want := fmt.Sprintf("multipart body of %d bytes, sha256 %x", len(body), sha256.Sum256(body))
+	if got := Multipart(body); got != want {
+		t.Errorf("Multipart() = %q, want %q", got, want)
+	}
+	if Multipart(body) == Multipart(append(body, 'x')) {
+		t.Error("Multipart() gave different bodies the same summary")
+	}
+}
+
+func TestIsSecretResponse(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "/telephony_credentials/1/token", want: true},
+		{path: "/documents/1/download", want: true},
+		{path: "/telephony_credentials/1"},
+		{path: "/documents"},
+		{path: "/tokens"},
+	}
+	for _, test := range tests {
+		if got := IsSecretResponse(test.path); got != test.want {
+			t.Errorf("IsSecretResponse(%q) = %t, want %t", test.path, got, test.want)
+		}
+	}
+}
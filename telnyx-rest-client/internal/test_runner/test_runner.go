@@ -1,6 +1,8 @@
 package test_runner
 
 import (
+	"context"
+
 	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
 	"go.uber.org/zap"
 	"os"
@@ -26,11 +28,11 @@ func NewTestRunner(client *telnyx.TelnyxClient, logger *zap.Logger) *TestRunner
 	return &TestRunner{client: client, logger: logger}
 }
 
-func (runner *TestRunner) PerformCreates() {
+func (runner *TestRunner) PerformCreates(ctx context.Context) {
 	runner.logger.Info("Performing create operations")
 
 	// Create a Billing Group
-	billingGroup, err := runner.client.CreateBillingGroup("Test Billing Group")
+	billingGroup, err := runner.client.CreateBillingGroup(ctx, "Test Billing Group")
 	if err != nil {
 		runner.logger.Error("Error creating billing group", zap.Error(err))
 		os.Exit(1)
@@ -42,17 +44,17 @@ func (runner *TestRunner) PerformCreates() {
 		zap.Time("Created At", billingGroup.CreatedAt))
 
 	// Create an Outbound Voice Profile
-	outboundVoiceProfile, err := runner.client.CreateOutboundVoiceProfile(telnyx.OutboundVoiceProfile{
+	outboundVoiceProfile, err := runner.client.CreateOutboundVoiceProfile(ctx, telnyx.OutboundVoiceProfile{
 		Name:                    "Test Outbound Profile",
 		TrafficType:             "conversational",
 		ServicePlan:             "global",
-		ConcurrentCallLimit:     10,
+		ConcurrentCallLimit:     telnyx.IntPtr(10),
 		Enabled:                 true,
 		Tags:                    []string{"test-profile"},
 		UsagePaymentMethod:      "rate-deck",
 		WhitelistedDestinations: []string{"US"},
-		MaxDestinationRate:      10.0,
-		DailySpendLimit:         "100.00",
+		MaxDestinationRate:      telnyx.Float64Ptr(10.0),
+		DailySpendLimit:         telnyx.StringPtr("100.00"),
 		DailySpendLimitEnabled:  true,
 		BillingGroupID:          runner.billingGroupID,
 		CallRecording: telnyx.CallRecording{
@@ -74,7 +76,7 @@ func (runner *TestRunner) PerformCreates() {
 		zap.Time("Created At", outboundVoiceProfile.CreatedAt))
 
 	// Create a Messaging Profile
-	messagingProfile, err := runner.client.CreateMessagingProfile(telnyx.MessagingProfile{
+	messagingProfile, err := runner.client.CreateMessagingProfile(ctx, telnyx.MessagingProfile{
 		Name:                    "Test Profile",
 		Enabled:                 true,
 		WebhookURL:              "https://www.example.com/hooks",
@@ -99,17 +101,15 @@ func (runner *TestRunner) PerformCreates() {
 		zap.Time("Updated At", messagingProfile.UpdatedAt))
 
 	// Create a Credential Connection
-	credentialConnection, err := runner.client.CreateCredentialConnection(telnyx.FQDNConnection{
-		Username:                         telnyx.StringPtr("hellopatienttest12345"),
-		Password:                         telnyx.StringPtr("54321testpatienthello"),
+	credentialConnection, err := runner.client.CreateCredentialConnection(ctx, telnyx.CredentialConnection{
+		Username:                         "hellopatienttest12345",
+		Password:                         "54321testpatienthello",
 		Active:                           true,
 		AnchorsiteOverride:               "Latency",
 		ConnectionName:                   "Test Credential Connection",
-		TransportProtocol:                "UDP",
 		DefaultOnHoldComfortNoiseEnabled: true,
 		DTMFType:                         "RFC 2833",
 		EncodeContactHeaderEnabled:       false,
-		EncryptedMedia:                   nil,
 		OnnetT38PassthroughEnabled:       false,
 		MicrosoftTeamsSbc:                false,
 		WebhookEventURL:                  "https://www.example.com/hooks",
@@ -126,34 +126,31 @@ func (runner *TestRunner) PerformCreates() {
 			DNISNumberFormat:            "e164",
 			Codecs:                      []string{"G722", "G711U", "G711A", "G729", "OPUS", "H.264"},
 			DefaultRoutingMethod:        "sequential",
-			ChannelLimit:                10,
-			GenerateRingbackTone:        true,
-			ISUPHeadersEnabled:          true,
-			PRACKEnabled:                true,
-			PrivacyZoneEnabled:          true,
-			SIPCompactHeadersEnabled:    true,
+			ChannelLimit:                telnyx.IntPtr(10),
+			GenerateRingbackTone:        telnyx.BoolPtr(true),
+			ISUPHeadersEnabled:          telnyx.BoolPtr(true),
+			PRACKEnabled:                telnyx.BoolPtr(true),
+			PrivacyZoneEnabled:          telnyx.BoolPtr(true),
+			SIPCompactHeadersEnabled:    telnyx.BoolPtr(true),
 			SIPRegion:                   "US",
 			SIPSubdomain:                "uniqueexample.sip.telnyx.com",
 			SIPSubdomainReceiveSettings: "only_my_connections",
-			Timeout1xxSecs:              3,
-			Timeout2xxSecs:              90,
-			ShakenSTIREnabled:           true,
+			Timeout1xxSecs:              telnyx.IntPtr(3),
+			Timeout2xxSecs:              telnyx.IntPtr(90),
+			ShakenSTIREnabled:           telnyx.BoolPtr(true),
 		},
 		Outbound: telnyx.OutboundSettings{
 			ANIOverride:            "+12345678901",
 			ANIOverrideType:        "always",
-			CallParkingEnabled:     true,
-			ChannelLimit:           10,
-			GenerateRingbackTone:   true,
-			InstantRingbackEnabled: false,
+			CallParkingEnabled:     telnyx.BoolPtr(true),
+			ChannelLimit:           telnyx.IntPtr(10),
+			GenerateRingbackTone:   telnyx.BoolPtr(true),
+			InstantRingbackEnabled: telnyx.BoolPtr(false),
 			IPAuthenticationMethod: "token",
-			IPAuthenticationToken:  "aBcD1234aBcD1234",
+			IPAuthenticationToken:  telnyx.StringPtr("aBcD1234aBcD1234"),
 			Localization:           "US",
 			OutboundVoiceProfileID: runner.outboundVoiceProfileID,
 			T38ReinviteSource:      "customer",
-			EncryptedMedia:         "SRTP",
-			Timeout1xxSecs:         3,
-			Timeout2xxSecs:         90,
 		},
 	})
 	if err != nil {
@@ -167,7 +164,7 @@ func (runner *TestRunner) PerformCreates() {
 		zap.Time("Created At", credentialConnection.CreatedAt))
 
 	// Create an FQDN Connection
-	fqdnConnection, err := runner.client.CreateFQDNConnection(telnyx.FQDNConnection{
+	fqdnConnection, err := runner.client.CreateFQDNConnection(ctx, telnyx.FQDNConnection{
 		Username:                         telnyx.StringPtr("hellopatienttest123456"),
 		Password:                         telnyx.StringPtr("54321testpatienthello"),
 		Active:                           true,
@@ -194,34 +191,31 @@ func (runner *TestRunner) PerformCreates() {
 			DNISNumberFormat:            "e164",
 			Codecs:                      []string{"G722", "G711U", "G711A", "G729", "OPUS", "H.264"},
 			DefaultRoutingMethod:        "sequential",
-			ChannelLimit:                10,
-			GenerateRingbackTone:        true,
-			ISUPHeadersEnabled:          true,
-			PRACKEnabled:                true,
-			PrivacyZoneEnabled:          true,
-			SIPCompactHeadersEnabled:    true,
+			ChannelLimit:                telnyx.IntPtr(10),
+			GenerateRingbackTone:        telnyx.BoolPtr(true),
+			ISUPHeadersEnabled:          telnyx.BoolPtr(true),
+			PRACKEnabled:                telnyx.BoolPtr(true),
+			PrivacyZoneEnabled:          telnyx.BoolPtr(true),
+			SIPCompactHeadersEnabled:    telnyx.BoolPtr(true),
 			SIPRegion:                   "US",
 			SIPSubdomain:                "uniqueexample.sip.telnyx.com",
 			SIPSubdomainReceiveSettings: "only_my_connections",
-			Timeout1xxSecs:              3,
-			Timeout2xxSecs:              90,
-			ShakenSTIREnabled:           true,
+			Timeout1xxSecs:              telnyx.IntPtr(3),
+			Timeout2xxSecs:              telnyx.IntPtr(90),
+			ShakenSTIREnabled:           telnyx.BoolPtr(true),
 		},
 		Outbound: telnyx.OutboundSettings{
 			ANIOverride:            "+12345678901",
 			ANIOverrideType:        "always",
-			CallParkingEnabled:     true,
-			ChannelLimit:           10,
-			GenerateRingbackTone:   true,
-			InstantRingbackEnabled: false,
+			CallParkingEnabled:     telnyx.BoolPtr(true),
+			ChannelLimit:           telnyx.IntPtr(10),
+			GenerateRingbackTone:   telnyx.BoolPtr(true),
+			InstantRingbackEnabled: telnyx.BoolPtr(false),
 			IPAuthenticationMethod: "token",
-			IPAuthenticationToken:  "aBcD1234aBcD1234",
+			IPAuthenticationToken:  telnyx.StringPtr("aBcD1234aBcD1234"),
 			Localization:           "US",
 			OutboundVoiceProfileID: runner.outboundVoiceProfileID,
 			T38ReinviteSource:      "customer",
-			EncryptedMedia:         "SRTP",
-			Timeout1xxSecs:         3,
-			Timeout2xxSecs:         90,
 		},
 	})
 	if err != nil {
@@ -237,7 +231,7 @@ func (runner *TestRunner) PerformCreates() {
 		zap.Time("Created At", fqdnConnection.CreatedAt))
 
 	// Create an FQDN and bind it to the connection
-	fqdn, err := runner.client.CreateFQDN(telnyx.FQDN{
+	fqdn, err := runner.client.CreateFQDN(ctx, telnyx.FQDN{
 		ConnectionID:  runner.fqdnConnectionIDInt,
 		FQDN:          "test.sip.livekit.cloud",
 		DNSRecordType: "a",
@@ -259,7 +253,7 @@ func (runner *TestRunner) PerformCreates() {
 		CountryCode: "US",
 		Limit:       10,
 	}
-	response, err := runner.client.ListAvailablePhoneNumbers(filters)
+	response, err := runner.client.ListAvailablePhoneNumbers(ctx, filters)
 	if err != nil {
 		runner.logger.Error("Error retrieving available phone numbers", zap.Error(err))
 		os.Exit(1)
@@ -286,7 +280,7 @@ func (runner *TestRunner) PerformCreates() {
 		CustomerReference:  "Test Order",
 	}
 
-	numberOrder, err := runner.client.CreateNumberOrder(numberOrderRequest)
+	numberOrder, err := runner.client.CreateNumberOrder(ctx, numberOrderRequest)
 	if err != nil {
 		runner.logger.Error("Error creating number order", zap.Error(err))
 		os.Exit(1)
@@ -303,11 +297,11 @@ func (runner *TestRunner) PerformCreates() {
 		zap.Time("UpdatedAt", numberOrder.UpdatedAt))
 }
 
-func (runner *TestRunner) PerformUpdates() {
+func (runner *TestRunner) PerformUpdates(ctx context.Context) {
 	runner.logger.Info("Performing update operations")
 
 	// Update the Messaging Profile
-	updatedMessagingProfile, err := runner.client.UpdateMessagingProfile(runner.messagingProfileID, telnyx.MessagingProfile{
+	updatedMessagingProfile, err := runner.client.UpdateMessagingProfile(ctx, runner.messagingProfileID, telnyx.MessagingProfile{
 		Name:                    "Updated Profile for Messages",
 		Enabled:                 true,
 		WebhookURL:              "https://www.example.com/hooks",
@@ -331,17 +325,17 @@ func (runner *TestRunner) PerformUpdates() {
 		zap.Time("Updated At", updatedMessagingProfile.UpdatedAt))
 
 	// Update the Outbound Voice Profile
-	updatedOutboundVoiceProfile, err := runner.client.UpdateOutboundVoiceProfile(runner.outboundVoiceProfileID, telnyx.OutboundVoiceProfile{
+	updatedOutboundVoiceProfile, err := runner.client.UpdateOutboundVoiceProfile(ctx, runner.outboundVoiceProfileID, telnyx.OutboundVoiceProfile{
 		Name:                    "Test Outbound Profile Updated",
 		TrafficType:             "conversational",
 		ServicePlan:             "global",
-		ConcurrentCallLimit:     10,
+		ConcurrentCallLimit:     telnyx.IntPtr(10),
 		Enabled:                 true,
 		Tags:                    []string{"test-profile"},
 		UsagePaymentMethod:      "rate-deck",
 		WhitelistedDestinations: []string{"US"},
-		MaxDestinationRate:      10.0,
-		DailySpendLimit:         "100.00",
+		MaxDestinationRate:      telnyx.Float64Ptr(10.0),
+		DailySpendLimit:         telnyx.StringPtr("100.00"),
 		DailySpendLimitEnabled:  true,
 		BillingGroupID:          runner.billingGroupID,
 		CallRecording: telnyx.CallRecording{
@@ -362,17 +356,15 @@ func (runner *TestRunner) PerformUpdates() {
 		zap.Time("Updated At", updatedOutboundVoiceProfile.UpdatedAt))
 
 	// Update the Credential Connection
-	updatedCredentialConnection, err := runner.client.UpdateCredentialConnection(runner.credentialConnectionID, telnyx.FQDNConnection{
-		Username:                         telnyx.StringPtr("updatedtest12345"),
-		Password:                         telnyx.StringPtr("updatedpassword54321"),
+	updatedCredentialConnection, err := runner.client.UpdateCredentialConnection(ctx, runner.credentialConnectionID, telnyx.CredentialConnection{
+		Username:                         "updatedtest12345",
+		Password:                         "updatedpassword54321",
 		Active:                           true,
 		AnchorsiteOverride:               "Latency",
 		ConnectionName:                   "Updated Credential Connection",
-		TransportProtocol:                "UDP",
 		DefaultOnHoldComfortNoiseEnabled: true,
 		DTMFType:                         "RFC 2833",
 		EncodeContactHeaderEnabled:       false,
-		EncryptedMedia:                   nil,
 		OnnetT38PassthroughEnabled:       false,
 		MicrosoftTeamsSbc:                false,
 		WebhookEventURL:                  "https://www.example.com/hooks",
@@ -389,34 +381,31 @@ func (runner *TestRunner) PerformUpdates() {
 			DNISNumberFormat:            "e164",
 			Codecs:                      []string{"G722", "G711U", "G711A", "G729", "OPUS", "H.264"},
 			DefaultRoutingMethod:        "sequential",
-			ChannelLimit:                10,
-			GenerateRingbackTone:        true,
-			ISUPHeadersEnabled:          true,
-			PRACKEnabled:                true,
-			PrivacyZoneEnabled:          true,
-			SIPCompactHeadersEnabled:    true,
+			ChannelLimit:                telnyx.IntPtr(10),
+			GenerateRingbackTone:        telnyx.BoolPtr(true),
+			ISUPHeadersEnabled:          telnyx.BoolPtr(true),
+			PRACKEnabled:                telnyx.BoolPtr(true),
+			PrivacyZoneEnabled:          telnyx.BoolPtr(true),
+			SIPCompactHeadersEnabled:    telnyx.BoolPtr(true),
 			SIPRegion:                   "US",
 			SIPSubdomain:                "updatedexample.sip.telnyx.com",
 			SIPSubdomainReceiveSettings: "only_my_connections",
-			Timeout1xxSecs:              3,
-			Timeout2xxSecs:              90,
-			ShakenSTIREnabled:           true,
+			Timeout1xxSecs:              telnyx.IntPtr(3),
+			Timeout2xxSecs:              telnyx.IntPtr(90),
+			ShakenSTIREnabled:           telnyx.BoolPtr(true),
 		},
 		Outbound: telnyx.OutboundSettings{
 			ANIOverride:            "+12345678901",
 			ANIOverrideType:        "always",
-			CallParkingEnabled:     true,
-			ChannelLimit:           10,
-			GenerateRingbackTone:   true,
-			InstantRingbackEnabled: false,
+			CallParkingEnabled:     telnyx.BoolPtr(true),
+			ChannelLimit:           telnyx.IntPtr(10),
+			GenerateRingbackTone:   telnyx.BoolPtr(true),
+			InstantRingbackEnabled: telnyx.BoolPtr(false),
 			IPAuthenticationMethod: "token",
-			IPAuthenticationToken:  "updatedtoken1234",
+			IPAuthenticationToken:  telnyx.StringPtr("updatedtoken1234"),
 			Localization:           "US",
 			OutboundVoiceProfileID: runner.outboundVoiceProfileID,
 			T38ReinviteSource:      "customer",
-			EncryptedMedia:         "SRTP",
-			Timeout1xxSecs:         3,
-			Timeout2xxSecs:         90,
 		},
 	})
 	if err != nil {
@@ -429,7 +418,7 @@ func (runner *TestRunner) PerformUpdates() {
 		zap.Time("Updated At", updatedCredentialConnection.UpdatedAt))
 
 	// Update the FQDN Connection
-	updatedFQDNConnection, err := runner.client.UpdateFQDNConnection(runner.fqdnConnectionID, telnyx.FQDNConnection{
+	updatedFQDNConnection, err := runner.client.UpdateFQDNConnection(ctx, runner.fqdnConnectionID, telnyx.FQDNConnection{
 		Username:                         telnyx.StringPtr("updatedtest123456"),
 		Password:                         telnyx.StringPtr("updatedpasswordhello"),
 		Active:                           true,
@@ -456,34 +445,31 @@ func (runner *TestRunner) PerformUpdates() {
 			DNISNumberFormat:            "e164",
 			Codecs:                      []string{"G722", "G711U", "G711A", "G729", "OPUS", "H.264"},
 			DefaultRoutingMethod:        "sequential",
-			ChannelLimit:                10,
-			GenerateRingbackTone:        true,
-			ISUPHeadersEnabled:          true,
-			PRACKEnabled:                true,
-			PrivacyZoneEnabled:          true,
-			SIPCompactHeadersEnabled:    true,
+			ChannelLimit:                telnyx.IntPtr(10),
+			GenerateRingbackTone:        telnyx.BoolPtr(true),
+			ISUPHeadersEnabled:          telnyx.BoolPtr(true),
+			PRACKEnabled:                telnyx.BoolPtr(true),
+			PrivacyZoneEnabled:          telnyx.BoolPtr(true),
+			SIPCompactHeadersEnabled:    telnyx.BoolPtr(true),
 			SIPRegion:                   "US",
 			SIPSubdomain:                "updatedexample.sip.telnyx.com",
 			SIPSubdomainReceiveSettings: "only_my_connections",
-			Timeout1xxSecs:              3,
-			Timeout2xxSecs:              90,
-			ShakenSTIREnabled:           true,
+			Timeout1xxSecs:              telnyx.IntPtr(3),
+			Timeout2xxSecs:              telnyx.IntPtr(90),
+			ShakenSTIREnabled:           telnyx.BoolPtr(true),
 		},
 		Outbound: telnyx.OutboundSettings{
 			ANIOverride:            "+12345678901",
 			ANIOverrideType:        "always",
-			CallParkingEnabled:     true,
-			ChannelLimit:           10,
-			GenerateRingbackTone:   true,
-			InstantRingbackEnabled: false,
+			CallParkingEnabled:     telnyx.BoolPtr(true),
+			ChannelLimit:           telnyx.IntPtr(10),
+			GenerateRingbackTone:   telnyx.BoolPtr(true),
+			InstantRingbackEnabled: telnyx.BoolPtr(false),
 			IPAuthenticationMethod: "token",
-			IPAuthenticationToken:  "updatedtoken1234",
+			IPAuthenticationToken:  telnyx.StringPtr("updatedtoken1234"),
 			Localization:           "US",
 			OutboundVoiceProfileID: runner.outboundVoiceProfileID,
 			T38ReinviteSource:      "customer",
-			EncryptedMedia:         "SRTP",
-			Timeout1xxSecs:         3,
-			Timeout2xxSecs:         90,
 		},
 	})
 	if err != nil {
@@ -496,7 +482,7 @@ func (runner *TestRunner) PerformUpdates() {
 		zap.Time("Updated At", updatedFQDNConnection.UpdatedAt))
 
 	// Update the FQDN
-	updatedFQDN, err := runner.client.UpdateFQDN(runner.fqdnID, telnyx.FQDN{
+	updatedFQDN, err := runner.client.UpdateFQDN(ctx, runner.fqdnID, telnyx.FQDN{
 		ConnectionID:  runner.fqdnConnectionIDInt,
 		FQDN:          "updated.test.sip.livekit.cloud",
 		DNSRecordType: "a",
@@ -522,7 +508,7 @@ func (runner *TestRunner) PerformUpdates() {
 		},
 	}
 
-	updatedNumberOrder, err := runner.client.UpdateNumberOrder(runner.numberOrderID, updateRequest)
+	updatedNumberOrder, err := runner.client.UpdateNumberOrder(ctx, runner.numberOrderID, updateRequest)
 	if err != nil {
 		runner.logger.Error("Error updating number order", zap.Error(err))
 		os.Exit(1)
@@ -539,7 +525,7 @@ func (runner *TestRunner) PerformUpdates() {
 	// Update the Phone Number
 	phoneNumberUpdateRequest := telnyx.UpdatePhoneNumberRequest{
 		CustomerReference:  "Updated Test Number",
-		ConnectionID:       runner.fqdnConnectionIDInt,
+		ConnectionID:       runner.fqdnConnectionID,
 		BillingGroupID:     runner.billingGroupID,
 		Tags:               []string{"test", "updated"},
 		HDVoiceEnabled:     true,
@@ -547,7 +533,7 @@ func (runner *TestRunner) PerformUpdates() {
 		NumberLevelRouting: "ENABLED",
 	}
 
-	updatedPhoneNumber, err := runner.client.UpdatePhoneNumber(runner.phoneNumberID, phoneNumberUpdateRequest)
+	updatedPhoneNumber, err := runner.client.UpdatePhoneNumber(ctx, runner.phoneNumberID, phoneNumberUpdateRequest)
 	if err != nil {
 		runner.logger.Error("Error updating phone number", zap.Error(err))
 		os.Exit(1)
@@ -560,11 +546,11 @@ func (runner *TestRunner) PerformUpdates() {
 		zap.Time("UpdatedAt", updatedPhoneNumber.UpdatedAt))
 }
 
-func (runner *TestRunner) PerformCascadingDeletes() {
+func (runner *TestRunner) PerformCascadingDeletes(ctx context.Context) {
 	runner.logger.Info("Performing cascading delete operations")
 
 	// Delete the phone number
-	err := runner.client.DeletePhoneNumber(runner.phoneNumberID)
+	err := runner.client.DeletePhoneNumber(ctx, runner.phoneNumberID)
 	if err != nil {
 		runner.logger.Error("Error deleting phone number", zap.Error(err))
 		os.Exit(1)
@@ -572,7 +558,7 @@ func (runner *TestRunner) PerformCascadingDeletes() {
 	runner.logger.Info("Deleted Phone Number")
 
 	// Delete the FQDN
-	err = runner.client.DeleteFQDN(runner.fqdnID)
+	err = runner.client.DeleteFQDN(ctx, runner.fqdnID)
 	if err != nil {
 		runner.logger.Error("Error deleting FQDN", zap.Error(err))
 		os.Exit(1)
@@ -580,7 +566,7 @@ func (runner *TestRunner) PerformCascadingDeletes() {
 	runner.logger.Info("Deleted FQDN")
 
 	// Delete the Credential Connection
-	err = runner.client.DeleteCredentialConnection(runner.credentialConnectionID)
+	err = runner.client.DeleteCredentialConnection(ctx, runner.credentialConnectionID)
 	if err != nil {
 		runner.logger.Error("Error deleting credential connection", zap.Error(err))
 		os.Exit(1)
@@ -588,7 +574,7 @@ func (runner *TestRunner) PerformCascadingDeletes() {
 	runner.logger.Info("Deleted Credential Connection")
 
 	// Delete the FQDN Connection
-	err = runner.client.DeleteFQDNConnection(runner.fqdnConnectionID)
+	err = runner.client.DeleteFQDNConnection(ctx, runner.fqdnConnectionID)
 	if err != nil {
 		runner.logger.Error("Error deleting FQDN connection", zap.Error(err))
 		os.Exit(1)
@@ -596,7 +582,7 @@ func (runner *TestRunner) PerformCascadingDeletes() {
 	runner.logger.Info("Deleted FQDN Connection")
 
 	// Delete the Messaging Profile
-	err = runner.client.DeleteMessagingProfile(runner.messagingProfileID)
+	err = runner.client.DeleteMessagingProfile(ctx, runner.messagingProfileID)
 	if err != nil {
 		runner.logger.Error("Error deleting messaging profile", zap.Error(err))
 		os.Exit(1)
@@ -604,7 +590,7 @@ func (runner *TestRunner) PerformCascadingDeletes() {
 	runner.logger.Info("Deleted Messaging Profile")
 
 	// Delete the Outbound Voice Profile
-	err = runner.client.DeleteOutboundVoiceProfile(runner.outboundVoiceProfileID)
+	err = runner.client.DeleteOutboundVoiceProfile(ctx, runner.outboundVoiceProfileID)
 	if err != nil {
 		runner.logger.Error("Error deleting outbound voice profile", zap.Error(err))
 		os.Exit(1)
@@ -612,7 +598,7 @@ func (runner *TestRunner) PerformCascadingDeletes() {
 	runner.logger.Info("Deleted Outbound Voice Profile")
 
 	// Delete the Billing Group
-	err = runner.client.DeleteBillingGroup(runner.billingGroupID)
+	err = runner.client.DeleteBillingGroup(ctx, runner.billingGroupID)
 	if err != nil {
 		runner.logger.Error("Error deleting billing group", zap.Error(err))
 		os.Exit(1)
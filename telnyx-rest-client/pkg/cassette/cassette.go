@@ -0,0 +1,354 @@
+// Package cassette records HTTP interactions with the Telnyx API to a fixture
+// file and replays them later, so flows that normally need a live account
+// can run in CI without credentials while still seeing real payload shapes.
+//
+// Credentials never reach the fixture: the Authorization header and every
+// sensitive JSON field (passwords, tokens, secrets) are replaced with
+// redact.Placeholder before an interaction is written. Bodies that are not
+// JSON cannot be redacted field by field and are replaced whole.
+//
+// A client only uses a cassette it is given explicitly:
+//
+//	recorder, err := cassette.Load("testdata/resources.json", cassette.ModeReplay)
+//	client, err := telnyx.NewClient(telnyx.WithCassette(recorder))
+//
+// Programs that want to offer recording and replay, such as the Terraform
+// provider and the test runner, call FromEnv to load the cassette named by
+// the environment:
+//
+//	TELNYX_REST_CLIENT_CASSETTE=testdata/resources.json
+//	TELNYX_REST_CLIENT_CASSETTE_MODE=record   # or replay, the default
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/petsinc/telnyx-rest-client/internal/redact"
+)
+
+// Mode selects whether a cassette talks to the network.
+type Mode int
+
+const (
+	// ModeReplay answers every request from the fixture file and fails
+	// requests that were never recorded. Nothing is sent over the network.
+	ModeReplay Mode = iota
+	// ModeRecord sends requests through the wrapped transport and writes
+	// each interaction to the fixture file, replacing its previous contents.
+	ModeRecord
+)
+
+// String returns the name accepted by ParseMode.
+func (mode Mode) String() string {
+	switch mode {
+	case ModeReplay:
+		return "replay"
+	case ModeRecord:
+		return "record"
+	default:
+		return fmt.Sprintf("Mode(%d)", int(mode))
+	}
+}
+
+// ParseMode parses "replay" or "record", case-insensitively. An empty value
+// means replay.
+func ParseMode(value string) (Mode, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "replay":
+		return ModeReplay, nil
+	case "record":
+		return ModeRecord, nil
+	default:
+		return ModeReplay, fmt.Errorf("cassette: invalid mode %q, expected replay or record", value)
+	}
+}
+
+// Interaction is one recorded request and the response it received.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is the part of a request used to match it on replay. URI is the
+// path and query, without scheme and host, so a cassette recorded against
+// one base URL replays against another with the same path prefix.
+type Request struct {
+	Method string          `json:"method"`
+	URI    string          `json:"uri"`
+	Body   json.RawMessage `json:"body,omitempty"`
+	Text   string          `json:"text,omitempty"`
+}
+
+// Response is a recorded response. JSON bodies are kept as JSON so fixtures
+// stay readable; anything else is recorded as redact.Placeholder in Text.
+type Response struct {
+	StatusCode int             `json:"status_code"`
+	Header     http.Header     `json:"header,omitempty"`
+	Body       json.RawMessage `json:"body,omitempty"`
+	Text       string          `json:"text,omitempty"`
+}
+
+type file struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Cassette is a fixture file shared by every transport that uses it.
+type Cassette struct {
+	path string
+	mode Mode
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	used         []bool
+	// secrets holds sensitive values sent in request bodies during replay,
+	// by record ID and field path, so responses that were redacted when
+	// recorded can echo them back as the real API would.
+	secrets map[string]map[string]interface{}
+}
+
+var (
+	openMu sync.Mutex
+	opened = map[string]*Cassette{}
+)
+
+// Load opens the cassette at path. Every call with the same path and mode in
+// a process returns the same Cassette, so the many clients a Terraform run
+// creates share one recording instead of overwriting each other. In replay
+// mode the file must exist; in record mode it is created or truncated on
+// the first recorded interaction.
+func Load(path string, mode Mode) (*Cassette, error) {
+	absolute, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("cassette: %w", err)
+	}
+	key := mode.String() + ":" + absolute
+
+	openMu.Lock()
+	defer openMu.Unlock()
+	if existing, ok := opened[key]; ok {
+		return existing, nil
+	}
+
+	cassette := &Cassette{path: absolute, mode: mode, secrets: map[string]map[string]interface{}{}}
+	if mode == ModeReplay {
+		data, err := os.ReadFile(absolute)
+		if err != nil {
+			return nil, fmt.Errorf("cassette: reading %s: %w", path, err)
+		}
+		var contents file
+		if err := json.Unmarshal(data, &contents); err != nil {
+			return nil, fmt.Errorf("cassette: parsing %s: %w", path, err)
+		}
+		cassette.interactions = contents.Interactions
+		cassette.used = make([]bool, len(contents.Interactions))
+	}
+	opened[key] = cassette
+	return cassette, nil
+}
+
+// Environment variables read by FromEnv.
+const (
+	EnvPath = "TELNYX_REST_CLIENT_CASSETTE"
+	EnvMode = "TELNYX_REST_CLIENT_CASSETTE_MODE"
+)
+
+// FromEnv loads the cassette named by TELNYX_REST_CLIENT_CASSETTE, in the
+// mode named by TELNYX_REST_CLIENT_CASSETTE_MODE. It returns nil when no
+// cassette is set.
+func FromEnv() (*Cassette, error) {
+	path := os.Getenv(EnvPath)
+	if path == "" {
+		return nil, nil
+	}
+	mode, err := ParseMode(os.Getenv(EnvMode))
+	if err != nil {
+		return nil, err
+	}
+	return Load(path, mode)
+}
+
+// Mode reports whether the cassette records or replays.
+func (cassette *Cassette) Mode() Mode {
+	return cassette.mode
+}
+
+// Transport returns a RoundTripper backed by the cassette. In record mode
+// requests are sent through next, or http.DefaultTransport when next is nil;
+// in replay mode next is never used.
+func (cassette *Cassette) Transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &transport{cassette: cassette, next: next}
+}
+
+type transport struct {
+	cassette *Cassette
+	next     http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	if t.cassette.mode == ModeReplay {
+		return t.cassette.replay(req, body)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	if err := t.cassette.record(newRequest(req, body), newResponse(req, resp, respBody)); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func newRequest(req *http.Request, body []byte) Request {
+	recorded := Request{Method: req.Method, URI: req.URL.RequestURI()}
+	recorded.Body, recorded.Text = sanitizeBody(body)
+	if len(body) > 0 && redact.IsMultipart(req.Header) {
+		recorded.Body, recorded.Text = nil, redact.Multipart(body)
+	}
+	return recorded
+}
+
+func newResponse(req *http.Request, resp *http.Response, body []byte) Response {
+	recorded := Response{StatusCode: resp.StatusCode, Header: redact.Headers(resp.Header)}
+	recorded.Body, recorded.Text = sanitizeBody(body)
+	if resp.StatusCode < 400 && redact.IsSecretResponse(req.URL.Path) {
+		recorded.Body, recorded.Text = nil, redact.Placeholder
+	}
+	return recorded
+}
+
+// sanitizeBody redacts a JSON body and returns it in compact form. Any other
+// body may hold a secret that cannot be found, so it is returned as
+// redact.Placeholder text instead.
+func sanitizeBody(body []byte) (json.RawMessage, string) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil, ""
+	}
+	if !json.Valid(body) {
+		return nil, redact.Placeholder
+	}
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, redact.JSON(body)); err != nil {
+		return nil, redact.Placeholder
+	}
+	return compact.Bytes(), ""
+}
+
+func (cassette *Cassette) record(req Request, resp Response) error {
+	cassette.mu.Lock()
+	defer cassette.mu.Unlock()
+
+	cassette.interactions = append(cassette.interactions, &Interaction{Request: req, Response: resp})
+	data, err := json.MarshalIndent(file{Interactions: cassette.interactions}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("cassette: encoding %s: %w", cassette.path, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(cassette.path), 0o755); err != nil {
+		return fmt.Errorf("cassette: %w", err)
+	}
+	// Written after every interaction so a run that is interrupted still
+	// leaves a usable prefix behind.
+	if err := os.WriteFile(cassette.path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("cassette: writing %s: %w", cassette.path, err)
+	}
+	return nil
+}
+
+// ErrNoInteraction is returned on replay for a request the cassette does not
+// contain, or once every matching interaction has been used.
+var ErrNoInteraction = errors.New("cassette: no recorded interaction")
+
+// replay answers req with the first unused interaction that has the same
+// method, URI and redacted body. Matching on content rather than position
+// lets concurrent requests, such as Terraform creating independent
+// resources in parallel, replay in a different order than they were
+// recorded.
+func (cassette *Cassette) replay(req *http.Request, body []byte) (*http.Response, error) {
+	want := newRequest(req, body)
+
+	cassette.mu.Lock()
+	defer cassette.mu.Unlock()
+
+	for i, interaction := range cassette.interactions {
+		if cassette.used[i] || !interaction.Request.matches(want) {
+			continue
+		}
+		cassette.used[i] = true
+
+		respBody := []byte(interaction.Response.Text)
+		if len(interaction.Response.Body) > 0 {
+			respBody = cassette.restoreSecrets(body, interaction.Response.Body)
+		}
+		header := interaction.Response.Header.Clone()
+		if header == nil {
+			header = http.Header{}
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(respBody)),
+			ContentLength: int64(len(respBody)),
+			Request:       req,
+		}, nil
+	}
+
+	detail := want.Text
+	if len(want.Body) > 0 {
+		detail = string(want.Body)
+	}
+	return nil, fmt.Errorf("%w for %s %s with body %s in %s", ErrNoInteraction, want.Method, want.URI, detail, cassette.path)
+}
+
+func (recorded Request) matches(other Request) bool {
+	return recorded.Method == other.Method &&
+		recorded.URI == other.URI &&
+		recorded.Text == other.Text &&
+		jsonEqual(recorded.Body, other.Body)
+}
+
+func jsonEqual(a, b json.RawMessage) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+	var left, right interface{}
+	if decodeJSON(a, &left) != nil || decodeJSON(b, &right) != nil {
+		return bytes.Equal(a, b)
+	}
+	leftJSON, _ := json.Marshal(left)
+	rightJSON, _ := json.Marshal(right)
+	return bytes.Equal(leftJSON, rightJSON)
+}
@@ -0,0 +1,163 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// send makes a request through transport and returns the response body.
+func send(t *testing.T, transport http.RoundTripper, method, url, body string) (string, error) {
+	t.Helper()
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer api-key-secret")
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := (&http.Client{Transport: transport}).Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data), nil
+}
+
+func TestRecordRedactsCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/credential_connections":
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("Set-Cookie", "session=cookie-secret")
+			io.WriteString(w, `{"data":{"id":"1","password":"response-password","outbound":{"token":"response-token"},"keys":[{"v1_secret":"response-v1-secret"}]}}`)
+		case "/telephony_credentials/1/token":
+			io.WriteString(w, "bare-token-secret")
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+			io.WriteString(w, "<html>upstream said password=html-secret</html>")
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "record.json")
+	recorder, err := Load(path, ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport := recorder.Transport(nil)
+
+	requests := []struct{ method, path, body string }{
+		{http.MethodPost, "/credential_connections", `{"connection_name":"test","password":"request-password","token":"request-token","v1_secret":"request-v1-secret"}`},
+		{http.MethodPost, "/telephony_credentials/1/token", ""},
+		{http.MethodPost, "/broken", "password=form-secret"},
+	}
+	for _, request := range requests {
+		if _, err := send(t, transport, request.method, server.URL+request.path, request.body); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fixture := string(data)
+	for _, secret := range []string{
+		"api-key-secret", "cookie-secret",
+		"request-password", "request-token", "request-v1-secret",
+		"response-password", "response-token", "response-v1-secret",
+		"bare-token-secret", "html-secret", "form-secret",
+	} {
+		if strings.Contains(fixture, secret) {
+			t.Errorf("fixture contains %q:\n%s", secret, fixture)
+		}
+	}
+	if !strings.Contains(fixture, `"connection_name": "test"`) {
+		t.Errorf("fixture lost the non-sensitive request fields:\n%s", fixture)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"data":{"id":"`+strings.TrimPrefix(r.URL.Path, "/billing_groups/")+`"}}`)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "replay.json")
+	recorder, err := Load(path, ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"first", "second"} {
+		if _, err := send(t, recorder.Transport(nil), http.MethodGet, server.URL+"/billing_groups/"+id, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	server.Close()
+
+	player, err := Load(path, ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport := player.Transport(nil)
+
+	// Replayed against another host, in the opposite order
+	for _, id := range []string{"second", "first"} {
+		body, err := send(t, transport, http.MethodGet, "http://replay.invalid/billing_groups/"+id, "")
+		if err != nil {
+			t.Fatalf("replaying %s: %v", id, err)
+		}
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, []byte(body)); err != nil {
+			t.Fatalf("replaying %s: %v", id, err)
+		}
+		if want := `{"data":{"id":"` + id + `"}}`; compact.String() != want {
+			t.Errorf("replaying %s: got %s, want %s", id, compact.String(), want)
+		}
+	}
+
+	if _, err := send(t, transport, http.MethodGet, "http://replay.invalid/billing_groups/first", ""); !errors.Is(err, ErrNoInteraction) {
+		t.Errorf("replaying a used interaction: expected ErrNoInteraction, got %v", err)
+	}
+	if _, err := send(t, transport, http.MethodGet, "http://replay.invalid/billing_groups/third", ""); !errors.Is(err, ErrNoInteraction) {
+		t.Errorf("replaying an unrecorded request: expected ErrNoInteraction, got %v", err)
+	}
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv(EnvPath, "")
+	if recording, err := FromEnv(); recording != nil || err != nil {
+		t.Errorf("FromEnv() without a cassette = %v, %v, want nil, nil", recording, err)
+	}
+
+	path := filepath.Join(t.TempDir(), "env.json")
+	t.Setenv(EnvPath, path)
+	t.Setenv(EnvMode, "record")
+	recording, err := FromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recording.Mode() != ModeRecord {
+		t.Errorf("FromEnv() mode = %s, want record", recording.Mode())
+	}
+
+	t.Setenv(EnvMode, "rewind")
+	if _, err := FromEnv(); err == nil {
+		t.Error("FromEnv() accepted an invalid mode")
+	}
+	t.Setenv(EnvMode, "")
+	if _, err := FromEnv(); err == nil {
+		t.Error("FromEnv() replayed a fixture that does not exist")
+	}
+}
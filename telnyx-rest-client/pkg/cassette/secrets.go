@@ -0,0 +1,121 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/petsinc/telnyx-rest-client/internal/redact"
+)
+
+// restoreSecrets puts sensitive values back into a replayed response body.
+//
+// Fixtures only hold redact.Placeholder for fields such as a connection's
+// password, but callers like the Terraform provider read those fields back
+// and expect to see what they sent. Values from the request body are
+// remembered under the ID of the record the response returns, and filled in
+// wherever that record later comes back with a placeholder. Values the API
+// generates itself, such as a messaging profile's v1_secret, were never sent
+// and stay redacted.
+//
+// The caller must hold cassette.mu.
+func (cassette *Cassette) restoreSecrets(reqBody []byte, respBody json.RawMessage) []byte {
+	var resp map[string]interface{}
+	if decodeJSON(respBody, &resp) != nil {
+		return respBody
+	}
+
+	sent := map[string]interface{}{}
+	var req interface{}
+	if decodeJSON(reqBody, &req) == nil {
+		collectSecrets(req, "", sent)
+	}
+
+	var records []map[string]interface{}
+	switch data := resp["data"].(type) {
+	case map[string]interface{}:
+		records = append(records, data)
+		if id, ok := data["id"].(string); ok && len(sent) > 0 {
+			known := cassette.secrets[id]
+			if known == nil {
+				known = map[string]interface{}{}
+				cassette.secrets[id] = known
+			}
+			for path, value := range sent {
+				known[path] = value
+			}
+		}
+	case []interface{}:
+		for _, item := range data {
+			if record, ok := item.(map[string]interface{}); ok {
+				records = append(records, record)
+			}
+		}
+	}
+
+	restored := false
+	for _, record := range records {
+		id, _ := record["id"].(string)
+		for path, value := range cassette.secrets[id] {
+			if setPlaceholder(record, strings.Split(path, "."), value) {
+				restored = true
+			}
+		}
+	}
+	if !restored {
+		return respBody
+	}
+	body, err := json.Marshal(resp)
+	if err != nil {
+		return respBody
+	}
+	return body
+}
+
+// collectSecrets records the value of every sensitive field in value by its
+// dotted path. Arrays are not descended into, since their elements cannot be
+// matched up with a response reliably.
+func collectSecrets(value interface{}, prefix string, secrets map[string]interface{}) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return
+	}
+	for key, field := range object {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		if redact.IsSensitiveField(key) {
+			if field != nil {
+				secrets[path] = field
+			}
+			continue
+		}
+		collectSecrets(field, path, secrets)
+	}
+}
+
+// setPlaceholder replaces the value at path with value when it is currently
+// the redaction placeholder.
+func setPlaceholder(object map[string]interface{}, path []string, value interface{}) bool {
+	if len(path) == 1 {
+		if object[path[0]] != redact.Placeholder {
+			return false
+		}
+		object[path[0]] = value
+		return true
+	}
+	child, ok := object[path[0]].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	return setPlaceholder(child, path[1:], value)
+}
+
+// decodeJSON decodes data keeping numbers exact, so 19 digit IDs survive a
+// round trip.
+func decodeJSON(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
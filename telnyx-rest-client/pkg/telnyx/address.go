@@ -0,0 +1,61 @@
+package telnyx
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+func (client *TelnyxClient) CreateAddress(ctx context.Context, request CreateAddressRequest) (*Address, error) {
+	var result struct {
+		Data Address `json:"data"`
+	}
+	err := client.doRequest(ctx, "POST", "/addresses", request, &result)
+	if err != nil {
+		client.logger.Error("Error creating address", zap.Error(err))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+func (client *TelnyxClient) GetAddress(ctx context.Context, addressID string) (*Address, error) {
+	var result struct {
+		Data Address `json:"data"`
+	}
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/addresses/%s", addressID), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+// DeleteAddress deletes an address. Telnyx refuses to delete an address that
+// is still the emergency address of a phone number.
+func (client *TelnyxClient) DeleteAddress(ctx context.Context, addressID string) error {
+	return client.doRequest(ctx, "DELETE", fmt.Sprintf("/addresses/%s", addressID), nil, nil)
+}
+
+// ValidateAddress checks an address without creating it, returning Telnyx's
+// verdict and suggested corrections.
+func (client *TelnyxClient) ValidateAddress(ctx context.Context, request ValidateAddressRequest) (*AddressValidation, error) {
+	var result struct {
+		Data AddressValidation `json:"data"`
+	}
+	err := client.doRequest(ctx, "POST", "/addresses/actions/validate", request, &result)
+	if err != nil {
+		client.logger.Error("Error validating address", zap.Error(err))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+// ListAddresses returns all addresses, following pagination.
+func (client *TelnyxClient) ListAddresses(ctx context.Context, opts *ListOptions) ([]Address, error) {
+	return listAll(client.IterateAddresses(ctx, opts))
+}
+
+// IterateAddresses returns an Iterator over addresses that fetches pages on demand.
+func (client *TelnyxClient) IterateAddresses(ctx context.Context, opts *ListOptions) *Iterator[Address] {
+	return newIterator[Address](ctx, client, "/addresses", opts)
+}
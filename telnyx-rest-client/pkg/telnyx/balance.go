@@ -0,0 +1,18 @@
+package telnyx
+
+import (
+	"context"
+)
+
+// GetBalance retrieves the account balance. It is a cheap authenticated call,
+// which makes it useful for checking that an API key is valid.
+func (client *TelnyxClient) GetBalance(ctx context.Context) (*Balance, error) {
+	var result struct {
+		Data Balance `json:"data"`
+	}
+	err := client.doRequest(ctx, "GET", "/balance", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Data, nil
+}
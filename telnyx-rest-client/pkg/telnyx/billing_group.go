@@ -1,44 +1,55 @@
 package telnyx
 
 import (
+	"context"
 	"fmt"
 )
 
-func (client *TelnyxClient) CreateBillingGroup(name string) (*BillingGroup, error) {
+func (client *TelnyxClient) CreateBillingGroup(ctx context.Context, name string) (*BillingGroup, error) {
 	request := CreateBillingGroupRequest{Name: name}
 	var result struct {
 		Data BillingGroup `json:"data"`
 	}
-	err := client.doRequest("POST", "/billing_groups", request, &result)
+	err := client.doRequest(ctx, "POST", "/billing_groups", request, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result.Data, nil
 }
 
-func (client *TelnyxClient) UpdateBillingGroup(billingGroupID, name string) (*BillingGroup, error) {
+func (client *TelnyxClient) UpdateBillingGroup(ctx context.Context, billingGroupID, name string) (*BillingGroup, error) {
 	request := UpdateBillingGroupRequest{Name: name}
 	var result struct {
 		Data BillingGroup `json:"data"`
 	}
-	err := client.doRequest("PATCH", fmt.Sprintf("/billing_groups/%s", billingGroupID), request, &result)
+	err := client.doRequest(ctx, "PATCH", fmt.Sprintf("/billing_groups/%s", billingGroupID), request, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result.Data, nil
 }
 
-func (client *TelnyxClient) DeleteBillingGroup(billingGroupID string) error {
-	return client.doRequest("DELETE", fmt.Sprintf("/billing_groups/%s", billingGroupID), nil, nil)
+func (client *TelnyxClient) DeleteBillingGroup(ctx context.Context, billingGroupID string) error {
+	return client.doRequest(ctx, "DELETE", fmt.Sprintf("/billing_groups/%s", billingGroupID), nil, nil)
 }
 
-func (client *TelnyxClient) GetBillingGroup(billingGroupID string) (*BillingGroup, error) {
+func (client *TelnyxClient) GetBillingGroup(ctx context.Context, billingGroupID string) (*BillingGroup, error) {
 	var result struct {
 		Data BillingGroup `json:"data"`
 	}
-	err := client.doRequest("GET", fmt.Sprintf("/billing_groups/%s", billingGroupID), nil, &result)
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/billing_groups/%s", billingGroupID), nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result.Data, nil
 }
+
+// ListBillingGroups returns all billing groups, following pagination.
+func (client *TelnyxClient) ListBillingGroups(ctx context.Context, opts *ListOptions) ([]BillingGroup, error) {
+	return listAll(client.IterateBillingGroups(ctx, opts))
+}
+
+// IterateBillingGroups returns an Iterator over billing groups that fetches pages on demand.
+func (client *TelnyxClient) IterateBillingGroups(ctx context.Context, opts *ListOptions) *Iterator[BillingGroup] {
+	return newIterator[BillingGroup](ctx, client, "/billing_groups", opts)
+}
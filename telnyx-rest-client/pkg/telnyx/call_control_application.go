@@ -1,17 +1,18 @@
 package telnyx
 
 import (
+	"context"
 	"fmt"
 
 	"go.uber.org/zap"
 )
 
 // CreateCallControlApplication creates a new Call Control Application.
-func (client *TelnyxClient) CreateCallControlApplication(request CallControlApplicationRequest) (*CallControlApplication, error) {
+func (client *TelnyxClient) CreateCallControlApplication(ctx context.Context, request CallControlApplicationRequest) (*CallControlApplication, error) {
 	var result struct {
 		Data CallControlApplication `json:"data"`
 	}
-	err := client.doRequest("POST", "/call_control_applications", request, &result)
+	err := client.doRequest(ctx, "POST", "/call_control_applications", request, &result)
 	if err != nil {
 		client.logger.Error("Error creating Call Control Application", zap.Error(err))
 		return nil, err
@@ -20,11 +21,11 @@ func (client *TelnyxClient) CreateCallControlApplication(request CallControlAppl
 }
 
 // GetCallControlApplication retrieves a Call Control Application by ID.
-func (client *TelnyxClient) GetCallControlApplication(applicationID string) (*CallControlApplication, error) {
+func (client *TelnyxClient) GetCallControlApplication(ctx context.Context, applicationID string) (*CallControlApplication, error) {
 	var result struct {
 		Data CallControlApplication `json:"data"`
 	}
-	err := client.doRequest("GET", fmt.Sprintf("/call_control_applications/%s", applicationID), nil, &result)
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/call_control_applications/%s", applicationID), nil, &result)
 	if err != nil {
 		client.logger.Error("Error fetching Call Control Application", zap.Error(err), zap.String("applicationID", applicationID))
 		return nil, err
@@ -33,11 +34,11 @@ func (client *TelnyxClient) GetCallControlApplication(applicationID string) (*Ca
 }
 
 // UpdateCallControlApplication updates an existing Call Control Application.
-func (client *TelnyxClient) UpdateCallControlApplication(applicationID string, request CallControlApplicationRequest) (*CallControlApplication, error) {
+func (client *TelnyxClient) UpdateCallControlApplication(ctx context.Context, applicationID string, request CallControlApplicationRequest) (*CallControlApplication, error) {
 	var result struct {
 		Data CallControlApplication `json:"data"`
 	}
-	err := client.doRequest("PATCH", fmt.Sprintf("/call_control_applications/%s", applicationID), request, &result)
+	err := client.doRequest(ctx, "PATCH", fmt.Sprintf("/call_control_applications/%s", applicationID), request, &result)
 	if err != nil {
 		client.logger.Error("Error updating Call Control Application", zap.Error(err), zap.String("applicationID", applicationID))
 		return nil, err
@@ -46,23 +47,20 @@ func (client *TelnyxClient) UpdateCallControlApplication(applicationID string, r
 }
 
 // DeleteCallControlApplication deletes a Call Control Application.
-func (client *TelnyxClient) DeleteCallControlApplication(applicationID string) error {
-	err := client.doRequest("DELETE", fmt.Sprintf("/call_control_applications/%s", applicationID), nil, nil)
+func (client *TelnyxClient) DeleteCallControlApplication(ctx context.Context, applicationID string) error {
+	err := client.doRequest(ctx, "DELETE", fmt.Sprintf("/call_control_applications/%s", applicationID), nil, nil)
 	if err != nil {
 		client.logger.Error("Error deleting Call Control Application", zap.Error(err), zap.String("applicationID", applicationID))
 	}
 	return err
 }
 
-// ListCallControlApplications lists all Call Control Applications.
-func (client *TelnyxClient) ListCallControlApplications() ([]CallControlApplication, error) {
-	var result struct {
-		Data []CallControlApplication `json:"data"`
-	}
-	err := client.doRequest("GET", "/call_control_applications", nil, &result)
-	if err != nil {
-		client.logger.Error("Error listing Call Control Applications", zap.Error(err))
-		return nil, err
-	}
-	return result.Data, nil
+// ListCallControlApplications returns all Call Control Applications, following pagination.
+func (client *TelnyxClient) ListCallControlApplications(ctx context.Context, opts *ListOptions) ([]CallControlApplication, error) {
+	return listAll(client.IterateCallControlApplications(ctx, opts))
+}
+
+// IterateCallControlApplications returns an Iterator over Call Control Applications that fetches pages on demand.
+func (client *TelnyxClient) IterateCallControlApplications(ctx context.Context, opts *ListOptions) *Iterator[CallControlApplication] {
+	return newIterator[CallControlApplication](ctx, client, "/call_control_applications", opts)
 }
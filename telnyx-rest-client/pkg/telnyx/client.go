@@ -2,35 +2,152 @@ package telnyx
 
 import (
 	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io/ioutil"
+	"io"
+	"mime/multipart"
 	"net/http"
+	"net/url"
 	"os"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/petsinc/telnyx-rest-client/internal/redact"
+	"github.com/petsinc/telnyx-rest-client/pkg/cassette"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
 
+// ErrMissingAPIKey is returned by NewClient when no API key was supplied
+// through WithAPIKey or the TELNYX_API_KEY environment variable.
+var ErrMissingAPIKey = errors.New("telnyx: an API key is required, set TELNYX_API_KEY or use WithAPIKey")
+
 type TelnyxClient struct {
-	apiKey  string
-	baseURL string
-	logger  *zap.Logger
+	apiKey      string
+	baseURL     string
+	userAgent   string
+	httpClient  *http.Client
+	retryPolicy RetryPolicy
+	rateLimiter *rateLimiter
+	logger      *zap.Logger
+
+	httpDebug       HTTPDebugLevel
+	httpDebugFunc   HTTPDebugFunc
+	httpDebugLogger *zap.Logger
 }
 
-func NewClient() *TelnyxClient {
-	apiKey := os.Getenv("TELNYX_API_KEY")
-	if apiKey == "" {
-		panic("TELNYX_API_KEY environment variable must be set")
+// NewClient builds a TelnyxClient from the given options. Settings that are
+// not supplied fall back to the environment and package defaults.
+func NewClient(opts ...Option) (*TelnyxClient, error) {
+	httpDebug, err := ParseHTTPDebugLevel(os.Getenv("TELNYX_REST_CLIENT_HTTP_DEBUG"))
+	if err != nil {
+		return nil, err
+	}
+
+	config := clientConfig{
+		apiKey:     os.Getenv("TELNYX_API_KEY"),
+		baseURL:    DefaultBaseURL,
+		userAgent:  DefaultUserAgent,
+		maxRetries: DefaultMaxRetries,
+		minBackoff: DefaultMinRetryBackoff,
+		maxBackoff: DefaultMaxRetryBackoff,
+		httpDebug:  httpDebug,
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	if config.apiKey == "" {
+		// A replayed run never reaches Telnyx, so it needs no credentials
+		if config.cassette == nil || config.cassette.Mode() != cassette.ModeReplay {
+			return nil, ErrMissingAPIKey
+		}
+		config.apiKey = redact.Placeholder
+	}
+	if config.maxRetries < 0 {
+		return nil, fmt.Errorf("telnyx: max retries must not be negative, got %d", config.maxRetries)
+	}
+	if config.minBackoff <= 0 || config.maxBackoff < config.minBackoff {
+		return nil, fmt.Errorf("telnyx: invalid retry backoff, min %s must be positive and not exceed max %s", config.minBackoff, config.maxBackoff)
+	}
+
+	baseURL, err := url.Parse(config.baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("telnyx: invalid base URL %q: %w", config.baseURL, err)
+	}
+	if baseURL.Scheme == "" || baseURL.Host == "" {
+		return nil, fmt.Errorf("telnyx: invalid base URL %q: scheme and host are required", config.baseURL)
 	}
 
-	logLevel := getLogLevelFromEnv()
-	config := zap.NewProductionConfig()
-	config.Level = zap.NewAtomicLevelAt(logLevel)
-	logger, _ := config.Build()
+	httpClient := &http.Client{}
+	if config.httpClient != nil {
+		clientCopy := *config.httpClient
+		httpClient = &clientCopy
+	}
+	if config.transport != nil {
+		httpClient.Transport = config.transport
+	}
+	if config.timeout > 0 {
+		httpClient.Timeout = config.timeout
+	}
+	if config.cassette != nil {
+		httpClient.Transport = config.cassette.Transport(httpClient.Transport)
+	}
 
-	return &TelnyxClient{apiKey: apiKey, baseURL: "https://api.telnyx.com/v2", logger: logger}
+	logger, httpDebugLogger := config.logger, config.logger
+	if logger == nil {
+		logConfig := zap.NewProductionConfig()
+		logConfig.Level = zap.NewAtomicLevelAt(getLogLevelFromEnv())
+		logger, err = logConfig.Build()
+		if err != nil {
+			return nil, fmt.Errorf("telnyx: building logger: %w", err)
+		}
+
+		// HTTP tracing is switched on separately, so it must not be hidden
+		// by a quieter TELNYX_REST_CLIENT_LOG_LEVEL.
+		logConfig.Level = zap.NewAtomicLevelAt(zapcore.InfoLevel)
+		httpDebugLogger, err = logConfig.Build()
+		if err != nil {
+			return nil, fmt.Errorf("telnyx: building logger: %w", err)
+		}
+	}
+
+	if config.rateLimit.Rate < 0 {
+		return nil, fmt.Errorf("telnyx: rate limit must not be negative, got %g", config.rateLimit.Rate)
+	}
+	for family, limit := range config.endpointRateLimits {
+		if limit.Rate < 0 {
+			return nil, fmt.Errorf("telnyx: rate limit for %q must not be negative, got %g", family, limit.Rate)
+		}
+	}
+
+	retryPolicy := config.retryPolicy
+	if retryPolicy == nil {
+		retryPolicy = DefaultRetryPolicy{
+			MaxRetries: config.maxRetries,
+			MinBackoff: config.minBackoff,
+			MaxBackoff: config.maxBackoff,
+		}
+	}
+
+	return &TelnyxClient{
+		apiKey:      config.apiKey,
+		baseURL:     strings.TrimRight(config.baseURL, "/"),
+		userAgent:   config.userAgent,
+		httpClient:  httpClient,
+		retryPolicy: retryPolicy,
+		rateLimiter: newRateLimiter(config.rateLimit, config.endpointRateLimits),
+		logger:      logger,
+
+		httpDebug:       config.httpDebug,
+		httpDebugFunc:   config.httpDebugFunc,
+		httpDebugLogger: httpDebugLogger,
+	}, nil
 }
 
 func getLogLevelFromEnv() zapcore.Level {
@@ -49,7 +166,10 @@ func getLogLevelFromEnv() zapcore.Level {
 	}
 }
 
-func (client *TelnyxClient) doRequest(method, path string, body interface{}, v interface{}) error {
+// doRequest sends body as JSON and decodes the response into v. A *[]byte v
+// receives the raw response body instead, for the few endpoints that do not
+// answer with JSON.
+func (client *TelnyxClient) doRequest(ctx context.Context, method, path string, body interface{}, v interface{}) error {
 	var bodyBytes []byte
 	var err error
 	if body != nil {
@@ -58,86 +178,143 @@ func (client *TelnyxClient) doRequest(method, path string, body interface{}, v i
 			client.logger.Error("Error encoding request body", zap.Error(err))
 			return err
 		}
-		err := PrettyPrintRequestBody(body)
-		if err != nil {
-			return err
-		}
 	}
 
-	return client.retryRequest(method, path, bodyBytes, v)
+	return client.retryRequest(ctx, method, path, "application/json", bodyBytes, v)
 }
 
-func (client *TelnyxClient) retryRequest(method, path string, bodyBytes []byte, v interface{}) error {
-	retryAttempts := 5
-	var lastErr error
-
-	for attempt := 0; attempt < retryAttempts; attempt++ {
-		if attempt > 0 {
-			waitTime := time.Duration(attempt*attempt) * time.Second // exponential backoff
-			client.logger.Info("Retrying request", zap.Int("attempt", attempt), zap.Duration("wait_time", waitTime))
-			time.Sleep(waitTime)
-		}
+// doMultipartRequest uploads content as the file part of a multipart form,
+// along with the given text fields, and decodes the response into v. The
+// boundary is derived from the content, so the same upload always produces
+// the same body and can be replayed from a cassette.
+func (client *TelnyxClient) doMultipartRequest(ctx context.Context, method, path string, fields map[string]string, fileField, filename string, content []byte, v interface{}) error {
+	digest := sha256.Sum256(content)
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	if err := writer.SetBoundary(hex.EncodeToString(digest[:16])); err != nil {
+		client.logger.Error("Error encoding request body", zap.Error(err))
+		return err
+	}
 
-		req, err := http.NewRequest(method, client.baseURL+path, bytes.NewReader(bodyBytes))
-		if err != nil {
-			client.logger.Error("Error creating request", zap.Error(err))
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := writer.WriteField(name, fields[name]); err != nil {
+			client.logger.Error("Error encoding request body", zap.Error(err))
 			return err
 		}
+	}
+	part, err := writer.CreateFormFile(fileField, filename)
+	if err == nil {
+		_, err = part.Write(content)
+	}
+	if err == nil {
+		err = writer.Close()
+	}
+	if err != nil {
+		client.logger.Error("Error encoding request body", zap.Error(err))
+		return err
+	}
 
-		req.Header.Set("Content-Type", "application/json")
-		req.Header.Set("Authorization", "Bearer "+client.apiKey)
+	return client.retryRequest(ctx, method, path, writer.FormDataContentType(), body.Bytes(), v)
+}
 
-		resp, err := http.DefaultClient.Do(req)
-		if err != nil {
-			client.logger.Error("Error making request", zap.Error(err))
+func (client *TelnyxClient) retryRequest(ctx context.Context, method, path, contentType string, bodyBytes []byte, v interface{}) error {
+	for attempt := 1; ; attempt++ {
+		if err := client.rateLimiter.wait(ctx, path); err != nil {
+			client.logger.Warn("Request cancelled while waiting for rate limiter", zap.String("path", path), zap.Error(err))
 			return err
 		}
-		defer resp.Body.Close()
 
-		respBody, err := ioutil.ReadAll(resp.Body)
+		req, err := http.NewRequestWithContext(ctx, method, client.baseURL+path, bytes.NewReader(bodyBytes))
 		if err != nil {
-			client.logger.Error("Error reading response body", zap.Error(err))
+			client.logger.Error("Error creating request", zap.Error(err))
 			return err
 		}
 
-		err = PrettyPrintResponseBody(respBody)
-		if err != nil {
-			return err
-		}
+		req.Header.Set("Content-Type", contentType)
+		req.Header.Set("Authorization", "Bearer "+client.apiKey)
+		req.Header.Set("User-Agent", client.userAgent)
 
-		if resp.StatusCode == 429 {
-			client.logger.Warn("Received 429 Too Many Requests", zap.Int("status_code", resp.StatusCode), zap.String("response", string(respBody)))
-			lastErr = fmt.Errorf("received 429 Too Many Requests: %s", string(respBody))
-			continue
+		resp, respBody, err := client.send(req, bodyBytes, attempt)
+		if resp != nil {
+			client.rateLimiter.observe(path, resp)
 		}
-
-		if resp.StatusCode >= 400 {
-			client.logger.Error("Received error response from API", zap.String("path", path), zap.Int("status_code", resp.StatusCode), zap.String("response", string(respBody)))
-
-			if telnyxErr := parseTelnyxError(respBody); telnyxErr != nil {
-				return telnyxErr
+		if err != nil {
+			client.logger.Warn("Error making request", zap.String("method", method), zap.String("path", path), zap.Int("attempt", attempt), zap.Error(err))
+		} else if resp.StatusCode < 400 {
+			if raw, ok := v.(*[]byte); ok {
+				*raw = respBody
+			} else if v != nil {
+				if err := json.Unmarshal(respBody, v); err != nil {
+					client.logger.Error("Error unmarshaling response", zap.Error(err))
+					return err
+				}
 			}
-
-			return fmt.Errorf("received error response from API: %s", string(respBody))
+			return nil
 		}
 
-		if v != nil {
-			if err := json.Unmarshal(respBody, v); err != nil {
-				client.logger.Error("Error unmarshaling response", zap.Error(err))
+		waitTime, retry := client.retryPolicy.ShouldRetry(attempt, req, resp, err)
+		if !retry {
+			if err != nil {
 				return err
 			}
+			return client.responseError(method, path, resp, respBody)
 		}
 
-		return nil
+		fields := []zap.Field{zap.String("method", method), zap.String("path", path), zap.Int("attempt", attempt), zap.Duration("wait_time", waitTime)}
+		if resp != nil {
+			fields = append(fields, zap.Int("status_code", resp.StatusCode))
+		}
+		client.logger.Info("Retrying request", fields...)
+		if err := sleepContext(ctx, waitTime); err != nil {
+			client.logger.Warn("Request cancelled while waiting to retry", zap.String("path", path), zap.Error(err))
+			return err
+		}
 	}
+}
+
+// send performs a single attempt and returns the response with its body fully
+// read, so the connection is released before any retry.
+func (client *TelnyxClient) send(req *http.Request, bodyBytes []byte, attempt int) (*http.Response, []byte, error) {
+	client.logHTTPRequest(req, bodyBytes, attempt)
+	start := time.Now()
+
+	resp, err := client.httpClient.Do(req)
+	if err != nil {
+		client.logHTTPResponse(req, nil, nil, time.Since(start), err)
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		client.logHTTPResponse(req, nil, nil, time.Since(start), err)
+		return nil, nil, err
+	}
+
+	client.logHTTPResponse(req, resp, respBody, time.Since(start), nil)
+	return resp, respBody, nil
+}
 
-	return lastErr
+func (client *TelnyxClient) responseError(method, path string, resp *http.Response, respBody []byte) error {
+	apiErr := newAPIError(method, path, resp, respBody)
+	client.logger.Error("Received error response from API", zap.String("path", path), zap.Int("status_code", resp.StatusCode), zap.String("request_id", apiErr.RequestID), zap.String("response", string(redact.JSON(respBody))))
+	return apiErr
 }
 
-func parseTelnyxError(respBody []byte) *TelnyxError {
-	var telnyxErr TelnyxError
-	if err := json.Unmarshal(respBody, &telnyxErr); err != nil {
+// sleepContext pauses for the given duration, returning early with the
+// context's error if it is cancelled or its deadline passes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
 		return nil
 	}
-	return &telnyxErr
 }
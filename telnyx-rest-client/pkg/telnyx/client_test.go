@@ -0,0 +1,150 @@
+package telnyx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/petsinc/telnyx-rest-client/pkg/cassette"
+	"go.uber.org/zap"
+)
+
+func TestNewClientValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{name: "negative max retries", opts: []Option{WithMaxRetries(-1)}, want: "max retries must not be negative, got -1"},
+		{name: "inverted backoff", opts: []Option{WithRetryBackoff(time.Second, time.Millisecond)}, want: "invalid retry backoff"},
+		{name: "zero min backoff", opts: []Option{WithRetryBackoff(0, time.Second)}, want: "invalid retry backoff"},
+		{name: "base URL without scheme", opts: []Option{WithBaseURL("api.telnyx.com/v2")}, want: "scheme and host are required"},
+		{name: "base URL without host", opts: []Option{WithBaseURL("https:///v2")}, want: "scheme and host are required"},
+		{name: "unparsable base URL", opts: []Option{WithBaseURL("https://api telnyx.com\x7f")}, want: "invalid base URL"},
+		{name: "negative rate limit", opts: []Option{WithRateLimit(-1, 0)}, want: "rate limit must not be negative, got -1"},
+		{name: "negative endpoint rate limit", opts: []Option{WithEndpointRateLimit("/fqdns/", -0.5, 0)}, want: `rate limit for "fqdns" must not be negative, got -0.5`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := append([]Option{WithAPIKey("test-key"), WithLogger(zap.NewNop())}, test.opts...)
+			client, err := NewClient(opts...)
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("NewClient() = %v, %v, want an error containing %q", client, err, test.want)
+			}
+		})
+	}
+
+	t.Run("missing API key", func(t *testing.T) {
+		t.Setenv("TELNYX_API_KEY", "")
+		if _, err := NewClient(WithLogger(zap.NewNop())); !errors.Is(err, ErrMissingAPIKey) {
+			t.Errorf("NewClient() error = %v, want ErrMissingAPIKey", err)
+		}
+		if _, err := NewClient(WithAPIKey(""), WithLogger(zap.NewNop())); !errors.Is(err, ErrMissingAPIKey) {
+			t.Errorf("NewClient(WithAPIKey(\"\")) error = %v, want ErrMissingAPIKey", err)
+		}
+	})
+
+	t.Run("API key from the environment", func(t *testing.T) {
+		t.Setenv("TELNYX_API_KEY", "env-key")
+		if _, err := NewClient(WithLogger(zap.NewNop())); err != nil {
+			t.Errorf("NewClient() with TELNYX_API_KEY set: %v", err)
+		}
+	})
+}
+
+// roundTripperFunc adapts a function to http.RoundTripper.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+func TestWithHTTPClientCopiesTheClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":{"balance":"1.00"}}`))
+	}))
+	defer server.Close()
+
+	callerTransport := &http.Transport{}
+	caller := &http.Client{Transport: callerTransport, Timeout: time.Minute}
+	var viaOption bool
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		viaOption = true
+		return http.DefaultTransport.RoundTrip(req)
+	})
+
+	client, err := NewClient(
+		WithAPIKey("test-key"),
+		WithBaseURL(server.URL),
+		WithHTTPClient(caller),
+		WithTransport(transport),
+		WithTimeout(5*time.Second),
+		WithLogger(zap.NewNop()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if caller.Transport != callerTransport || caller.Timeout != time.Minute {
+		t.Errorf("NewClient changed the caller's http.Client to %+v", caller)
+	}
+	if client.httpClient == caller {
+		t.Error("the client shares the caller's http.Client")
+	}
+	if client.httpClient.Timeout != 5*time.Second {
+		t.Errorf("client timeout = %s, want 5s from WithTimeout", client.httpClient.Timeout)
+	}
+	if _, err := client.GetBalance(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if !viaOption {
+		t.Error("the request did not go through the WithTransport transport")
+	}
+}
+
+func TestWithCassette(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "balance.json")
+	fixture := `{"interactions":[{"request":{"method":"GET","uri":"/v2/balance"},"response":{"status_code":200,"body":{"data":{"balance":"42.00","currency":"USD"}}}}]}`
+	if err := os.WriteFile(path, []byte(fixture), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	recording, err := cassette.Load(path, cassette.ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A replayed run never reaches Telnyx, so no API key is needed
+	t.Setenv("TELNYX_API_KEY", "")
+	client, err := NewClient(WithCassette(recording), WithMaxRetries(0), WithLogger(zap.NewNop()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	balance, err := client.GetBalance(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance.Balance != "42.00" {
+		t.Errorf("replayed balance %q, want 42.00", balance.Balance)
+	}
+}
+
+func TestCassetteIsNotReadFromTheEnvironment(t *testing.T) {
+	t.Setenv(cassette.EnvPath, filepath.Join(t.TempDir(), "missing.json"))
+	t.Setenv(cassette.EnvMode, "replay")
+	t.Setenv("TELNYX_API_KEY", "")
+
+	// The missing fixture would fail to load, and replaying would waive the
+	// API key, if the client picked the cassette up on its own
+	if _, err := NewClient(WithAPIKey("test-key"), WithLogger(zap.NewNop())); err != nil {
+		t.Errorf("NewClient() with a cassette only in the environment: %v", err)
+	}
+	if _, err := NewClient(WithLogger(zap.NewNop())); err != ErrMissingAPIKey {
+		t.Errorf("NewClient() without an API key = %v, want ErrMissingAPIKey", err)
+	}
+}
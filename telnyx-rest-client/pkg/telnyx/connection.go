@@ -0,0 +1,15 @@
+package telnyx
+
+import "context"
+
+// ListConnections returns every connection on the account, whatever its kind,
+// following pagination. Filters such as filter[outbound_voice_profile_id]
+// and filter[connection_name][contains] narrow the listing.
+func (client *TelnyxClient) ListConnections(ctx context.Context, opts *ListOptions) ([]Connection, error) {
+	return listAll(client.IterateConnections(ctx, opts))
+}
+
+// IterateConnections returns an Iterator over connections of every kind that fetches pages on demand.
+func (client *TelnyxClient) IterateConnections(ctx context.Context, opts *ListOptions) *Iterator[Connection] {
+	return newIterator[Connection](ctx, client, "/connections", opts)
+}
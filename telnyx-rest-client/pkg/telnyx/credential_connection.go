@@ -1,17 +1,18 @@
 package telnyx
 
 import (
+	"context"
 	"fmt"
 
 	"go.uber.org/zap"
 )
 
 // Create Credential Connection
-func (client *TelnyxClient) CreateCredentialConnection(profile CredentialConnection) (*CredentialConnection, error) {
+func (client *TelnyxClient) CreateCredentialConnection(ctx context.Context, profile CredentialConnection) (*CredentialConnection, error) {
 	var result struct {
 		Data CredentialConnection `json:"data"`
 	}
-	err := client.doRequest("POST", "/credential_connections", profile, &result)
+	err := client.doRequest(ctx, "POST", "/credential_connections", profile, &result)
 	if err != nil {
 		client.logger.Error("Error creating credential connection", zap.Error(err))
 		return nil, err
@@ -20,11 +21,11 @@ func (client *TelnyxClient) CreateCredentialConnection(profile CredentialConnect
 }
 
 // Update Credential Connection
-func (client *TelnyxClient) UpdateCredentialConnection(credentialConnectionID string, profile CredentialConnection) (*CredentialConnection, error) {
+func (client *TelnyxClient) UpdateCredentialConnection(ctx context.Context, credentialConnectionID string, profile CredentialConnection) (*CredentialConnection, error) {
 	var result struct {
 		Data CredentialConnection `json:"data"`
 	}
-	err := client.doRequest("PATCH", fmt.Sprintf("/credential_connections/%s", credentialConnectionID), profile, &result)
+	err := client.doRequest(ctx, "PATCH", fmt.Sprintf("/credential_connections/%s", credentialConnectionID), profile, &result)
 	if err != nil {
 		client.logger.Error("Error updating credential connection", zap.Error(err), zap.String("credentialConnectionID", credentialConnectionID))
 		return nil, err
@@ -33,8 +34,8 @@ func (client *TelnyxClient) UpdateCredentialConnection(credentialConnectionID st
 }
 
 // Delete Credential Connection
-func (client *TelnyxClient) DeleteCredentialConnection(credentialConnectionID string) error {
-	err := client.doRequest("DELETE", fmt.Sprintf("/credential_connections/%s", credentialConnectionID), nil, nil)
+func (client *TelnyxClient) DeleteCredentialConnection(ctx context.Context, credentialConnectionID string) error {
+	err := client.doRequest(ctx, "DELETE", fmt.Sprintf("/credential_connections/%s", credentialConnectionID), nil, nil)
 	if err != nil {
 		client.logger.Error("Error deleting credential connection", zap.Error(err), zap.String("credentialConnectionID", credentialConnectionID))
 	}
@@ -42,14 +43,24 @@ func (client *TelnyxClient) DeleteCredentialConnection(credentialConnectionID st
 }
 
 // Get Credential Connection
-func (client *TelnyxClient) GetCredentialConnection(credentialConnectionID string) (*CredentialConnection, error) {
+func (client *TelnyxClient) GetCredentialConnection(ctx context.Context, credentialConnectionID string) (*CredentialConnection, error) {
 	var result struct {
 		Data CredentialConnection `json:"data"`
 	}
-	err := client.doRequest("GET", fmt.Sprintf("/credential_connections/%s", credentialConnectionID), nil, &result)
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/credential_connections/%s", credentialConnectionID), nil, &result)
 	if err != nil {
 		client.logger.Error("Error getting credential connection", zap.Error(err), zap.String("credentialConnectionID", credentialConnectionID))
 		return nil, err
 	}
 	return &result.Data, nil
 }
+
+// ListCredentialConnections returns all credential connections, following pagination.
+func (client *TelnyxClient) ListCredentialConnections(ctx context.Context, opts *ListOptions) ([]CredentialConnection, error) {
+	return listAll(client.IterateCredentialConnections(ctx, opts))
+}
+
+// IterateCredentialConnections returns an Iterator over credential connections that fetches pages on demand.
+func (client *TelnyxClient) IterateCredentialConnections(ctx context.Context, opts *ListOptions) *Iterator[CredentialConnection] {
+	return newIterator[CredentialConnection](ctx, client, "/credential_connections", opts)
+}
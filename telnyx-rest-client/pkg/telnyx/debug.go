@@ -0,0 +1,123 @@
+package telnyx
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/petsinc/telnyx-rest-client/internal/redact"
+	"go.uber.org/zap"
+)
+
+// HTTPDebugLevel controls how much of each HTTP exchange is logged. It is
+// independent of the logger level, so request tracing can be switched on
+// without turning on every other debug message.
+type HTTPDebugLevel int
+
+const (
+	// HTTPDebugOff logs nothing about individual requests.
+	HTTPDebugOff HTTPDebugLevel = iota
+	// HTTPDebugHeaders logs the method, URL, status, duration and headers.
+	HTTPDebugHeaders
+	// HTTPDebugBodies additionally logs request and response bodies.
+	HTTPDebugBodies
+)
+
+// String returns the name accepted by ParseHTTPDebugLevel.
+func (level HTTPDebugLevel) String() string {
+	switch level {
+	case HTTPDebugHeaders:
+		return "headers"
+	case HTTPDebugBodies:
+		return "bodies"
+	default:
+		return "off"
+	}
+}
+
+// ParseHTTPDebugLevel converts "off", "headers" or "bodies" to an
+// HTTPDebugLevel. An empty string means off.
+func ParseHTTPDebugLevel(value string) (HTTPDebugLevel, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "off":
+		return HTTPDebugOff, nil
+	case "headers":
+		return HTTPDebugHeaders, nil
+	case "bodies":
+		return HTTPDebugBodies, nil
+	default:
+		return HTTPDebugOff, fmt.Errorf("telnyx: unknown HTTP debug level %q, expected off, headers or bodies", value)
+	}
+}
+
+// HTTPDebugFunc receives one HTTP debug entry. Secrets in headers and bodies
+// have already been redacted. ctx is the context of the request being traced.
+type HTTPDebugFunc func(ctx context.Context, message string, fields map[string]interface{})
+
+// logHTTPRequest emits a debug entry for an outgoing request.
+func (client *TelnyxClient) logHTTPRequest(req *http.Request, bodyBytes []byte, attempt int) {
+	if client.httpDebug == HTTPDebugOff {
+		return
+	}
+	fields := map[string]interface{}{
+		"method":          req.Method,
+		"url":             req.URL.String(),
+		"attempt":         attempt,
+		"request_headers": flattenHeaders(redact.Headers(req.Header)),
+	}
+	if client.httpDebug >= HTTPDebugBodies && len(bodyBytes) > 0 {
+		fields["request_body"] = string(redact.JSON(bodyBytes))
+		if redact.IsMultipart(req.Header) {
+			fields["request_body"] = redact.Multipart(bodyBytes)
+		}
+	}
+	client.emitHTTPDebug(req.Context(), "Sending Telnyx API request", fields)
+}
+
+// logHTTPResponse emits a debug entry for the outcome of a request.
+func (client *TelnyxClient) logHTTPResponse(req *http.Request, resp *http.Response, respBody []byte, elapsed time.Duration, err error) {
+	if client.httpDebug == HTTPDebugOff {
+		return
+	}
+	fields := map[string]interface{}{
+		"method":   req.Method,
+		"url":      req.URL.String(),
+		"duration": elapsed.String(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+		client.emitHTTPDebug(req.Context(), "Telnyx API request failed", fields)
+		return
+	}
+	fields["status_code"] = resp.StatusCode
+	fields["response_headers"] = flattenHeaders(redact.Headers(resp.Header))
+	if client.httpDebug >= HTTPDebugBodies && len(respBody) > 0 {
+		fields["response_body"] = string(redact.JSON(respBody))
+		if resp.StatusCode < 400 && redact.IsSecretResponse(req.URL.Path) {
+			fields["response_body"] = redact.Placeholder
+		}
+	}
+	client.emitHTTPDebug(req.Context(), "Received Telnyx API response", fields)
+}
+
+func (client *TelnyxClient) emitHTTPDebug(ctx context.Context, message string, fields map[string]interface{}) {
+	if client.httpDebugFunc != nil {
+		client.httpDebugFunc(ctx, message, fields)
+		return
+	}
+	zapFields := make([]zap.Field, 0, len(fields))
+	for key, value := range fields {
+		zapFields = append(zapFields, zap.Any(key, value))
+	}
+	client.httpDebugLogger.Info(message, zapFields...)
+}
+
+func flattenHeaders(header http.Header) map[string]string {
+	flattened := make(map[string]string, len(header))
+	for name, values := range header {
+		flattened[name] = strings.Join(values, ", ")
+	}
+	return flattened
+}
@@ -0,0 +1,64 @@
+package telnyx
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestHTTPDebugRedacts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/token"):
+			fmt.Fprint(w, "bare-token-secret")
+		case strings.HasSuffix(r.URL.Path, "/download"):
+			fmt.Fprint(w, "document-contents")
+		default:
+			w.Header().Set("Set-Cookie", "session=cookie-secret")
+			fmt.Fprint(w, `{"data":{"id":"1","sip_password":"response-password"}}`)
+		}
+	}))
+	defer server.Close()
+
+	var entries []string
+	client, err := NewClient(
+		WithAPIKey("api-key-secret"),
+		WithBaseURL(server.URL),
+		WithLogger(zap.NewNop()),
+		WithHTTPDebug(HTTPDebugBodies),
+		WithHTTPDebugFunc(func(ctx context.Context, message string, fields map[string]interface{}) {
+			entries = append(entries, fmt.Sprint(fields))
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	body := map[string]string{"connection_name": "office", "password": "request-password"}
+	if err := client.doRequest(ctx, http.MethodPost, "/credential_connections", body, nil); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"/telephony_credentials/1/token", "/documents/1/download"} {
+		// The bodies are not JSON, so decoding them fails after they are logged
+		client.doRequest(ctx, http.MethodGet, path, nil, &map[string]interface{}{})
+	}
+
+	log := strings.Join(entries, "\n")
+	if len(entries) != 6 {
+		t.Fatalf("expected a request and a response entry per call, got:\n%s", log)
+	}
+	for _, secret := range []string{"api-key-secret", "cookie-secret", "request-password", "response-password", "bare-token-secret", "document-contents"} {
+		if strings.Contains(log, secret) {
+			t.Errorf("debug log contains %q:\n%s", secret, log)
+		}
+	}
+	if !strings.Contains(log, "office") {
+		t.Errorf("debug log lost the non-sensitive request fields:\n%s", log)
+	}
+}
@@ -0,0 +1,80 @@
+package telnyx
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// UploadDocument uploads a file, such as a proof of address, so it can be
+// given as the value of a document regulatory requirement or attached to a
+// porting order.
+func (client *TelnyxClient) UploadDocument(ctx context.Context, filename string, content []byte, customerReference string) (*Document, error) {
+	var result struct {
+		Data Document `json:"data"`
+	}
+	fields := map[string]string{}
+	if customerReference != "" {
+		fields["customer_reference"] = customerReference
+	}
+	err := client.doMultipartRequest(ctx, "POST", "/documents", fields, "file", filename, content, &result)
+	if err != nil {
+		client.logger.Error("Error uploading document", zap.Error(err), zap.String("filename", filename))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+func (client *TelnyxClient) GetDocument(ctx context.Context, documentID string) (*Document, error) {
+	var result struct {
+		Data Document `json:"data"`
+	}
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/documents/%s", documentID), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+func (client *TelnyxClient) UpdateDocument(ctx context.Context, documentID string, request UpdateDocumentRequest) (*Document, error) {
+	var result struct {
+		Data Document `json:"data"`
+	}
+	err := client.doRequest(ctx, "PATCH", fmt.Sprintf("/documents/%s", documentID), request, &result)
+	if err != nil {
+		client.logger.Error("Error updating document", zap.Error(err))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+func (client *TelnyxClient) DeleteDocument(ctx context.Context, documentID string) error {
+	err := client.doRequest(ctx, "DELETE", fmt.Sprintf("/documents/%s", documentID), nil, nil)
+	if err != nil {
+		client.logger.Error("Error deleting document", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// DownloadDocument returns the contents of an uploaded document.
+func (client *TelnyxClient) DownloadDocument(ctx context.Context, documentID string) ([]byte, error) {
+	var content []byte
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/documents/%s/download", documentID), nil, &content)
+	if err != nil {
+		client.logger.Error("Error downloading document", zap.Error(err))
+		return nil, err
+	}
+	return content, nil
+}
+
+// ListDocuments returns all documents, following pagination.
+func (client *TelnyxClient) ListDocuments(ctx context.Context, opts *ListOptions) ([]Document, error) {
+	return listAll(client.IterateDocuments(ctx, opts))
+}
+
+// IterateDocuments returns an Iterator over documents that fetches pages on demand.
+func (client *TelnyxClient) IterateDocuments(ctx context.Context, opts *ListOptions) *Iterator[Document] {
+	return newIterator[Document](ctx, client, "/documents", opts)
+}
@@ -0,0 +1,155 @@
+package telnyx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/petsinc/telnyx-rest-client/internal/redact"
+)
+
+// APIError is returned for every response with a 4xx or 5xx status. Use
+// errors.As to get at it, or the Is* helpers to classify it.
+type APIError struct {
+	// StatusCode is the HTTP status of the response.
+	StatusCode int
+	// Method and Path identify the request that failed.
+	Method string
+	Path   string
+	// RequestID is the Telnyx request ID, useful when contacting support.
+	RequestID string
+	// Errors holds the parsed error objects from the response body, if any.
+	Errors []TelnyxErrorDetail `json:"errors"`
+	// Body is the raw response body, kept for responses that are not
+	// Telnyx error documents.
+	Body []byte
+}
+
+// TelnyxError is the previous name of APIError.
+type TelnyxError = APIError
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	var message strings.Builder
+	fmt.Fprintf(&message, "telnyx: %s %s returned %d", e.Method, e.Path, e.StatusCode)
+	if e.RequestID != "" {
+		fmt.Fprintf(&message, " (request id %s)", e.RequestID)
+	}
+
+	if len(e.Errors) == 0 {
+		if body := strings.TrimSpace(string(redact.JSON(e.Body))); body != "" {
+			message.WriteString(": " + body)
+		}
+		return message.String()
+	}
+	for i, detail := range e.Errors {
+		if i == 0 {
+			message.WriteString(": ")
+		} else {
+			message.WriteString("; ")
+		}
+		message.WriteString(detail.String())
+	}
+	return message.String()
+}
+
+// HasCode reports whether any of the returned errors has the given Telnyx
+// error code, such as "10005".
+func (e *APIError) HasCode(code string) bool {
+	for _, detail := range e.Errors {
+		if detail.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// IsResourceNotFound reports whether the requested resource does not exist.
+// https://developers.telnyx.com/api/errors/10005
+func (e *APIError) IsResourceNotFound() bool {
+	return e.StatusCode == http.StatusNotFound || e.HasCode("10005")
+}
+
+// String formats the error as "code title: detail (pointer)".
+func (d TelnyxErrorDetail) String() string {
+	message := d.Title
+	if d.Code != "" {
+		message = d.Code + " " + message
+	}
+	if d.Detail != "" && d.Detail != d.Title {
+		message += ": " + d.Detail
+	}
+	if pointer := d.Pointer(); pointer != "" {
+		message += " (" + pointer + ")"
+	}
+	return message
+}
+
+// Pointer returns the JSON pointer to the request field the error refers
+// to, such as "/outbound/channel_limit", or an empty string.
+func (d TelnyxErrorDetail) Pointer() string {
+	return d.Source["pointer"]
+}
+
+// newAPIError builds an APIError from a failed response.
+func newAPIError(method, path string, resp *http.Response, respBody []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Method:     method,
+		Path:       path,
+		RequestID:  resp.Header.Get("X-Request-Id"),
+		Body:       respBody,
+	}
+	var document struct {
+		Errors []TelnyxErrorDetail `json:"errors"`
+	}
+	if err := json.Unmarshal(respBody, &document); err == nil {
+		apiErr.Errors = document.Errors
+	}
+	return apiErr
+}
+
+// AsAPIError returns the APIError wrapped by err, if any.
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// IsNotFound reports whether err is an API error for a missing resource.
+func IsNotFound(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.IsResourceNotFound()
+}
+
+// IsConflict reports whether err is a 409 Conflict, for example a name that
+// is already in use.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsValidation reports whether the API rejected the request body as invalid.
+func IsValidation(err error) bool {
+	return hasStatus(err, http.StatusUnprocessableEntity) || hasStatus(err, http.StatusBadRequest)
+}
+
+// IsUnauthorized reports whether the API key was missing, invalid or lacked
+// permission for the request.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized) || hasStatus(err, http.StatusForbidden)
+}
+
+// IsRateLimited reports whether the request was still being rate limited
+// after all retries were used up.
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+func hasStatus(err error, statusCode int) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.StatusCode == statusCode
+}
@@ -0,0 +1,130 @@
+package telnyx
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestErrorClassification(t *testing.T) {
+	tests := []struct {
+		status int
+		body   string
+		want   string
+	}{
+		{status: http.StatusNotFound, want: "not found"},
+		{status: http.StatusOK, body: `{"errors":[{"code":"10005","title":"Resource not found"}]}`, want: "not found"},
+		{status: http.StatusConflict, want: "conflict"},
+		{status: http.StatusBadRequest, want: "validation"},
+		{status: http.StatusUnprocessableEntity, want: "validation"},
+		{status: http.StatusUnauthorized, want: "unauthorized"},
+		{status: http.StatusForbidden, want: "unauthorized"},
+		{status: http.StatusTooManyRequests, want: "rate limited"},
+		{status: http.StatusInternalServerError},
+	}
+	classifiers := map[string]func(error) bool{
+		"not found":    IsNotFound,
+		"conflict":     IsConflict,
+		"validation":   IsValidation,
+		"unauthorized": IsUnauthorized,
+		"rate limited": IsRateLimited,
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%d %s", test.status, test.want), func(t *testing.T) {
+			apiErr := newAPIError(http.MethodGet, "/billing_groups/1", &http.Response{StatusCode: test.status, Header: http.Header{}}, []byte(test.body))
+			// Callers usually see the error wrapped with more context
+			err := fmt.Errorf("reading billing group: %w", apiErr)
+			for name, classify := range classifiers {
+				if got := classify(err); got != (name == test.want) {
+					t.Errorf("%s = %t", name, got)
+				}
+			}
+		})
+	}
+
+	for name, classify := range classifiers {
+		if classify(errors.New("connection refused")) || classify(nil) {
+			t.Errorf("%s matched an error that is not an APIError", name)
+		}
+	}
+}
+
+func TestAsAPIError(t *testing.T) {
+	apiErr := newAPIError(http.MethodPost, "/fqdns", &http.Response{StatusCode: http.StatusConflict, Header: http.Header{}}, nil)
+
+	got, ok := AsAPIError(fmt.Errorf("outer: %w", fmt.Errorf("inner: %w", apiErr)))
+	if !ok || got != apiErr {
+		t.Errorf("AsAPIError() = %v, %t, want the wrapped error", got, ok)
+	}
+	if _, ok := AsAPIError(io.EOF); ok {
+		t.Error("AsAPIError(io.EOF) reported an APIError")
+	}
+}
+
+func TestAPIErrorMessage(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		requestID string
+		body      string
+		want      string
+	}{
+		{
+			name:      "error document",
+			status:    http.StatusUnprocessableEntity,
+			requestID: "req-123",
+			body:      `{"errors":[{"code":"10015","title":"Invalid value","detail":"channel_limit must be positive.","source":{"pointer":"/outbound/channel_limit"}},{"code":"10032","title":"Missing required parameter"}]}`,
+			want:      "telnyx: POST /credential_connections returned 422 (request id req-123): 10015 Invalid value: channel_limit must be positive. (/outbound/channel_limit); 10032 Missing required parameter",
+		},
+		{
+			name:   "body that is not JSON",
+			status: http.StatusBadGateway,
+			body:   "<html>Bad Gateway</html>\n",
+			want:   "telnyx: POST /credential_connections returned 502: <html>Bad Gateway</html>",
+		},
+		{
+			name:   "JSON without errors is redacted",
+			status: http.StatusBadRequest,
+			body:   `{"message":"bad","password":"hunter2"}`,
+			want:   `telnyx: POST /credential_connections returned 400: {"message":"bad","password":"REDACTED"}`,
+		},
+		{
+			name:   "empty body",
+			status: http.StatusServiceUnavailable,
+			want:   "telnyx: POST /credential_connections returned 503",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: test.status, Header: http.Header{}}
+			if test.requestID != "" {
+				resp.Header.Set("X-Request-Id", test.requestID)
+			}
+			apiErr := newAPIError(http.MethodPost, "/credential_connections", resp, []byte(test.body))
+			if got := apiErr.Error(); got != test.want {
+				t.Errorf("Error() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAPIErrorFromBodyThatIsNotJSON(t *testing.T) {
+	body := []byte("upstream timed out")
+	apiErr := newAPIError(http.MethodGet, "/phone_numbers", &http.Response{StatusCode: http.StatusGatewayTimeout, Header: http.Header{}}, body)
+
+	if len(apiErr.Errors) != 0 {
+		t.Errorf("parsed %d error objects from a plain text body", len(apiErr.Errors))
+	}
+	if string(apiErr.Body) != string(body) {
+		t.Errorf("Body = %q, want the raw response", apiErr.Body)
+	}
+	if apiErr.HasCode("10005") || apiErr.IsResourceNotFound() {
+		t.Error("a 504 with a plain text body was classified as not found")
+	}
+	if !strings.Contains(apiErr.Error(), "upstream timed out") {
+		t.Errorf("Error() = %q, want it to include the body", apiErr.Error())
+	}
+}
@@ -1,48 +1,59 @@
 package telnyx
 
 import (
+	"context"
 	"fmt"
 	"go.uber.org/zap"
 )
 
-func (client *TelnyxClient) CreateFQDN(fqdn FQDN) (*FQDN, error) {
+func (client *TelnyxClient) CreateFQDN(ctx context.Context, fqdn FQDN) (*FQDN, error) {
 	var result struct {
 		Data FQDN `json:"data"`
 	}
-	err := client.doRequest("POST", "/fqdns", fqdn, &result)
+	err := client.doRequest(ctx, "POST", "/fqdns", fqdn, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result.Data, nil
 }
 
-func (client *TelnyxClient) UpdateFQDN(fqdnID string, fqdn FQDN) (*FQDN, error) {
+func (client *TelnyxClient) UpdateFQDN(ctx context.Context, fqdnID string, fqdn FQDN) (*FQDN, error) {
 	var result struct {
 		Data FQDN `json:"data"`
 	}
-	err := client.doRequest("PATCH", fmt.Sprintf("/fqdns/%s", fqdnID), fqdn, &result)
+	err := client.doRequest(ctx, "PATCH", fmt.Sprintf("/fqdns/%s", fqdnID), fqdn, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result.Data, nil
 }
 
-func (client *TelnyxClient) DeleteFQDN(fqdnID string) error {
-	err := client.doRequest("DELETE", fmt.Sprintf("/fqdns/%s", fqdnID), nil, nil)
+func (client *TelnyxClient) DeleteFQDN(ctx context.Context, fqdnID string) error {
+	err := client.doRequest(ctx, "DELETE", fmt.Sprintf("/fqdns/%s", fqdnID), nil, nil)
 	if err != nil {
 		client.logger.Error("Error deleting FQDN", zap.Error(err), zap.String("fqdnID", fqdnID))
 	}
 	return err
 }
 
-func (client *TelnyxClient) GetFQDN(fqdnID string) (*FQDN, error) {
+func (client *TelnyxClient) GetFQDN(ctx context.Context, fqdnID string) (*FQDN, error) {
 	var result struct {
 		Data FQDN `json:"data"`
 	}
-	err := client.doRequest("GET", fmt.Sprintf("/fqdns/%s", fqdnID), nil, &result)
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/fqdns/%s", fqdnID), nil, &result)
 	if err != nil {
 		client.logger.Error("Error getting FQDN", zap.Error(err), zap.String("fqdnID", fqdnID))
 		return nil, err
 	}
 	return &result.Data, nil
 }
+
+// ListFQDNs returns all FQDNs, following pagination.
+func (client *TelnyxClient) ListFQDNs(ctx context.Context, opts *ListOptions) ([]FQDN, error) {
+	return listAll(client.IterateFQDNs(ctx, opts))
+}
+
+// IterateFQDNs returns an Iterator over FQDNs that fetches pages on demand.
+func (client *TelnyxClient) IterateFQDNs(ctx context.Context, opts *ListOptions) *Iterator[FQDN] {
+	return newIterator[FQDN](ctx, client, "/fqdns", opts)
+}
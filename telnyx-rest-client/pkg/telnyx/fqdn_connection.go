@@ -1,15 +1,16 @@
 package telnyx
 
 import (
+	"context"
 	"fmt"
 	"go.uber.org/zap"
 )
 
-func (client *TelnyxClient) CreateFQDNConnection(profile FQDNConnection) (*FQDNConnection, error) {
+func (client *TelnyxClient) CreateFQDNConnection(ctx context.Context, profile FQDNConnection) (*FQDNConnection, error) {
 	var result struct {
 		Data FQDNConnection `json:"data"`
 	}
-	err := client.doRequest("POST", "/fqdn_connections", profile, &result)
+	err := client.doRequest(ctx, "POST", "/fqdn_connections", profile, &result)
 	if err != nil {
 		client.logger.Error("Error creating FQDN connection", zap.Error(err))
 		return nil, err
@@ -17,11 +18,11 @@ func (client *TelnyxClient) CreateFQDNConnection(profile FQDNConnection) (*FQDNC
 	return &result.Data, nil
 }
 
-func (client *TelnyxClient) UpdateFQDNConnection(fqdnConnectionID string, profile FQDNConnection) (*FQDNConnection, error) {
+func (client *TelnyxClient) UpdateFQDNConnection(ctx context.Context, fqdnConnectionID string, profile FQDNConnection) (*FQDNConnection, error) {
 	var result struct {
 		Data FQDNConnection `json:"data"`
 	}
-	err := client.doRequest("PATCH", fmt.Sprintf("/fqdn_connections/%s", fqdnConnectionID), profile, &result)
+	err := client.doRequest(ctx, "PATCH", fmt.Sprintf("/fqdn_connections/%s", fqdnConnectionID), profile, &result)
 	if err != nil {
 		client.logger.Error("Error updating FQDN connection", zap.Error(err), zap.String("fqdnConnectionID", fqdnConnectionID))
 		return nil, err
@@ -29,22 +30,32 @@ func (client *TelnyxClient) UpdateFQDNConnection(fqdnConnectionID string, profil
 	return &result.Data, nil
 }
 
-func (client *TelnyxClient) DeleteFQDNConnection(connectionID string) error {
-	err := client.doRequest("DELETE", fmt.Sprintf("/fqdn_connections/%s", connectionID), nil, nil)
+func (client *TelnyxClient) DeleteFQDNConnection(ctx context.Context, connectionID string) error {
+	err := client.doRequest(ctx, "DELETE", fmt.Sprintf("/fqdn_connections/%s", connectionID), nil, nil)
 	if err != nil {
 		client.logger.Error("Error deleting FQDN connection", zap.Error(err), zap.String("connectionID", connectionID))
 	}
 	return err
 }
 
-func (client *TelnyxClient) GetFQDNConnection(fqdnConnectionID string) (*FQDNConnection, error) {
+func (client *TelnyxClient) GetFQDNConnection(ctx context.Context, fqdnConnectionID string) (*FQDNConnection, error) {
 	var result struct {
 		Data FQDNConnection `json:"data"`
 	}
-	err := client.doRequest("GET", fmt.Sprintf("/fqdn_connections/%s", fqdnConnectionID), nil, &result)
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/fqdn_connections/%s", fqdnConnectionID), nil, &result)
 	if err != nil {
 		client.logger.Error("Error fetching FQDN connection", zap.Error(err), zap.String("fqdnConnectionID", fqdnConnectionID))
 		return nil, err
 	}
 	return &result.Data, nil
 }
+
+// ListFQDNConnections returns all FQDN connections, following pagination.
+func (client *TelnyxClient) ListFQDNConnections(ctx context.Context, opts *ListOptions) ([]FQDNConnection, error) {
+	return listAll(client.IterateFQDNConnections(ctx, opts))
+}
+
+// IterateFQDNConnections returns an Iterator over FQDN connections that fetches pages on demand.
+func (client *TelnyxClient) IterateFQDNConnections(ctx context.Context, opts *ListOptions) *Iterator[FQDNConnection] {
+	return newIterator[FQDNConnection](ctx, client, "/fqdn_connections", opts)
+}
@@ -0,0 +1,61 @@
+package telnyx
+
+import (
+	"context"
+	"fmt"
+	"go.uber.org/zap"
+)
+
+func (client *TelnyxClient) CreateIP(ctx context.Context, ip IP) (*IP, error) {
+	var result struct {
+		Data IP `json:"data"`
+	}
+	err := client.doRequest(ctx, "POST", "/ips", ip, &result)
+	if err != nil {
+		client.logger.Error("Error creating IP", zap.Error(err))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+func (client *TelnyxClient) UpdateIP(ctx context.Context, ipID string, ip IP) (*IP, error) {
+	var result struct {
+		Data IP `json:"data"`
+	}
+	err := client.doRequest(ctx, "PATCH", fmt.Sprintf("/ips/%s", ipID), ip, &result)
+	if err != nil {
+		client.logger.Error("Error updating IP", zap.Error(err), zap.String("ipID", ipID))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+func (client *TelnyxClient) DeleteIP(ctx context.Context, ipID string) error {
+	err := client.doRequest(ctx, "DELETE", fmt.Sprintf("/ips/%s", ipID), nil, nil)
+	if err != nil {
+		client.logger.Error("Error deleting IP", zap.Error(err), zap.String("ipID", ipID))
+	}
+	return err
+}
+
+func (client *TelnyxClient) GetIP(ctx context.Context, ipID string) (*IP, error) {
+	var result struct {
+		Data IP `json:"data"`
+	}
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/ips/%s", ipID), nil, &result)
+	if err != nil {
+		client.logger.Error("Error getting IP", zap.Error(err), zap.String("ipID", ipID))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+// ListIPs returns all IPs, following pagination.
+func (client *TelnyxClient) ListIPs(ctx context.Context, opts *ListOptions) ([]IP, error) {
+	return listAll(client.IterateIPs(ctx, opts))
+}
+
+// IterateIPs returns an Iterator over IPs that fetches pages on demand.
+func (client *TelnyxClient) IterateIPs(ctx context.Context, opts *ListOptions) *Iterator[IP] {
+	return newIterator[IP](ctx, client, "/ips", opts)
+}
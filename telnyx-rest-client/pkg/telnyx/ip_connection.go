@@ -0,0 +1,61 @@
+package telnyx
+
+import (
+	"context"
+	"fmt"
+	"go.uber.org/zap"
+)
+
+func (client *TelnyxClient) CreateIPConnection(ctx context.Context, connection IPConnection) (*IPConnection, error) {
+	var result struct {
+		Data IPConnection `json:"data"`
+	}
+	err := client.doRequest(ctx, "POST", "/ip_connections", connection, &result)
+	if err != nil {
+		client.logger.Error("Error creating IP connection", zap.Error(err))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+func (client *TelnyxClient) UpdateIPConnection(ctx context.Context, ipConnectionID string, connection IPConnection) (*IPConnection, error) {
+	var result struct {
+		Data IPConnection `json:"data"`
+	}
+	err := client.doRequest(ctx, "PATCH", fmt.Sprintf("/ip_connections/%s", ipConnectionID), connection, &result)
+	if err != nil {
+		client.logger.Error("Error updating IP connection", zap.Error(err), zap.String("ipConnectionID", ipConnectionID))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+func (client *TelnyxClient) DeleteIPConnection(ctx context.Context, ipConnectionID string) error {
+	err := client.doRequest(ctx, "DELETE", fmt.Sprintf("/ip_connections/%s", ipConnectionID), nil, nil)
+	if err != nil {
+		client.logger.Error("Error deleting IP connection", zap.Error(err), zap.String("ipConnectionID", ipConnectionID))
+	}
+	return err
+}
+
+func (client *TelnyxClient) GetIPConnection(ctx context.Context, ipConnectionID string) (*IPConnection, error) {
+	var result struct {
+		Data IPConnection `json:"data"`
+	}
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/ip_connections/%s", ipConnectionID), nil, &result)
+	if err != nil {
+		client.logger.Error("Error fetching IP connection", zap.Error(err), zap.String("ipConnectionID", ipConnectionID))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+// ListIPConnections returns all IP connections, following pagination.
+func (client *TelnyxClient) ListIPConnections(ctx context.Context, opts *ListOptions) ([]IPConnection, error) {
+	return listAll(client.IterateIPConnections(ctx, opts))
+}
+
+// IterateIPConnections returns an Iterator over IP connections that fetches pages on demand.
+func (client *TelnyxClient) IterateIPConnections(ctx context.Context, opts *ListOptions) *Iterator[IPConnection] {
+	return newIterator[IPConnection](ctx, client, "/ip_connections", opts)
+}
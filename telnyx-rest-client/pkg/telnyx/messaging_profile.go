@@ -1,42 +1,53 @@
 package telnyx
 
 import (
+	"context"
 	"fmt"
 )
 
-func (client *TelnyxClient) CreateMessagingProfile(profile MessagingProfile) (*MessagingProfile, error) {
+func (client *TelnyxClient) CreateMessagingProfile(ctx context.Context, profile MessagingProfile) (*MessagingProfile, error) {
 	var result struct {
 		Data MessagingProfile `json:"data"`
 	}
-	err := client.doRequest("POST", "/messaging_profiles", profile, &result)
+	err := client.doRequest(ctx, "POST", "/messaging_profiles", profile, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result.Data, nil
 }
 
-func (client *TelnyxClient) GetMessagingProfile(profileID string) (*MessagingProfile, error) {
+func (client *TelnyxClient) GetMessagingProfile(ctx context.Context, profileID string) (*MessagingProfile, error) {
 	var result struct {
 		Data MessagingProfile `json:"data"`
 	}
-	err := client.doRequest("GET", fmt.Sprintf("/messaging_profiles/%s", profileID), nil, &result)
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/messaging_profiles/%s", profileID), nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result.Data, nil
 }
 
-func (client *TelnyxClient) UpdateMessagingProfile(profileID string, profile MessagingProfile) (*MessagingProfile, error) {
+func (client *TelnyxClient) UpdateMessagingProfile(ctx context.Context, profileID string, profile MessagingProfile) (*MessagingProfile, error) {
 	var result struct {
 		Data MessagingProfile `json:"data"`
 	}
-	err := client.doRequest("PATCH", fmt.Sprintf("/messaging_profiles/%s", profileID), profile, &result)
+	err := client.doRequest(ctx, "PATCH", fmt.Sprintf("/messaging_profiles/%s", profileID), profile, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result.Data, nil
 }
 
-func (client *TelnyxClient) DeleteMessagingProfile(profileID string) error {
-	return client.doRequest("DELETE", fmt.Sprintf("/messaging_profiles/%s", profileID), nil, nil)
+func (client *TelnyxClient) DeleteMessagingProfile(ctx context.Context, profileID string) error {
+	return client.doRequest(ctx, "DELETE", fmt.Sprintf("/messaging_profiles/%s", profileID), nil, nil)
+}
+
+// ListMessagingProfiles returns all messaging profiles, following pagination.
+func (client *TelnyxClient) ListMessagingProfiles(ctx context.Context, opts *ListOptions) ([]MessagingProfile, error) {
+	return listAll(client.IterateMessagingProfiles(ctx, opts))
+}
+
+// IterateMessagingProfiles returns an Iterator over messaging profiles that fetches pages on demand.
+func (client *TelnyxClient) IterateMessagingProfiles(ctx context.Context, opts *ListOptions) *Iterator[MessagingProfile] {
+	return newIterator[MessagingProfile](ctx, client, "/messaging_profiles", opts)
 }
@@ -0,0 +1,166 @@
+package telnyx
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+func (client *TelnyxClient) CreateNotificationProfile(ctx context.Context, profile NotificationProfile) (*NotificationProfile, error) {
+	var result struct {
+		Data NotificationProfile `json:"data"`
+	}
+	err := client.doRequest(ctx, "POST", "/notification_profiles", profile, &result)
+	if err != nil {
+		client.logger.Error("Error creating notification profile", zap.Error(err))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+func (client *TelnyxClient) GetNotificationProfile(ctx context.Context, profileID string) (*NotificationProfile, error) {
+	var result struct {
+		Data NotificationProfile `json:"data"`
+	}
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/notification_profiles/%s", profileID), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+func (client *TelnyxClient) UpdateNotificationProfile(ctx context.Context, profileID string, profile NotificationProfile) (*NotificationProfile, error) {
+	var result struct {
+		Data NotificationProfile `json:"data"`
+	}
+	err := client.doRequest(ctx, "PATCH", fmt.Sprintf("/notification_profiles/%s", profileID), profile, &result)
+	if err != nil {
+		client.logger.Error("Error updating notification profile", zap.Error(err), zap.String("profileID", profileID))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+func (client *TelnyxClient) DeleteNotificationProfile(ctx context.Context, profileID string) error {
+	err := client.doRequest(ctx, "DELETE", fmt.Sprintf("/notification_profiles/%s", profileID), nil, nil)
+	if err != nil {
+		client.logger.Error("Error deleting notification profile", zap.Error(err), zap.String("profileID", profileID))
+	}
+	return err
+}
+
+// ListNotificationProfiles returns all notification profiles, following pagination.
+func (client *TelnyxClient) ListNotificationProfiles(ctx context.Context, opts *ListOptions) ([]NotificationProfile, error) {
+	return listAll(client.IterateNotificationProfiles(ctx, opts))
+}
+
+// IterateNotificationProfiles returns an Iterator over notification profiles that fetches pages on demand.
+func (client *TelnyxClient) IterateNotificationProfiles(ctx context.Context, opts *ListOptions) *Iterator[NotificationProfile] {
+	return newIterator[NotificationProfile](ctx, client, "/notification_profiles", opts)
+}
+
+func (client *TelnyxClient) CreateNotificationChannel(ctx context.Context, channel NotificationChannel) (*NotificationChannel, error) {
+	var result struct {
+		Data NotificationChannel `json:"data"`
+	}
+	err := client.doRequest(ctx, "POST", "/notification_channels", channel, &result)
+	if err != nil {
+		client.logger.Error("Error creating notification channel", zap.Error(err))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+func (client *TelnyxClient) GetNotificationChannel(ctx context.Context, channelID string) (*NotificationChannel, error) {
+	var result struct {
+		Data NotificationChannel `json:"data"`
+	}
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/notification_channels/%s", channelID), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+func (client *TelnyxClient) UpdateNotificationChannel(ctx context.Context, channelID string, channel NotificationChannel) (*NotificationChannel, error) {
+	var result struct {
+		Data NotificationChannel `json:"data"`
+	}
+	err := client.doRequest(ctx, "PATCH", fmt.Sprintf("/notification_channels/%s", channelID), channel, &result)
+	if err != nil {
+		client.logger.Error("Error updating notification channel", zap.Error(err), zap.String("channelID", channelID))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+func (client *TelnyxClient) DeleteNotificationChannel(ctx context.Context, channelID string) error {
+	err := client.doRequest(ctx, "DELETE", fmt.Sprintf("/notification_channels/%s", channelID), nil, nil)
+	if err != nil {
+		client.logger.Error("Error deleting notification channel", zap.Error(err), zap.String("channelID", channelID))
+	}
+	return err
+}
+
+// ListNotificationChannels returns all notification channels, following pagination.
+func (client *TelnyxClient) ListNotificationChannels(ctx context.Context, opts *ListOptions) ([]NotificationChannel, error) {
+	return listAll(client.IterateNotificationChannels(ctx, opts))
+}
+
+// IterateNotificationChannels returns an Iterator over notification channels that fetches pages on demand.
+func (client *TelnyxClient) IterateNotificationChannels(ctx context.Context, opts *ListOptions) *Iterator[NotificationChannel] {
+	return newIterator[NotificationChannel](ctx, client, "/notification_channels", opts)
+}
+
+func (client *TelnyxClient) CreateNotificationSetting(ctx context.Context, setting NotificationSetting) (*NotificationSetting, error) {
+	var result struct {
+		Data NotificationSetting `json:"data"`
+	}
+	err := client.doRequest(ctx, "POST", "/notification_settings", setting, &result)
+	if err != nil {
+		client.logger.Error("Error creating notification setting", zap.Error(err))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+func (client *TelnyxClient) GetNotificationSetting(ctx context.Context, settingID string) (*NotificationSetting, error) {
+	var result struct {
+		Data NotificationSetting `json:"data"`
+	}
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/notification_settings/%s", settingID), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+func (client *TelnyxClient) DeleteNotificationSetting(ctx context.Context, settingID string) error {
+	err := client.doRequest(ctx, "DELETE", fmt.Sprintf("/notification_settings/%s", settingID), nil, nil)
+	if err != nil {
+		client.logger.Error("Error deleting notification setting", zap.Error(err), zap.String("settingID", settingID))
+	}
+	return err
+}
+
+// ListNotificationSettings returns all notification settings, following pagination.
+func (client *TelnyxClient) ListNotificationSettings(ctx context.Context, opts *ListOptions) ([]NotificationSetting, error) {
+	return listAll(client.IterateNotificationSettings(ctx, opts))
+}
+
+// IterateNotificationSettings returns an Iterator over notification settings that fetches pages on demand.
+func (client *TelnyxClient) IterateNotificationSettings(ctx context.Context, opts *ListOptions) *Iterator[NotificationSetting] {
+	return newIterator[NotificationSetting](ctx, client, "/notification_settings", opts)
+}
+
+// ListNotificationEventConditions returns the event conditions notification
+// settings can subscribe to, following pagination.
+func (client *TelnyxClient) ListNotificationEventConditions(ctx context.Context, opts *ListOptions) ([]NotificationEventCondition, error) {
+	return listAll(client.IterateNotificationEventConditions(ctx, opts))
+}
+
+// IterateNotificationEventConditions returns an Iterator over notification event conditions that fetches pages on demand.
+func (client *TelnyxClient) IterateNotificationEventConditions(ctx context.Context, opts *ListOptions) *Iterator[NotificationEventCondition] {
+	return newIterator[NotificationEventCondition](ctx, client, "/notification_event_conditions", opts)
+}
@@ -0,0 +1,28 @@
+package telnyx
+
+import (
+	"context"
+	"net/url"
+
+	"go.uber.org/zap"
+)
+
+// LookupNumber returns what Telnyx knows about a phone number in E.164
+// format. lookupTypes selects the optional parts of the answer, such as
+// LookupTypeCarrier and LookupTypeCallerName; portability is always
+// returned. Every lookup is billed by Telnyx.
+func (client *TelnyxClient) LookupNumber(ctx context.Context, phoneNumber string, lookupTypes []string) (*NumberLookup, error) {
+	path := "/number_lookup/" + url.PathEscape(phoneNumber)
+	if len(lookupTypes) > 0 {
+		path += "?" + url.Values{"type": lookupTypes}.Encode()
+	}
+	var result struct {
+		Data NumberLookup `json:"data"`
+	}
+	err := client.doRequest(ctx, "GET", path, nil, &result)
+	if err != nil {
+		client.logger.Error("Error looking up phone number", zap.Error(err), zap.String("phone_number", phoneNumber))
+		return nil, err
+	}
+	return &result.Data, nil
+}
@@ -1,58 +1,83 @@
 package telnyx
 
 import (
+	"context"
 	"fmt"
 )
 
-func (client *TelnyxClient) CreateNumberOrder(request CreateNumberOrderRequest) (*PhoneNumberOrderResponse, error) {
+func (client *TelnyxClient) CreateNumberOrder(ctx context.Context, request CreateNumberOrderRequest) (*PhoneNumberOrderResponse, error) {
 	var result struct {
 		Data PhoneNumberOrderResponse `json:"data"`
 	}
-	err := client.doRequest("POST", "/number_orders", request, &result)
+	err := client.doRequest(ctx, "POST", "/number_orders", request, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result.Data, nil
 }
 
-func (client *TelnyxClient) UpdateNumberOrder(numberOrderID string, request UpdateNumberOrderRequest) (*PhoneNumberOrderResponse, error) {
+func (client *TelnyxClient) UpdateNumberOrder(ctx context.Context, numberOrderID string, request UpdateNumberOrderRequest) (*PhoneNumberOrderResponse, error) {
 	var result struct {
 		Data PhoneNumberOrderResponse `json:"data"`
 	}
-	err := client.doRequest("PATCH", fmt.Sprintf("/number_orders/%s", numberOrderID), request, &result)
+	err := client.doRequest(ctx, "PATCH", fmt.Sprintf("/number_orders/%s", numberOrderID), request, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result.Data, nil
 }
 
-func (client *TelnyxClient) GetNumberOrder(numberOrderID string) (*PhoneNumberOrderResponse, error) {
+func (client *TelnyxClient) GetNumberOrder(ctx context.Context, numberOrderID string) (*PhoneNumberOrderResponse, error) {
 	var result struct {
 		Data PhoneNumberOrderResponse `json:"data"`
 	}
-	err := client.doRequest("GET", fmt.Sprintf("/number_orders/%s", numberOrderID), nil, &result)
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/number_orders/%s", numberOrderID), nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result.Data, nil
 }
 
-func (client *TelnyxClient) GetSubNumberOrder(subNumberOrderID string) (*SubNumberOrderResponse, error) {
+func (client *TelnyxClient) GetSubNumberOrder(ctx context.Context, subNumberOrderID string) (*SubNumberOrderResponse, error) {
 	var result struct {
 		Data SubNumberOrderResponse `json:"data"`
 	}
-	err := client.doRequest("GET", fmt.Sprintf("/sub_number_orders/%s", subNumberOrderID), nil, &result)
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/sub_number_orders/%s", subNumberOrderID), nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result.Data, nil
 }
 
-func (client *TelnyxClient) CancelSubNumberOrder(subNumberOrderID string) (*SubNumberOrderResponse, error) {
+func (client *TelnyxClient) CancelSubNumberOrder(ctx context.Context, subNumberOrderID string) (*SubNumberOrderResponse, error) {
 	var result struct {
 		Data SubNumberOrderResponse `json:"data"`
 	}
-	err := client.doRequest("PATCH", fmt.Sprintf("/sub_number_orders/%s/cancel", subNumberOrderID), nil, &result)
+	err := client.doRequest(ctx, "PATCH", fmt.Sprintf("/sub_number_orders/%s/cancel", subNumberOrderID), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+// ListNumberOrders returns all number orders, following pagination.
+func (client *TelnyxClient) ListNumberOrders(ctx context.Context, opts *ListOptions) ([]PhoneNumberOrderResponse, error) {
+	return listAll(client.IterateNumberOrders(ctx, opts))
+}
+
+// IterateNumberOrders returns an Iterator over number orders that fetches pages on demand.
+func (client *TelnyxClient) IterateNumberOrders(ctx context.Context, opts *ListOptions) *Iterator[PhoneNumberOrderResponse] {
+	return newIterator[PhoneNumberOrderResponse](ctx, client, "/number_orders", opts)
+}
+
+// UpdateNumberOrderPhoneNumberRequirementGroup fulfils the regulatory
+// requirements of one number of an order with an approved requirement group.
+func (client *TelnyxClient) UpdateNumberOrderPhoneNumberRequirementGroup(ctx context.Context, numberOrderPhoneNumberID, requirementGroupID string) (*OrderResponsePhoneNumbers, error) {
+	var result struct {
+		Data OrderResponsePhoneNumbers `json:"data"`
+	}
+	request := map[string]string{"requirement_group_id": requirementGroupID}
+	err := client.doRequest(ctx, "POST", fmt.Sprintf("/number_order_phone_numbers/%s/requirement_group", numberOrderPhoneNumberID), request, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -1,10 +1,11 @@
 package telnyx
 
 import (
+	"context"
 	"fmt"
 )
 
-func (client *TelnyxClient) CreateNumberReservation(phoneNumbers []string, customerReference string) (*PhoneNumberReservation, error) {
+func (client *TelnyxClient) CreateNumberReservation(ctx context.Context, phoneNumbers []string, customerReference string) (*PhoneNumberReservation, error) {
 	var phoneNumbersMap []map[string]string
 	for _, phoneNumber := range phoneNumbers {
 		phoneNumbersMap = append(phoneNumbersMap, map[string]string{"phone_number": phoneNumber})
@@ -16,20 +17,47 @@ func (client *TelnyxClient) CreateNumberReservation(phoneNumbers []string, custo
 	var result struct {
 		Data PhoneNumberReservation `json:"data"`
 	}
-	err := client.doRequest("POST", "/number_reservations", body, &result)
+	err := client.doRequest(ctx, "POST", "/number_reservations", body, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result.Data, nil
 }
 
-func (client *TelnyxClient) ExtendPhoneNumberReservation(reservationID string) (*PhoneNumberReservation, error) {
+func (client *TelnyxClient) ExtendPhoneNumberReservation(ctx context.Context, reservationID string) (*PhoneNumberReservation, error) {
 	var result struct {
 		Data PhoneNumberReservation `json:"data"`
 	}
-	err := client.doRequest("POST", fmt.Sprintf("/number_reservations/%s/actions/extend", reservationID), nil, &result)
+	err := client.doRequest(ctx, "POST", fmt.Sprintf("/number_reservations/%s/actions/extend", reservationID), nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result.Data, nil
 }
+
+func (client *TelnyxClient) GetNumberReservation(ctx context.Context, reservationID string) (*PhoneNumberReservation, error) {
+	var result struct {
+		Data PhoneNumberReservation `json:"data"`
+	}
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/number_reservations/%s", reservationID), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+// DeleteNumberReservation releases the numbers held by a reservation before
+// it expires.
+func (client *TelnyxClient) DeleteNumberReservation(ctx context.Context, reservationID string) error {
+	return client.doRequest(ctx, "DELETE", fmt.Sprintf("/number_reservations/%s", reservationID), nil, nil)
+}
+
+// ListNumberReservations returns all number reservations, following pagination.
+func (client *TelnyxClient) ListNumberReservations(ctx context.Context, opts *ListOptions) ([]PhoneNumberReservation, error) {
+	return listAll(client.IterateNumberReservations(ctx, opts))
+}
+
+// IterateNumberReservations returns an Iterator over number reservations that fetches pages on demand.
+func (client *TelnyxClient) IterateNumberReservations(ctx context.Context, opts *ListOptions) *Iterator[PhoneNumberReservation] {
+	return newIterator[PhoneNumberReservation](ctx, client, "/number_reservations", opts)
+}
@@ -0,0 +1,178 @@
+package telnyx
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/petsinc/telnyx-rest-client/pkg/cassette"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultBaseURL is the Telnyx v2 API endpoint used when no base URL is configured.
+	DefaultBaseURL = "https://api.telnyx.com/v2"
+
+	// DefaultUserAgent is sent with every request unless overridden with WithUserAgent.
+	DefaultUserAgent = "telnyx-rest-client"
+
+	// DefaultMaxRetries is how many times a failed request is retried unless
+	// overridden with WithMaxRetries.
+	DefaultMaxRetries = 4
+)
+
+// clientConfig collects the options passed to NewClient before the client is built.
+type clientConfig struct {
+	apiKey      string
+	baseURL     string
+	userAgent   string
+	httpClient  *http.Client
+	transport   http.RoundTripper
+	timeout     time.Duration
+	maxRetries  int
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	retryPolicy RetryPolicy
+	logger      *zap.Logger
+
+	httpDebug     HTTPDebugLevel
+	httpDebugFunc HTTPDebugFunc
+
+	rateLimit          RateLimit
+	endpointRateLimits map[string]RateLimit
+
+	cassette *cassette.Cassette
+}
+
+// Option configures a TelnyxClient created with NewClient.
+type Option func(*clientConfig)
+
+// WithAPIKey sets the API key used to authenticate requests. When omitted,
+// the TELNYX_API_KEY environment variable is used.
+func WithAPIKey(apiKey string) Option {
+	return func(config *clientConfig) {
+		config.apiKey = apiKey
+	}
+}
+
+// WithBaseURL points the client at a different API endpoint, such as a proxy,
+// a regional endpoint or a local stand-in server.
+func WithBaseURL(baseURL string) Option {
+	return func(config *clientConfig) {
+		config.baseURL = baseURL
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to send requests. The client is
+// copied, so later options such as WithTransport or WithTimeout do not modify
+// the caller's value.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(config *clientConfig) {
+		config.httpClient = httpClient
+	}
+}
+
+// WithTransport sets the round tripper used to send requests.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(config *clientConfig) {
+		config.transport = transport
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(config *clientConfig) {
+		config.userAgent = userAgent
+	}
+}
+
+// WithLogger sets the logger used by the client. When omitted, a production
+// zap logger is built using the level from TELNYX_REST_CLIENT_LOG_LEVEL.
+func WithLogger(logger *zap.Logger) Option {
+	return func(config *clientConfig) {
+		config.logger = logger
+	}
+}
+
+// WithTimeout limits how long a single HTTP attempt may take, including
+// reading the response body. A zero value means no timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(config *clientConfig) {
+		config.timeout = timeout
+	}
+}
+
+// WithMaxRetries sets how many times a failed request is retried after the
+// first attempt. Zero disables retries. It is ignored when WithRetryPolicy is
+// also given.
+func WithMaxRetries(maxRetries int) Option {
+	return func(config *clientConfig) {
+		config.maxRetries = maxRetries
+	}
+}
+
+// WithRetryBackoff sets the bounds of the wait between retries. The first
+// retry waits up to min, and each later one up to twice as long as the last,
+// never exceeding max. A Retry-After header from the API takes precedence.
+// It is ignored when WithRetryPolicy is also given.
+func WithRetryBackoff(min, max time.Duration) Option {
+	return func(config *clientConfig) {
+		config.minBackoff = min
+		config.maxBackoff = max
+	}
+}
+
+// WithRetryPolicy replaces the DefaultRetryPolicy with a custom policy.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(config *clientConfig) {
+		config.retryPolicy = policy
+	}
+}
+
+// WithHTTPDebug sets how much of each HTTP exchange is logged. When omitted,
+// the level is read from TELNYX_REST_CLIENT_HTTP_DEBUG. Headers such as
+// Authorization and body fields such as password are always redacted.
+func WithHTTPDebug(level HTTPDebugLevel) Option {
+	return func(config *clientConfig) {
+		config.httpDebug = level
+	}
+}
+
+// WithHTTPDebugFunc sends HTTP debug entries to fn instead of the client
+// logger, for example to forward them to a host application's own logging.
+func WithHTTPDebugFunc(fn HTTPDebugFunc) Option {
+	return func(config *clientConfig) {
+		config.httpDebugFunc = fn
+	}
+}
+
+// WithRateLimit limits every endpoint family to requestsPerSecond, allowing
+// bursts of up to burst requests. A burst below 1 defaults to the rate
+// rounded up. Each family, such as "fqdns" or "phone_numbers", gets its own
+// bucket. A rate of zero, the default, leaves requests unthrottled apart from
+// pauses requested by the API's rate limit headers.
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(config *clientConfig) {
+		config.rateLimit = RateLimit{Rate: requestsPerSecond, Burst: burst}
+	}
+}
+
+// WithEndpointRateLimit overrides the rate limit for one endpoint family,
+// named by the first segment of its path, for example "number_orders".
+func WithEndpointRateLimit(family string, requestsPerSecond float64, burst int) Option {
+	return func(config *clientConfig) {
+		if config.endpointRateLimits == nil {
+			config.endpointRateLimits = map[string]RateLimit{}
+		}
+		config.endpointRateLimits[strings.Trim(family, "/")] = RateLimit{Rate: requestsPerSecond, Burst: burst}
+	}
+}
+
+// WithCassette records the client's HTTP traffic to the cassette or replays
+// it from it, see package cassette. A client replaying a cassette never
+// reaches Telnyx, so it needs no API key. A nil cassette is ignored.
+func WithCassette(recording *cassette.Cassette) Option {
+	return func(config *clientConfig) {
+		config.cassette = recording
+	}
+}
@@ -1,47 +1,58 @@
 package telnyx
 
 import (
+	"context"
 	"fmt"
 	"go.uber.org/zap"
 )
 
-func (client *TelnyxClient) CreateOutboundVoiceProfile(profile OutboundVoiceProfile) (*OutboundVoiceProfile, error) {
+func (client *TelnyxClient) CreateOutboundVoiceProfile(ctx context.Context, profile OutboundVoiceProfile) (*OutboundVoiceProfile, error) {
 	var result struct {
 		Data OutboundVoiceProfile `json:"data"`
 	}
-	err := client.doRequest("POST", "/outbound_voice_profiles", profile, &result)
+	err := client.doRequest(ctx, "POST", "/outbound_voice_profiles", profile, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result.Data, nil
 }
 
-func (client *TelnyxClient) GetOutboundVoiceProfile(profileID string) (*OutboundVoiceProfile, error) {
+func (client *TelnyxClient) GetOutboundVoiceProfile(ctx context.Context, profileID string) (*OutboundVoiceProfile, error) {
 	var result struct {
 		Data OutboundVoiceProfile `json:"data"`
 	}
-	err := client.doRequest("GET", fmt.Sprintf("/outbound_voice_profiles/%s", profileID), nil, &result)
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/outbound_voice_profiles/%s", profileID), nil, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result.Data, nil
 }
 
-func (client *TelnyxClient) UpdateOutboundVoiceProfile(profileID string, profile OutboundVoiceProfile) (*OutboundVoiceProfile, error) {
+func (client *TelnyxClient) UpdateOutboundVoiceProfile(ctx context.Context, profileID string, profile OutboundVoiceProfile) (*OutboundVoiceProfile, error) {
 	var result struct {
 		Data OutboundVoiceProfile `json:"data"`
 	}
-	err := client.doRequest("PATCH", fmt.Sprintf("/outbound_voice_profiles/%s", profileID), profile, &result)
+	err := client.doRequest(ctx, "PATCH", fmt.Sprintf("/outbound_voice_profiles/%s", profileID), profile, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result.Data, nil
 }
 
-func (client *TelnyxClient) DeleteOutboundVoiceProfile(profileID string) error {
-	err := client.doRequest("DELETE", fmt.Sprintf("/outbound_voice_profiles/%s", profileID), nil, nil)
+func (client *TelnyxClient) DeleteOutboundVoiceProfile(ctx context.Context, profileID string) error {
+	err := client.doRequest(ctx, "DELETE", fmt.Sprintf("/outbound_voice_profiles/%s", profileID), nil, nil)
 	if err != nil {
 		client.logger.Error("Error deleting outbound voice profile", zap.Error(err), zap.String("profileID", profileID))
 	}
 	return err
 }
+
+// ListOutboundVoiceProfiles returns all outbound voice profiles, following pagination.
+func (client *TelnyxClient) ListOutboundVoiceProfiles(ctx context.Context, opts *ListOptions) ([]OutboundVoiceProfile, error) {
+	return listAll(client.IterateOutboundVoiceProfiles(ctx, opts))
+}
+
+// IterateOutboundVoiceProfiles returns an Iterator over outbound voice profiles that fetches pages on demand.
+func (client *TelnyxClient) IterateOutboundVoiceProfiles(ctx context.Context, opts *ListOptions) *Iterator[OutboundVoiceProfile] {
+	return newIterator[OutboundVoiceProfile](ctx, client, "/outbound_voice_profiles", opts)
+}
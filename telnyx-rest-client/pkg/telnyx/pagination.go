@@ -0,0 +1,143 @@
+package telnyx
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"go.uber.org/zap"
+)
+
+// DefaultPageSize is the page size requested when ListOptions.PageSize is
+// not set. It is the largest page most Telnyx collection endpoints accept.
+const DefaultPageSize = 250
+
+// ListOptions narrows and tunes a paginated list request. A nil
+// *ListOptions lists everything using DefaultPageSize.
+type ListOptions struct {
+	// PageSize is the number of records requested per page.
+	PageSize int
+	// Filters are sent as query parameters with every page request, for
+	// example "filter[name]" or "filter[status]".
+	Filters url.Values
+}
+
+// PaginationMeta is the meta object returned with every page of a Telnyx
+// collection.
+type PaginationMeta struct {
+	PageNumber   int `json:"page_number"`
+	PageSize     int `json:"page_size"`
+	TotalPages   int `json:"total_pages"`
+	TotalResults int `json:"total_results"`
+}
+
+// Iterator walks a Telnyx collection one record at a time, fetching pages
+// as they are needed. Use it like bufio.Scanner:
+//
+//	it := client.IterateBillingGroups(ctx, nil)
+//	for it.Next() {
+//		group := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	ctx    context.Context
+	client *TelnyxClient
+	path   string
+	opts   ListOptions
+
+	page    []T
+	index   int
+	next    int
+	done    bool
+	current T
+	meta    PaginationMeta
+	err     error
+}
+
+func newIterator[T any](ctx context.Context, client *TelnyxClient, path string, opts *ListOptions) *Iterator[T] {
+	iterator := &Iterator[T]{ctx: ctx, client: client, path: path, next: 1}
+	if opts != nil {
+		iterator.opts = *opts
+	}
+	if iterator.opts.PageSize <= 0 {
+		iterator.opts.PageSize = DefaultPageSize
+	}
+	return iterator
+}
+
+// Next advances to the next record, fetching the next page when the current
+// one is used up. It returns false when the collection is exhausted or a
+// request fails; check Err to tell the two apart.
+func (it *Iterator[T]) Next() bool {
+	for it.index >= len(it.page) {
+		if it.done || it.err != nil {
+			return false
+		}
+		it.fetch()
+	}
+	it.current = it.page[it.index]
+	it.index++
+	return true
+}
+
+// Value returns the record Next advanced to.
+func (it *Iterator[T]) Value() T {
+	return it.current
+}
+
+// Err returns the first error encountered while fetching pages.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Meta returns the pagination metadata of the most recently fetched page.
+func (it *Iterator[T]) Meta() PaginationMeta {
+	return it.meta
+}
+
+func (it *Iterator[T]) fetch() {
+	query := url.Values{}
+	for key, values := range it.opts.Filters {
+		query[key] = append([]string(nil), values...)
+	}
+	query.Set("page[number]", strconv.Itoa(it.next))
+	query.Set("page[size]", strconv.Itoa(it.opts.PageSize))
+
+	var result struct {
+		Data []T             `json:"data"`
+		Meta *PaginationMeta `json:"meta"`
+	}
+	if err := it.client.doRequest(it.ctx, "GET", it.path+"?"+query.Encode(), nil, &result); err != nil {
+		it.client.logger.Error("Error listing page", zap.Error(err), zap.String("path", it.path), zap.Int("page", it.next))
+		it.err = err
+		return
+	}
+
+	it.page = result.Data
+	it.index = 0
+	if result.Meta != nil {
+		it.meta = *result.Meta
+		it.done = it.next >= result.Meta.TotalPages
+	} else {
+		// Without meta the only signal left is a short page.
+		it.done = len(result.Data) < it.opts.PageSize
+	}
+	if len(result.Data) == 0 {
+		it.done = true
+	}
+	it.next++
+}
+
+// listAll drains an iterator into a slice.
+func listAll[T any](it *Iterator[T]) ([]T, error) {
+	var records []T
+	for it.Next() {
+		records = append(records, it.Value())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
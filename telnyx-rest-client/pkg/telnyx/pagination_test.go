@@ -0,0 +1,199 @@
+package telnyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+type pageRecord struct {
+	ID string `json:"id"`
+}
+
+// pageServer serves pages of records from a fixed list and remembers the
+// query of every request.
+type pageServer struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	queries []url.Values
+}
+
+// newPageServer serves pages of the given records. withMeta controls whether
+// pages carry a meta object, and failPage, when positive, answers that page
+// with a 404.
+func newPageServer(t *testing.T, records []pageRecord, withMeta bool, failPage int) *pageServer {
+	t.Helper()
+	server := &pageServer{}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		server.mu.Lock()
+		server.queries = append(server.queries, query)
+		server.mu.Unlock()
+
+		number, _ := strconv.Atoi(query.Get("page[number]"))
+		size, _ := strconv.Atoi(query.Get("page[size]"))
+		if number == failPage {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[{"code":"10005","title":"Resource not found"}]}`))
+			return
+		}
+
+		start := min((number-1)*size, len(records))
+		end := min(start+size, len(records))
+		page := map[string]interface{}{"data": records[start:end]}
+		if withMeta {
+			page["meta"] = PaginationMeta{
+				PageNumber:   number,
+				PageSize:     size,
+				TotalPages:   (len(records) + size - 1) / size,
+				TotalResults: len(records),
+			}
+		}
+		json.NewEncoder(w).Encode(page)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (server *pageServer) pagesRequested() []url.Values {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	return append([]url.Values(nil), server.queries...)
+}
+
+func newPageTestClient(t *testing.T, server *pageServer) *TelnyxClient {
+	t.Helper()
+	client, err := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL), WithMaxRetries(0), WithLogger(zap.NewNop()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func makePageRecords(n int) []pageRecord {
+	records := make([]pageRecord, n)
+	for i := range records {
+		records[i] = pageRecord{ID: strconv.Itoa(i + 1)}
+	}
+	return records
+}
+
+func TestIteratorPages(t *testing.T) {
+	tests := []struct {
+		name      string
+		records   int
+		withMeta  bool
+		wantPages int
+	}{
+		{name: "total_pages drives the pages", records: 7, withMeta: true, wantPages: 3},
+		{name: "total_pages with full pages", records: 6, withMeta: true, wantPages: 2},
+		{name: "short last page without meta", records: 7, wantPages: 3},
+		// Without meta a full last page cannot be told apart, so one more
+		// page is requested and comes back empty
+		{name: "full last page without meta", records: 6, wantPages: 3},
+		{name: "empty first page", records: 0, withMeta: true, wantPages: 1},
+		{name: "empty first page without meta", records: 0, wantPages: 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			records := makePageRecords(test.records)
+			server := newPageServer(t, records, test.withMeta, 0)
+
+			got, err := listAll(newIterator[pageRecord](context.Background(), newPageTestClient(t, server), "/records", &ListOptions{PageSize: 3}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != len(records) || (len(records) > 0 && !reflect.DeepEqual(got, records)) {
+				t.Errorf("listed %v, want %v", got, records)
+			}
+			if pages := server.pagesRequested(); len(pages) != test.wantPages {
+				t.Errorf("requested %d pages, want %d", len(pages), test.wantPages)
+			}
+		})
+	}
+}
+
+func TestIteratorCarriesFiltersToEveryPage(t *testing.T) {
+	server := newPageServer(t, makePageRecords(5), true, 0)
+	opts := &ListOptions{
+		PageSize: 2,
+		Filters: url.Values{
+			"filter[status]":   {"active"},
+			"filter[tag][]":    {"a", "b"},
+			"filter[name][eq]": {"office"},
+		},
+	}
+
+	if _, err := listAll(newIterator[pageRecord](context.Background(), newPageTestClient(t, server), "/records", opts)); err != nil {
+		t.Fatal(err)
+	}
+
+	pages := server.pagesRequested()
+	if len(pages) != 3 {
+		t.Fatalf("requested %d pages, want 3", len(pages))
+	}
+	for i, query := range pages {
+		if got := query.Get("page[number]"); got != strconv.Itoa(i+1) {
+			t.Errorf("request %d asked for page %s", i+1, got)
+		}
+		if got := query.Get("page[size]"); got != "2" {
+			t.Errorf("request %d asked for page size %s, want 2", i+1, got)
+		}
+		for key, values := range opts.Filters {
+			if !reflect.DeepEqual(query[key], values) {
+				t.Errorf("request %d sent %s=%v, want %v", i+1, key, query[key], values)
+			}
+		}
+	}
+	if len(opts.Filters) != 3 {
+		t.Errorf("caller's filters were modified: %v", opts.Filters)
+	}
+}
+
+func TestIteratorDefaultPageSize(t *testing.T) {
+	server := newPageServer(t, makePageRecords(1), true, 0)
+
+	if _, err := listAll(newIterator[pageRecord](context.Background(), newPageTestClient(t, server), "/records", nil)); err != nil {
+		t.Fatal(err)
+	}
+	if got := server.pagesRequested()[0].Get("page[size]"); got != strconv.Itoa(DefaultPageSize) {
+		t.Errorf("requested page size %s, want %d", got, DefaultPageSize)
+	}
+}
+
+func TestIteratorErrorOnLaterPage(t *testing.T) {
+	server := newPageServer(t, makePageRecords(10), true, 3)
+	client := newPageTestClient(t, server)
+
+	it := newIterator[pageRecord](context.Background(), client, "/records", &ListOptions{PageSize: 2})
+	var got []pageRecord
+	for it.Next() {
+		got = append(got, it.Value())
+	}
+	if want := makePageRecords(4); !reflect.DeepEqual(got, want) {
+		t.Errorf("iterated %v before the error, want the first two pages %v", got, want)
+	}
+	if !IsNotFound(it.Err()) {
+		t.Errorf("Err() = %v, want the 404 from page 3", it.Err())
+	}
+	if it.Next() {
+		t.Error("Next() returned true after an error")
+	}
+	if meta := it.Meta(); meta.PageNumber != 2 {
+		t.Errorf("Meta() is for page %d, want the last page fetched, 2", meta.PageNumber)
+	}
+
+	records, err := listAll(newIterator[pageRecord](context.Background(), client, "/records", &ListOptions{PageSize: 2}))
+	if records != nil || !IsNotFound(err) {
+		t.Errorf("listAll() = %v, %v, want nil and the 404", records, err)
+	}
+}
@@ -1,17 +1,18 @@
 package telnyx
 
 import (
+	"context"
 	"fmt"
 	"go.uber.org/zap"
 	"net/url"
 	"strings"
 )
 
-func (client *TelnyxClient) GetPhoneNumber(phoneNumberID string) (*PhoneNumberResponse, error) {
+func (client *TelnyxClient) GetPhoneNumber(ctx context.Context, phoneNumberID string) (*PhoneNumberResponse, error) {
 	var result struct {
 		Data PhoneNumberResponse `json:"data"`
 	}
-	err := client.doRequest("GET", fmt.Sprintf("/phone_numbers/%s", phoneNumberID), nil, &result)
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/phone_numbers/%s", phoneNumberID), nil, &result)
 	if err != nil {
 		client.logger.Error("Error retrieving phone number", zap.Error(err), zap.String("phone_number_id", phoneNumberID))
 		return nil, err
@@ -19,11 +20,11 @@ func (client *TelnyxClient) GetPhoneNumber(phoneNumberID string) (*PhoneNumberRe
 	return &result.Data, nil
 }
 
-func (client *TelnyxClient) UpdatePhoneNumber(phoneNumberID string, request UpdatePhoneNumberRequest) (*UpdatePhoneNumberResponse, error) {
+func (client *TelnyxClient) UpdatePhoneNumber(ctx context.Context, phoneNumberID string, request UpdatePhoneNumberRequest) (*UpdatePhoneNumberResponse, error) {
 	var result struct {
 		Data UpdatePhoneNumberResponse `json:"data"`
 	}
-	err := client.doRequest("PATCH", fmt.Sprintf("/phone_numbers/%s", phoneNumberID), request, &result)
+	err := client.doRequest(ctx, "PATCH", fmt.Sprintf("/phone_numbers/%s", phoneNumberID), request, &result)
 	if err != nil {
 		client.logger.Error("Error updating phone number", zap.Error(err), zap.String("phone_number_id", phoneNumberID))
 		return nil, err
@@ -31,19 +32,87 @@ func (client *TelnyxClient) UpdatePhoneNumber(phoneNumberID string, request Upda
 	return &result.Data, nil
 }
 
-func (client *TelnyxClient) DeletePhoneNumber(phoneNumberID string) error {
-	err := client.doRequest("DELETE", fmt.Sprintf("/phone_numbers/%s", phoneNumberID), nil, nil)
+func (client *TelnyxClient) DeletePhoneNumber(ctx context.Context, phoneNumberID string) error {
+	err := client.doRequest(ctx, "DELETE", fmt.Sprintf("/phone_numbers/%s", phoneNumberID), nil, nil)
 	if err != nil {
 		client.logger.Error("Error deleting phone number", zap.Error(err), zap.String("phone_number_id", phoneNumberID))
 	}
 	return err
 }
 
+// GetPhoneNumberVoiceSettings retrieves the voice settings of a phone number.
+func (client *TelnyxClient) GetPhoneNumberVoiceSettings(ctx context.Context, phoneNumberID string) (*PhoneNumberVoiceSettings, error) {
+	var result struct {
+		Data PhoneNumberVoiceSettings `json:"data"`
+	}
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/phone_numbers/%s/voice", phoneNumberID), nil, &result)
+	if err != nil {
+		client.logger.Error("Error retrieving phone number voice settings", zap.Error(err), zap.String("phone_number_id", phoneNumberID))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+// UpdatePhoneNumberVoiceSettings changes the voice settings of a phone number.
+func (client *TelnyxClient) UpdatePhoneNumberVoiceSettings(ctx context.Context, phoneNumberID string, request UpdatePhoneNumberVoiceSettingsRequest) (*PhoneNumberVoiceSettings, error) {
+	var result struct {
+		Data PhoneNumberVoiceSettings `json:"data"`
+	}
+	err := client.doRequest(ctx, "PATCH", fmt.Sprintf("/phone_numbers/%s/voice", phoneNumberID), request, &result)
+	if err != nil {
+		client.logger.Error("Error updating phone number voice settings", zap.Error(err), zap.String("phone_number_id", phoneNumberID))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+// GetPhoneNumberMessagingSettings retrieves the messaging settings of a phone number.
+func (client *TelnyxClient) GetPhoneNumberMessagingSettings(ctx context.Context, phoneNumberID string) (*PhoneNumberMessagingSettings, error) {
+	var result struct {
+		Data PhoneNumberMessagingSettings `json:"data"`
+	}
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/phone_numbers/%s/messaging", phoneNumberID), nil, &result)
+	if err != nil {
+		client.logger.Error("Error retrieving phone number messaging settings", zap.Error(err), zap.String("phone_number_id", phoneNumberID))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+// UpdatePhoneNumberMessagingSettings changes the messaging profile and product of a phone number.
+func (client *TelnyxClient) UpdatePhoneNumberMessagingSettings(ctx context.Context, phoneNumberID string, request UpdatePhoneNumberMessagingSettingsRequest) (*PhoneNumberMessagingSettings, error) {
+	var result struct {
+		Data PhoneNumberMessagingSettings `json:"data"`
+	}
+	err := client.doRequest(ctx, "PATCH", fmt.Sprintf("/phone_numbers/%s/messaging", phoneNumberID), request, &result)
+	if err != nil {
+		client.logger.Error("Error updating phone number messaging settings", zap.Error(err), zap.String("phone_number_id", phoneNumberID))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+// EnablePhoneNumberEmergency enables or disables emergency (E911) calling on
+// a phone number. Enabling requires the ID of a validated address. The
+// returned voice settings report the emergency status, which stays
+// "provisioning" until the carrier has registered the address.
+func (client *TelnyxClient) EnablePhoneNumberEmergency(ctx context.Context, phoneNumberID string, request EnablePhoneNumberEmergencyRequest) (*PhoneNumberVoiceSettings, error) {
+	var result struct {
+		Data PhoneNumberVoiceSettings `json:"data"`
+	}
+	err := client.doRequest(ctx, "POST", fmt.Sprintf("/phone_numbers/%s/actions/enable_emergency", phoneNumberID), request, &result)
+	if err != nil {
+		client.logger.Error("Error changing phone number emergency settings", zap.Error(err), zap.String("phone_number_id", phoneNumberID))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
 // ListAvailablePhoneNumbers retrieves available phone numbers based on the provided filters.
-func (client *TelnyxClient) ListAvailablePhoneNumbers(filters AvailablePhoneNumbersRequest) (*AvailablePhoneNumbersResponse, error) {
+func (client *TelnyxClient) ListAvailablePhoneNumbers(ctx context.Context, filters AvailablePhoneNumbersRequest) (*AvailablePhoneNumbersResponse, error) {
 	queryParams := filters.toQueryParams()
 	var result AvailablePhoneNumbersResponse
-	err := client.doRequest("GET", fmt.Sprintf("/available_phone_numbers?%s", queryParams), nil, &result)
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/available_phone_numbers?%s", queryParams), nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -86,3 +155,73 @@ func (filters AvailablePhoneNumbersRequest) toQueryParams() string {
 	}
 	return params.Encode()
 }
+
+// ListPhoneNumbers returns all phone numbers on the account, following pagination.
+func (client *TelnyxClient) ListPhoneNumbers(ctx context.Context, opts *ListOptions) ([]PhoneNumberResponse, error) {
+	return listAll(client.IteratePhoneNumbers(ctx, opts))
+}
+
+// ListPhoneNumbersMatching returns the phone numbers on the account that
+// match filters, following pagination. The phone numbers endpoint cannot
+// filter by messaging or outbound voice profile, so MessagingProfileID is
+// applied to the pages as they arrive, and OutboundVoiceProfileID by first
+// listing the connections that use the profile.
+func (client *TelnyxClient) ListPhoneNumbersMatching(ctx context.Context, filters PhoneNumberFilters) ([]PhoneNumberResponse, error) {
+	var connectionIDs map[string]bool
+	if filters.OutboundVoiceProfileID != "" {
+		connections, err := client.ListConnections(ctx, &ListOptions{
+			Filters: url.Values{"filter[outbound_voice_profile_id]": {filters.OutboundVoiceProfileID}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		connectionIDs = make(map[string]bool, len(connections))
+		for _, connection := range connections {
+			connectionIDs[connection.ID] = true
+		}
+		if len(connectionIDs) == 0 {
+			return nil, nil
+		}
+	}
+
+	it := client.IteratePhoneNumbers(ctx, &ListOptions{Filters: filters.values()})
+	var phoneNumbers []PhoneNumberResponse
+	for it.Next() {
+		phoneNumber := it.Value()
+		if filters.MessagingProfileID != "" && phoneNumber.MessagingProfileID != filters.MessagingProfileID {
+			continue
+		}
+		if connectionIDs != nil && !connectionIDs[phoneNumber.ConnectionID] {
+			continue
+		}
+		phoneNumbers = append(phoneNumbers, phoneNumber)
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return phoneNumbers, nil
+}
+
+func (filters PhoneNumberFilters) values() url.Values {
+	params := url.Values{}
+	addParam := func(key, value string) {
+		if value != "" {
+			params.Set(key, value)
+		}
+	}
+	addParam("filter[tag]", filters.Tag)
+	addParam("filter[phone_number]", filters.PhoneNumber)
+	addParam("filter[status]", filters.Status)
+	addParam("filter[connection_id]", filters.ConnectionID)
+	addParam("filter[voice.connection_name][contains]", filters.ConnectionName)
+	addParam("filter[country_iso_alpha2]", filters.CountryCode)
+	addParam("filter[number_type][eq]", filters.NumberType)
+	addParam("filter[customer_reference]", filters.CustomerReference)
+	addParam("filter[billing_group_id]", filters.BillingGroupID)
+	return params
+}
+
+// IteratePhoneNumbers returns an Iterator over phone numbers on the account that fetches pages on demand.
+func (client *TelnyxClient) IteratePhoneNumbers(ctx context.Context, opts *ListOptions) *Iterator[PhoneNumberResponse] {
+	return newIterator[PhoneNumberResponse](ctx, client, "/phone_numbers", opts)
+}
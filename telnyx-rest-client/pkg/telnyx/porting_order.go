@@ -0,0 +1,161 @@
+package telnyx
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"go.uber.org/zap"
+)
+
+// CheckPortability reports whether each phone number can be ported to
+// Telnyx.
+func (client *TelnyxClient) CheckPortability(ctx context.Context, phoneNumbers []string) ([]PortabilityCheckResult, error) {
+	var result struct {
+		Data []PortabilityCheckResult `json:"data"`
+	}
+	request := map[string][]string{"phone_numbers": phoneNumbers}
+	err := client.doRequest(ctx, "POST", "/portability_checks", request, &result)
+	if err != nil {
+		client.logger.Error("Error checking portability", zap.Error(err))
+		return nil, err
+	}
+	return result.Data, nil
+}
+
+// CreatePortingOrders creates draft porting orders for the phone numbers.
+// Telnyx splits the numbers into one order per losing carrier and number
+// type, so more than one order may be returned.
+func (client *TelnyxClient) CreatePortingOrders(ctx context.Context, request CreatePortingOrderRequest) ([]PortingOrder, error) {
+	var result struct {
+		Data []PortingOrder `json:"data"`
+	}
+	err := client.doRequest(ctx, "POST", "/porting_orders", request, &result)
+	if err != nil {
+		client.logger.Error("Error creating porting orders", zap.Error(err))
+		return nil, err
+	}
+	return result.Data, nil
+}
+
+func (client *TelnyxClient) GetPortingOrder(ctx context.Context, portingOrderID string) (*PortingOrder, error) {
+	var result struct {
+		Data PortingOrder `json:"data"`
+	}
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/porting_orders/%s", portingOrderID), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+func (client *TelnyxClient) UpdatePortingOrder(ctx context.Context, portingOrderID string, request UpdatePortingOrderRequest) (*PortingOrder, error) {
+	var result struct {
+		Data PortingOrder `json:"data"`
+	}
+	err := client.doRequest(ctx, "PATCH", fmt.Sprintf("/porting_orders/%s", portingOrderID), request, &result)
+	if err != nil {
+		client.logger.Error("Error updating porting order", zap.Error(err))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+// AttachPortingOrderDocuments sets the uploaded LOA and invoice documents of
+// a porting order. Either ID may be empty to keep the current document.
+func (client *TelnyxClient) AttachPortingOrderDocuments(ctx context.Context, portingOrderID, loaDocumentID, invoiceDocumentID string) (*PortingOrder, error) {
+	return client.UpdatePortingOrder(ctx, portingOrderID, UpdatePortingOrderRequest{
+		Documents: &PortingOrderDocuments{LOA: loaDocumentID, Invoice: invoiceDocumentID},
+	})
+}
+
+// DeletePortingOrder deletes a draft porting order. Submitted orders must be
+// cancelled instead.
+func (client *TelnyxClient) DeletePortingOrder(ctx context.Context, portingOrderID string) error {
+	err := client.doRequest(ctx, "DELETE", fmt.Sprintf("/porting_orders/%s", portingOrderID), nil, nil)
+	if err != nil {
+		client.logger.Error("Error deleting porting order", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// SubmitPortingOrder confirms a draft porting order, sending it to the
+// losing carrier.
+func (client *TelnyxClient) SubmitPortingOrder(ctx context.Context, portingOrderID string) (*PortingOrder, error) {
+	var result struct {
+		Data PortingOrder `json:"data"`
+	}
+	err := client.doRequest(ctx, "POST", fmt.Sprintf("/porting_orders/%s/actions/confirm", portingOrderID), nil, &result)
+	if err != nil {
+		client.logger.Error("Error submitting porting order", zap.Error(err))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+// CancelPortingOrder cancels a submitted porting order. Telnyx moves it to
+// cancel-pending until the losing carrier confirms.
+func (client *TelnyxClient) CancelPortingOrder(ctx context.Context, portingOrderID string) (*PortingOrder, error) {
+	var result struct {
+		Data PortingOrder `json:"data"`
+	}
+	err := client.doRequest(ctx, "POST", fmt.Sprintf("/porting_orders/%s/actions/cancel", portingOrderID), nil, &result)
+	if err != nil {
+		client.logger.Error("Error cancelling porting order", zap.Error(err))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+// ListPortingOrders returns all porting orders, following pagination.
+func (client *TelnyxClient) ListPortingOrders(ctx context.Context, opts *ListOptions) ([]PortingOrder, error) {
+	return listAll(client.IteratePortingOrders(ctx, opts))
+}
+
+// IteratePortingOrders returns an Iterator over porting orders that fetches pages on demand.
+func (client *TelnyxClient) IteratePortingOrders(ctx context.Context, opts *ListOptions) *Iterator[PortingOrder] {
+	return newIterator[PortingOrder](ctx, client, "/porting_orders", opts)
+}
+
+// ListPortingOrderPhoneNumbers returns the status of each number of a
+// porting order.
+func (client *TelnyxClient) ListPortingOrderPhoneNumbers(ctx context.Context, portingOrderID string) ([]PortingPhoneNumber, error) {
+	return client.ListPortingPhoneNumbers(ctx, &ListOptions{Filters: url.Values{"filter[porting_order_id]": {portingOrderID}}})
+}
+
+// ListPortingPhoneNumbers returns all numbers being ported, following pagination.
+func (client *TelnyxClient) ListPortingPhoneNumbers(ctx context.Context, opts *ListOptions) ([]PortingPhoneNumber, error) {
+	return listAll(client.IteratePortingPhoneNumbers(ctx, opts))
+}
+
+// IteratePortingPhoneNumbers returns an Iterator over numbers being ported that fetches pages on demand.
+func (client *TelnyxClient) IteratePortingPhoneNumbers(ctx context.Context, opts *ListOptions) *Iterator[PortingPhoneNumber] {
+	return newIterator[PortingPhoneNumber](ctx, client, "/porting_phone_numbers", opts)
+}
+
+// CreatePortingOrderComment adds a comment to a porting order, for example
+// to answer a question from the Telnyx porting team.
+func (client *TelnyxClient) CreatePortingOrderComment(ctx context.Context, portingOrderID, body string) (*PortingComment, error) {
+	var result struct {
+		Data PortingComment `json:"data"`
+	}
+	request := map[string]string{"body": body}
+	err := client.doRequest(ctx, "POST", fmt.Sprintf("/porting_orders/%s/comments", portingOrderID), request, &result)
+	if err != nil {
+		client.logger.Error("Error creating porting order comment", zap.Error(err))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+// ListPortingOrderComments returns all comments on a porting order, oldest
+// first, following pagination.
+func (client *TelnyxClient) ListPortingOrderComments(ctx context.Context, portingOrderID string, opts *ListOptions) ([]PortingComment, error) {
+	return listAll(client.IteratePortingOrderComments(ctx, portingOrderID, opts))
+}
+
+// IteratePortingOrderComments returns an Iterator over the comments on a porting order that fetches pages on demand.
+func (client *TelnyxClient) IteratePortingOrderComments(ctx context.Context, portingOrderID string, opts *ListOptions) *Iterator[PortingComment] {
+	return newIterator[PortingComment](ctx, client, fmt.Sprintf("/porting_orders/%s/comments", portingOrderID), opts)
+}
@@ -0,0 +1,167 @@
+package telnyx
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimit is a token bucket: requests are sent at up to Rate per second on
+// average, with bursts of up to Burst requests after a quiet period.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// rateLimiter throttles requests per endpoint family, the first segment of
+// the request path such as "fqdns" or "phone_numbers". Families without a
+// limit of their own share the default bucket's settings but not its tokens,
+// so a burst against one endpoint does not starve the others.
+//
+// Independently of any configured limit, a family is paused when Telnyx
+// reports its quota as used up, either through X-RateLimit-Remaining and
+// X-RateLimit-Reset or through a 429 with Retry-After. Concurrent requests to
+// that family then wait instead of all failing and backing off separately.
+type rateLimiter struct {
+	mu        sync.Mutex
+	defaults  RateLimit
+	endpoints map[string]RateLimit
+	buckets   map[string]*tokenBucket
+	// now is time.Now outside tests.
+	now func() time.Time
+}
+
+func newRateLimiter(defaults RateLimit, endpoints map[string]RateLimit) *rateLimiter {
+	return &rateLimiter{
+		defaults:  defaults,
+		endpoints: endpoints,
+		buckets:   map[string]*tokenBucket{},
+		now:       time.Now,
+	}
+}
+
+// wait blocks until a request to path may be sent or ctx is done.
+func (limiter *rateLimiter) wait(ctx context.Context, path string) error {
+	return limiter.bucket(path).wait(ctx, limiter.now)
+}
+
+// observe pauses the family of path when the response shows it is out of
+// quota.
+func (limiter *rateLimiter) observe(path string, resp *http.Response) {
+	now := limiter.now()
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if wait, ok := retryAfter(resp.Header, now); ok {
+			limiter.bucket(path).pauseUntil(now.Add(wait))
+			return
+		}
+	}
+	remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	if err != nil || remaining > 0 {
+		return
+	}
+	if reset, ok := rateLimitReset(resp.Header.Get("X-RateLimit-Reset"), now); ok {
+		limiter.bucket(path).pauseUntil(reset)
+	}
+}
+
+func (limiter *rateLimiter) bucket(path string) *tokenBucket {
+	family := endpointFamily(path)
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	bucket, ok := limiter.buckets[family]
+	if !ok {
+		limit, ok := limiter.endpoints[family]
+		if !ok {
+			limit = limiter.defaults
+		}
+		bucket = newTokenBucket(limit, limiter.now())
+		limiter.buckets[family] = bucket
+	}
+	return bucket
+}
+
+// endpointFamily returns the first segment of path, ignoring any query.
+func endpointFamily(path string) string {
+	path = strings.TrimPrefix(path, "/")
+	if i := strings.IndexAny(path, "/?"); i >= 0 {
+		path = path[:i]
+	}
+	return path
+}
+
+// rateLimitReset parses X-RateLimit-Reset, which may be either a number of
+// seconds from now or a Unix timestamp.
+func rateLimitReset(value string, now time.Time) (time.Time, bool) {
+	seconds, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || seconds < 0 {
+		return time.Time{}, false
+	}
+	// Anything this large cannot be a relative delay.
+	if seconds > 1e9 {
+		return time.Unix(int64(seconds), 0), true
+	}
+	return now.Add(time.Duration(seconds * float64(time.Second))), true
+}
+
+type tokenBucket struct {
+	mu          sync.Mutex
+	limit       RateLimit
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+func newTokenBucket(limit RateLimit, now time.Time) *tokenBucket {
+	if limit.Burst < 1 {
+		limit.Burst = int(math.Max(1, math.Ceil(limit.Rate)))
+	}
+	return &tokenBucket{limit: limit, tokens: float64(limit.Burst), last: now}
+}
+
+func (bucket *tokenBucket) wait(ctx context.Context, now func() time.Time) error {
+	for {
+		delay := bucket.reserve(now())
+		if delay <= 0 {
+			return nil
+		}
+		if err := sleepContext(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token and returns zero, or returns how long to wait before
+// trying again.
+func (bucket *tokenBucket) reserve(now time.Time) time.Duration {
+	bucket.mu.Lock()
+	defer bucket.mu.Unlock()
+
+	if now.Before(bucket.pausedUntil) {
+		return bucket.pausedUntil.Sub(now)
+	}
+	if bucket.limit.Rate <= 0 {
+		return 0
+	}
+
+	elapsed := now.Sub(bucket.last).Seconds()
+	bucket.tokens = math.Min(float64(bucket.limit.Burst), bucket.tokens+elapsed*bucket.limit.Rate)
+	bucket.last = now
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return 0
+	}
+	return time.Duration((1 - bucket.tokens) / bucket.limit.Rate * float64(time.Second))
+}
+
+func (bucket *tokenBucket) pauseUntil(until time.Time) {
+	bucket.mu.Lock()
+	defer bucket.mu.Unlock()
+	if until.After(bucket.pausedUntil) {
+		bucket.pausedUntil = until
+	}
+}
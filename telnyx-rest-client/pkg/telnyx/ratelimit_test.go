@@ -0,0 +1,257 @@
+package telnyx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// fakeClock is a manually advanced clock for rateLimiter.now.
+type fakeClock struct {
+	current time.Time
+}
+
+func (clock *fakeClock) now() time.Time { return clock.current }
+
+func (clock *fakeClock) advance(d time.Duration) { clock.current = clock.current.Add(d) }
+
+func newTestRateLimiter(defaults RateLimit, endpoints map[string]RateLimit) (*rateLimiter, *fakeClock) {
+	clock := &fakeClock{current: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)}
+	limiter := newRateLimiter(defaults, endpoints)
+	limiter.now = clock.now
+	return limiter, clock
+}
+
+// reserve takes a token for path at the fake clock's current time.
+func reserve(limiter *rateLimiter, path string) time.Duration {
+	return limiter.bucket(path).reserve(limiter.now())
+}
+
+func TestRateLimiterBurstAndRefill(t *testing.T) {
+	limiter, clock := newTestRateLimiter(RateLimit{Rate: 10, Burst: 3}, nil)
+
+	for i := 0; i < 3; i++ {
+		if delay := reserve(limiter, "/fqdns"); delay != 0 {
+			t.Fatalf("request %d of the burst delayed by %v", i+1, delay)
+		}
+	}
+	if delay := reserve(limiter, "/fqdns"); delay != 100*time.Millisecond {
+		t.Fatalf("request after the burst delayed by %v, want 100ms", delay)
+	}
+
+	clock.advance(100 * time.Millisecond)
+	if delay := reserve(limiter, "/fqdns"); delay != 0 {
+		t.Fatalf("request after one refill interval delayed by %v", delay)
+	}
+	clock.advance(50 * time.Millisecond)
+	if delay := reserve(limiter, "/fqdns"); delay != 50*time.Millisecond {
+		t.Fatalf("request after half a refill interval delayed by %v, want 50ms", delay)
+	}
+
+	// A long quiet period refills the bucket only up to the burst
+	clock.advance(time.Hour)
+	for i := 0; i < 3; i++ {
+		if delay := reserve(limiter, "/fqdns"); delay != 0 {
+			t.Fatalf("request %d after a quiet period delayed by %v", i+1, delay)
+		}
+	}
+	if delay := reserve(limiter, "/fqdns"); delay == 0 {
+		t.Fatal("bucket refilled beyond its burst")
+	}
+}
+
+func TestRateLimiterDefaultBurst(t *testing.T) {
+	limiter, _ := newTestRateLimiter(RateLimit{Rate: 2.5}, nil)
+
+	for i := 0; i < 3; i++ {
+		if delay := reserve(limiter, "/fqdns"); delay != 0 {
+			t.Fatalf("request %d delayed by %v: burst should default to the rate rounded up", i+1, delay)
+		}
+	}
+	if delay := reserve(limiter, "/fqdns"); delay == 0 {
+		t.Fatal("expected a delay after the default burst of 3")
+	}
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	limiter, _ := newTestRateLimiter(RateLimit{}, nil)
+
+	for i := 0; i < 1000; i++ {
+		if delay := reserve(limiter, "/fqdns"); delay != 0 {
+			t.Fatalf("request %d delayed by %v without a rate limit", i+1, delay)
+		}
+	}
+}
+
+func TestRateLimiterEndpointFamilies(t *testing.T) {
+	limiter, clock := newTestRateLimiter(RateLimit{Rate: 10, Burst: 1}, map[string]RateLimit{
+		"number_orders": {Rate: 0.5, Burst: 2},
+	})
+
+	// Paths in the same family share a bucket, whatever follows the first segment
+	if delay := reserve(limiter, "/fqdns/1"); delay != 0 {
+		t.Fatalf("first fqdns request delayed by %v", delay)
+	}
+	if delay := reserve(limiter, "/fqdns?filter[connection_id]=2"); delay != 100*time.Millisecond {
+		t.Fatalf("second fqdns request delayed by %v, want 100ms", delay)
+	}
+
+	// Other families have their own tokens with the default settings
+	if delay := reserve(limiter, "/phone_numbers"); delay != 0 {
+		t.Fatalf("phone_numbers request delayed by %v after fqdns used its burst", delay)
+	}
+
+	// An override replaces the default settings for its family only
+	for i := 0; i < 2; i++ {
+		if delay := reserve(limiter, "/number_orders"); delay != 0 {
+			t.Fatalf("number_orders request %d delayed by %v", i+1, delay)
+		}
+	}
+	if delay := reserve(limiter, "/number_orders/1"); delay != 2*time.Second {
+		t.Fatalf("number_orders request after its burst delayed by %v, want 2s", delay)
+	}
+	clock.advance(2 * time.Second)
+	if delay := reserve(limiter, "/number_orders/1"); delay != 0 {
+		t.Fatalf("number_orders request after refill delayed by %v", delay)
+	}
+}
+
+func TestRateLimiterObserve(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		header    map[string]string
+		wantPause time.Duration
+	}{
+		{
+			name:      "429 with Retry-After",
+			status:    http.StatusTooManyRequests,
+			header:    map[string]string{"Retry-After": "5"},
+			wantPause: 5 * time.Second,
+		},
+		{
+			name:      "429 prefers Retry-After to the reset",
+			status:    http.StatusTooManyRequests,
+			header:    map[string]string{"Retry-After": "5", "X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "30"},
+			wantPause: 5 * time.Second,
+		},
+		{
+			name:      "429 without Retry-After uses the reset",
+			status:    http.StatusTooManyRequests,
+			header:    map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "30"},
+			wantPause: 30 * time.Second,
+		},
+		{
+			name:      "quota used up, reset in seconds",
+			status:    http.StatusOK,
+			header:    map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "1.5"},
+			wantPause: 1500 * time.Millisecond,
+		},
+		{
+			name:      "quota used up, reset as a Unix timestamp",
+			status:    http.StatusOK,
+			header:    map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "1714564860"},
+			wantPause: time.Minute,
+		},
+		{
+			name:   "quota left",
+			status: http.StatusOK,
+			header: map[string]string{"X-RateLimit-Remaining": "1", "X-RateLimit-Reset": "30"},
+		},
+		{
+			name:   "unparseable reset",
+			status: http.StatusOK,
+			header: map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "soon"},
+		},
+		{
+			name:   "no headers",
+			status: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			limiter, clock := newTestRateLimiter(RateLimit{}, nil)
+			resp := &http.Response{StatusCode: test.status, Header: http.Header{}}
+			for name, value := range test.header {
+				resp.Header.Set(name, value)
+			}
+
+			limiter.observe("/phone_numbers/1", resp)
+
+			if delay := reserve(limiter, "/phone_numbers"); delay != test.wantPause {
+				t.Fatalf("request after the response delayed by %v, want %v", delay, test.wantPause)
+			}
+			if delay := reserve(limiter, "/fqdns"); delay != 0 {
+				t.Errorf("pause spread to another family: fqdns delayed by %v", delay)
+			}
+			clock.advance(test.wantPause)
+			if delay := reserve(limiter, "/phone_numbers"); delay != 0 {
+				t.Errorf("request after the pause delayed by %v", delay)
+			}
+		})
+	}
+}
+
+func TestRateLimiterPauseOnlyExtends(t *testing.T) {
+	limiter, _ := newTestRateLimiter(RateLimit{}, nil)
+	tooMany := func(seconds int) *http.Response {
+		return &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {strconv.Itoa(seconds)}}}
+	}
+
+	limiter.observe("/fqdns", tooMany(10))
+	limiter.observe("/fqdns", tooMany(2))
+	if delay := reserve(limiter, "/fqdns"); delay != 10*time.Second {
+		t.Errorf("a shorter Retry-After cut the pause to %v, want 10s", delay)
+	}
+}
+
+func TestRateLimiterWaitCancelled(t *testing.T) {
+	limiter := newRateLimiter(RateLimit{}, nil)
+	limiter.observe("/fqdns", &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"60"}}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := limiter.wait(ctx, "/fqdns"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the wait to end with the context, got %v", err)
+	}
+}
+
+func TestClientHonoursRateLimitReset(t *testing.T) {
+	const reset = 200 * time.Millisecond
+	var mu sync.Mutex
+	var arrivals []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		arrivals = append(arrivals, time.Now())
+		first := len(arrivals) == 1
+		mu.Unlock()
+		if first {
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatFloat(reset.Seconds(), 'f', -1, 64))
+		}
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL), WithLogger(zap.NewNop()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := client.doRequest(context.Background(), http.MethodGet, "/billing_groups", nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Allow for the reset being measured from before the response was read
+	if gap := arrivals[1].Sub(arrivals[0]); gap < reset-20*time.Millisecond {
+		t.Errorf("second request sent %v after the first, want at least %v", gap, reset)
+	}
+}
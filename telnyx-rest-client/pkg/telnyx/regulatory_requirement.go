@@ -0,0 +1,80 @@
+package telnyx
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// ListPhoneNumberRegulatoryRequirements returns the regulatory requirements
+// Telnyx has for ordering each phone number. Numbers without requirements
+// are returned with an empty list.
+func (client *TelnyxClient) ListPhoneNumberRegulatoryRequirements(ctx context.Context, phoneNumbers []string) ([]PhoneNumberRegulatoryRequirements, error) {
+	var result struct {
+		Data []PhoneNumberRegulatoryRequirements `json:"data"`
+	}
+	query := url.Values{"filter[phone_number]": {strings.Join(phoneNumbers, ",")}}
+	err := client.doRequest(ctx, "GET", "/phone_numbers_regulatory_requirements?"+query.Encode(), nil, &result)
+	if err != nil {
+		client.logger.Error("Error listing phone number regulatory requirements", zap.Error(err))
+		return nil, err
+	}
+	return result.Data, nil
+}
+
+func (client *TelnyxClient) CreateRequirementGroup(ctx context.Context, request CreateRequirementGroupRequest) (*RequirementGroup, error) {
+	var result struct {
+		Data RequirementGroup `json:"data"`
+	}
+	err := client.doRequest(ctx, "POST", "/requirement_groups", request, &result)
+	if err != nil {
+		client.logger.Error("Error creating requirement group", zap.Error(err))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+func (client *TelnyxClient) GetRequirementGroup(ctx context.Context, requirementGroupID string) (*RequirementGroup, error) {
+	var result struct {
+		Data RequirementGroup `json:"data"`
+	}
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/requirement_groups/%s", requirementGroupID), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+func (client *TelnyxClient) UpdateRequirementGroup(ctx context.Context, requirementGroupID string, request UpdateRequirementGroupRequest) (*RequirementGroup, error) {
+	var result struct {
+		Data RequirementGroup `json:"data"`
+	}
+	err := client.doRequest(ctx, "PATCH", fmt.Sprintf("/requirement_groups/%s", requirementGroupID), request, &result)
+	if err != nil {
+		client.logger.Error("Error updating requirement group", zap.Error(err))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+func (client *TelnyxClient) DeleteRequirementGroup(ctx context.Context, requirementGroupID string) error {
+	err := client.doRequest(ctx, "DELETE", fmt.Sprintf("/requirement_groups/%s", requirementGroupID), nil, nil)
+	if err != nil {
+		client.logger.Error("Error deleting requirement group", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// ListRequirementGroups returns all requirement groups, following pagination.
+func (client *TelnyxClient) ListRequirementGroups(ctx context.Context, opts *ListOptions) ([]RequirementGroup, error) {
+	return listAll(client.IterateRequirementGroups(ctx, opts))
+}
+
+// IterateRequirementGroups returns an Iterator over requirement groups that fetches pages on demand.
+func (client *TelnyxClient) IterateRequirementGroups(ctx context.Context, opts *ListOptions) *Iterator[RequirementGroup] {
+	return newIterator[RequirementGroup](ctx, client, "/requirement_groups", opts)
+}
@@ -0,0 +1,187 @@
+package telnyx
+
+import (
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultMinRetryBackoff is the wait before the first retry unless
+	// overridden with WithRetryBackoff.
+	DefaultMinRetryBackoff = 1 * time.Second
+
+	// DefaultMaxRetryBackoff caps the computed wait between retries unless
+	// overridden with WithRetryBackoff.
+	DefaultMaxRetryBackoff = 30 * time.Second
+)
+
+// RetryPolicy decides whether a failed attempt is sent again.
+//
+// ShouldRetry is called after every attempt that did not succeed. attempt is
+// the number of attempts made so far, starting at 1. Exactly one of resp and
+// err is non-nil: resp when the API answered, err when the request never got
+// a response. The response body has already been read and closed, so only the
+// status code and headers may be inspected. It returns how long to wait before
+// the next attempt, and false to give up and return the last failure.
+type RetryPolicy interface {
+	ShouldRetry(attempt int, req *http.Request, resp *http.Response, err error) (time.Duration, bool)
+}
+
+// RetryPolicyFunc adapts an ordinary function to the RetryPolicy interface.
+type RetryPolicyFunc func(attempt int, req *http.Request, resp *http.Response, err error) (time.Duration, bool)
+
+// ShouldRetry calls f(attempt, req, resp, err).
+func (f RetryPolicyFunc) ShouldRetry(attempt int, req *http.Request, resp *http.Response, err error) (time.Duration, bool) {
+	return f(attempt, req, resp, err)
+}
+
+// DefaultRetryPolicy is the policy used unless WithRetryPolicy is given.
+//
+// It retries 429 responses for every method, since Telnyx rejects those
+// before doing any work. 5xx responses and transport failures are only
+// retried for idempotent methods, because a POST such as CreateNumberOrder
+// may already have taken effect. PATCH is not idempotent in general, so
+// Update calls are only replayed after such failures when RetryPatch is set.
+// The one exception is a request that failed while dialling, which never
+// reached the server. A Retry-After header is
+// honored; otherwise the wait grows exponentially from MinBackoff, capped at
+// MaxBackoff, with full jitter.
+type DefaultRetryPolicy struct {
+	MaxRetries int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// RetryPatch treats PATCH as idempotent, for callers whose updates set
+	// fields to absolute values and so can safely be sent twice.
+	RetryPatch bool
+}
+
+// ShouldRetry implements RetryPolicy.
+func (policy DefaultRetryPolicy) ShouldRetry(attempt int, req *http.Request, resp *http.Response, err error) (time.Duration, bool) {
+	if attempt > policy.MaxRetries {
+		return 0, false
+	}
+	if req != nil && req.Context().Err() != nil {
+		return 0, false
+	}
+
+	if err != nil {
+		if !policy.isIdempotent(req) && !isDialError(err) {
+			return 0, false
+		}
+		if !isTransientError(err) {
+			return 0, false
+		}
+		return policy.backoff(attempt), true
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+	case isRetryableStatus(resp.StatusCode) && policy.isIdempotent(req):
+	default:
+		return 0, false
+	}
+
+	if wait, ok := retryAfter(resp.Header, time.Now()); ok {
+		return wait, true
+	}
+	return policy.backoff(attempt), true
+}
+
+// backoff returns a random wait between zero and MinBackoff doubled once per
+// previous attempt, capped at MaxBackoff.
+func (policy DefaultRetryPolicy) backoff(attempt int) time.Duration {
+	minBackoff, maxBackoff := policy.MinBackoff, policy.MaxBackoff
+	if minBackoff <= 0 {
+		minBackoff = DefaultMinRetryBackoff
+	}
+	if maxBackoff < minBackoff {
+		maxBackoff = minBackoff
+	}
+
+	ceiling := float64(minBackoff) * math.Pow(2, float64(attempt-1))
+	if ceiling > float64(maxBackoff) {
+		ceiling = float64(maxBackoff)
+	}
+	return time.Duration(rand.Int63n(int64(ceiling)) + 1)
+}
+
+func (policy DefaultRetryPolicy) isIdempotent(req *http.Request) bool {
+	if req == nil {
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPatch:
+		return policy.RetryPatch
+	default:
+		return false
+	}
+}
+
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// isTransientError reports whether a transport error is worth retrying.
+// Malformed URLs and similar programming errors are not.
+func isTransientError(err error) bool {
+	// http.Client wraps every failure in *url.Error, which itself satisfies
+	// net.Error, so look at what it wraps instead.
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// isDialError reports whether the request failed before a connection was
+// established, in which case the server cannot have seen it.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr)
+}
+
+// retryAfter parses a Retry-After header given either as a number of seconds
+// or as an HTTP date.
+func retryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := date.Sub(now)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
@@ -0,0 +1,225 @@
+package telnyx
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		header string
+		want   time.Duration
+		wantOK bool
+	}{
+		{name: "missing", header: ""},
+		{name: "seconds", header: "7", want: 7 * time.Second, wantOK: true},
+		{name: "zero seconds", header: "0", want: 0, wantOK: true},
+		{name: "negative seconds", header: "-1"},
+		{name: "http date", header: now.Add(90 * time.Second).Format(http.TimeFormat), want: 90 * time.Second, wantOK: true},
+		{name: "http date in the past", header: now.Add(-time.Minute).Format(http.TimeFormat), want: 0, wantOK: true},
+		{name: "garbage", header: "soon"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			header := http.Header{}
+			if test.header != "" {
+				header.Set("Retry-After", test.header)
+			}
+			got, ok := retryAfter(header, now)
+			if got != test.want || ok != test.wantOK {
+				t.Errorf("retryAfter(%q) = %v, %t, want %v, %t", test.header, got, ok, test.want, test.wantOK)
+			}
+		})
+	}
+}
+
+func TestDefaultRetryPolicyHonoursRetryAfter(t *testing.T) {
+	policy := DefaultRetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+	req := httptest.NewRequest(http.MethodPost, "/number_orders", nil)
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"5"}}}
+
+	wait, retry := policy.ShouldRetry(1, req, resp, nil)
+	if !retry || wait != 5*time.Second {
+		t.Errorf("ShouldRetry = %v, %t, want 5s, true: Retry-After overrides MaxBackoff", wait, retry)
+	}
+}
+
+func TestDefaultRetryPolicyBackoff(t *testing.T) {
+	policy := DefaultRetryPolicy{MaxRetries: 10, MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	req := httptest.NewRequest(http.MethodGet, "/billing_groups", nil)
+	resp := &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}
+
+	for attempt := 1; attempt <= 10; attempt++ {
+		ceiling := policy.MinBackoff << (attempt - 1)
+		if ceiling > policy.MaxBackoff {
+			ceiling = policy.MaxBackoff
+		}
+		for i := 0; i < 200; i++ {
+			wait, retry := policy.ShouldRetry(attempt, req, resp, nil)
+			if !retry {
+				t.Fatalf("attempt %d: expected a retry", attempt)
+			}
+			if wait <= 0 || wait > ceiling {
+				t.Fatalf("attempt %d: wait %v outside (0, %v]", attempt, wait, ceiling)
+			}
+		}
+	}
+
+	if _, retry := policy.ShouldRetry(11, req, resp, nil); retry {
+		t.Error("expected no retry once MaxRetries attempts have been retried")
+	}
+}
+
+func TestDefaultRetryPolicyMethods(t *testing.T) {
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	tests := []struct {
+		name      string
+		policy    DefaultRetryPolicy
+		method    string
+		status    int
+		err       error
+		wantRetry bool
+	}{
+		{name: "GET 503", method: http.MethodGet, status: http.StatusServiceUnavailable, wantRetry: true},
+		{name: "DELETE 502", method: http.MethodDelete, status: http.StatusBadGateway, wantRetry: true},
+		{name: "GET 404", method: http.MethodGet, status: http.StatusNotFound},
+		{name: "POST 500", method: http.MethodPost, status: http.StatusInternalServerError},
+		{name: "POST 503", method: http.MethodPost, status: http.StatusServiceUnavailable},
+		{name: "POST 429", method: http.MethodPost, status: http.StatusTooManyRequests, wantRetry: true},
+		{name: "POST read error", method: http.MethodPost, err: io.ErrUnexpectedEOF},
+		{name: "POST dial error", method: http.MethodPost, err: dialErr, wantRetry: true},
+		{name: "GET read error", method: http.MethodGet, err: io.ErrUnexpectedEOF, wantRetry: true},
+		{name: "PATCH 503", method: http.MethodPatch, status: http.StatusServiceUnavailable},
+		{name: "PATCH read error", method: http.MethodPatch, err: io.ErrUnexpectedEOF},
+		{name: "PATCH 503 with RetryPatch", policy: DefaultRetryPolicy{RetryPatch: true}, method: http.MethodPatch, status: http.StatusServiceUnavailable, wantRetry: true},
+		{name: "PATCH 429", method: http.MethodPatch, status: http.StatusTooManyRequests, wantRetry: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			policy := test.policy
+			policy.MaxRetries, policy.MinBackoff, policy.MaxBackoff = 3, time.Millisecond, time.Millisecond
+			req := httptest.NewRequest(test.method, "/resource", nil)
+			var resp *http.Response
+			if test.err == nil {
+				resp = &http.Response{StatusCode: test.status, Header: http.Header{}}
+			}
+			if _, retry := policy.ShouldRetry(1, req, resp, test.err); retry != test.wantRetry {
+				t.Errorf("ShouldRetry = %t, want %t", retry, test.wantRetry)
+			}
+		})
+	}
+}
+
+// newRetryTestClient returns a client for server that retries up to three
+// times with millisecond backoff.
+func newRetryTestClient(t *testing.T, server *httptest.Server) *TelnyxClient {
+	t.Helper()
+	client, err := NewClient(
+		WithAPIKey("test-key"),
+		WithBaseURL(server.URL),
+		WithMaxRetries(3),
+		WithRetryBackoff(time.Millisecond, time.Millisecond),
+		WithLogger(zap.NewNop()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestClientDoesNotReplayPOST(t *testing.T) {
+	tests := []struct {
+		name    string
+		respond func(w http.ResponseWriter)
+	}{
+		{
+			name: "5xx",
+			respond: func(w http.ResponseWriter) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+		},
+		{
+			name: "read error",
+			respond: func(w http.ResponseWriter) {
+				// Promise more body than is sent, so reading it fails
+				w.Header().Set("Content-Length", "100")
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"data":`))
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				test.respond(w)
+			}))
+			defer server.Close()
+
+			err := newRetryTestClient(t, server).doRequest(context.Background(), http.MethodPost, "/number_orders", map[string]string{}, nil)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if got := requests.Load(); got != 1 {
+				t.Errorf("POST was sent %d times, want 1", got)
+			}
+		})
+	}
+}
+
+func TestClientRetriesGET(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	if err := newRetryTestClient(t, server).doRequest(context.Background(), http.MethodGet, "/billing_groups", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("GET was sent %d times, want 3", got)
+	}
+}
+
+func TestClientCancelledDuringBackoff(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Retry-After", strconv.Itoa(60))
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	err := newRetryTestClient(t, server).doRequest(ctx, http.MethodGet, "/billing_groups", nil, nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("cancellation took %v, want it to interrupt the 60s backoff", elapsed)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("GET was sent %d times, want 1", got)
+	}
+}
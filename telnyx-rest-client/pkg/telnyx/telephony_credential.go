@@ -0,0 +1,89 @@
+package telnyx
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// ConnectionID returns the ID of the credential connection the credential
+// belongs to, taken from ResourceID.
+func (credential TelephonyCredential) ConnectionID() string {
+	return strings.TrimPrefix(credential.ResourceID, "connection:")
+}
+
+func (client *TelnyxClient) CreateTelephonyCredential(ctx context.Context, request TelephonyCredentialRequest) (*TelephonyCredential, error) {
+	var result struct {
+		Data TelephonyCredential `json:"data"`
+	}
+	err := client.doRequest(ctx, "POST", "/telephony_credentials", request, &result)
+	if err != nil {
+		client.logger.Error("Error creating telephony credential", zap.Error(err))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+func (client *TelnyxClient) GetTelephonyCredential(ctx context.Context, credentialID string) (*TelephonyCredential, error) {
+	var result struct {
+		Data TelephonyCredential `json:"data"`
+	}
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/telephony_credentials/%s", credentialID), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+// UpdateTelephonyCredential changes the name, tag, connection or expiry of a
+// telephony credential. The SIP username and password stay the same.
+func (client *TelnyxClient) UpdateTelephonyCredential(ctx context.Context, credentialID string, request TelephonyCredentialRequest) (*TelephonyCredential, error) {
+	var result struct {
+		Data TelephonyCredential `json:"data"`
+	}
+	err := client.doRequest(ctx, "PATCH", fmt.Sprintf("/telephony_credentials/%s", credentialID), request, &result)
+	if err != nil {
+		client.logger.Error("Error updating telephony credential", zap.Error(err), zap.String("credential_id", credentialID))
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+func (client *TelnyxClient) DeleteTelephonyCredential(ctx context.Context, credentialID string) error {
+	err := client.doRequest(ctx, "DELETE", fmt.Sprintf("/telephony_credentials/%s", credentialID), nil, nil)
+	if err != nil {
+		client.logger.Error("Error deleting telephony credential", zap.Error(err), zap.String("credential_id", credentialID))
+	}
+	return err
+}
+
+// CreateTelephonyCredentialToken mints a short-lived JWT that a WebRTC client
+// can log in with instead of the SIP username and password. Telnyx refuses
+// to mint tokens for expired credentials.
+func (client *TelnyxClient) CreateTelephonyCredentialToken(ctx context.Context, credentialID string) (string, error) {
+	var token []byte
+	err := client.doRequest(ctx, "POST", fmt.Sprintf("/telephony_credentials/%s/token", credentialID), nil, &token)
+	if err != nil {
+		client.logger.Error("Error creating telephony credential token", zap.Error(err), zap.String("credential_id", credentialID))
+		return "", err
+	}
+	return strings.TrimSpace(string(token)), nil
+}
+
+// ListTelephonyCredentials returns all telephony credentials, following pagination.
+func (client *TelnyxClient) ListTelephonyCredentials(ctx context.Context, opts *ListOptions) ([]TelephonyCredential, error) {
+	return listAll(client.IterateTelephonyCredentials(ctx, opts))
+}
+
+// ListTelephonyCredentialsByTag returns the telephony credentials carrying tag.
+func (client *TelnyxClient) ListTelephonyCredentialsByTag(ctx context.Context, tag string) ([]TelephonyCredential, error) {
+	return client.ListTelephonyCredentials(ctx, &ListOptions{Filters: url.Values{"filter[tag]": {tag}}})
+}
+
+// IterateTelephonyCredentials returns an Iterator over telephony credentials that fetches pages on demand.
+func (client *TelnyxClient) IterateTelephonyCredentials(ctx context.Context, opts *ListOptions) *Iterator[TelephonyCredential] {
+	return newIterator[TelephonyCredential](ctx, client, "/telephony_credentials", opts)
+}
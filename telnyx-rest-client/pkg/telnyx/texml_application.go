@@ -1,16 +1,17 @@
 package telnyx
 
 import (
+	"context"
 	"fmt"
 	"go.uber.org/zap"
 )
 
 // CreateTeXMLApplication creates a new TeXML application.
-func (client *TelnyxClient) CreateTeXMLApplication(request TeXMLApplicationRequest) (*TeXMLApplication, error) {
+func (client *TelnyxClient) CreateTeXMLApplication(ctx context.Context, request TeXMLApplicationRequest) (*TeXMLApplication, error) {
 	var result struct {
 		Data TeXMLApplication `json:"data"`
 	}
-	err := client.doRequest("POST", "/texml_applications", request, &result)
+	err := client.doRequest(ctx, "POST", "/texml_applications", request, &result)
 	if err != nil {
 		client.logger.Error("Error creating TeXML application", zap.Error(err))
 		return nil, err
@@ -19,11 +20,11 @@ func (client *TelnyxClient) CreateTeXMLApplication(request TeXMLApplicationReque
 }
 
 // UpdateTeXMLApplication updates an existing TeXML application.
-func (client *TelnyxClient) UpdateTeXMLApplication(applicationID string, request TeXMLApplicationRequest) (*TeXMLApplication, error) {
+func (client *TelnyxClient) UpdateTeXMLApplication(ctx context.Context, applicationID string, request TeXMLApplicationRequest) (*TeXMLApplication, error) {
 	var result struct {
 		Data TeXMLApplication `json:"data"`
 	}
-	err := client.doRequest("PATCH", fmt.Sprintf("/texml_applications/%s", applicationID), request, &result)
+	err := client.doRequest(ctx, "PATCH", fmt.Sprintf("/texml_applications/%s", applicationID), request, &result)
 	if err != nil {
 		client.logger.Error("Error updating TeXML application", zap.Error(err), zap.String("applicationID", applicationID))
 		return nil, err
@@ -32,8 +33,8 @@ func (client *TelnyxClient) UpdateTeXMLApplication(applicationID string, request
 }
 
 // DeleteTeXMLApplication deletes a TeXML application.
-func (client *TelnyxClient) DeleteTeXMLApplication(applicationID string) error {
-	err := client.doRequest("DELETE", fmt.Sprintf("/texml_applications/%s", applicationID), nil, nil)
+func (client *TelnyxClient) DeleteTeXMLApplication(ctx context.Context, applicationID string) error {
+	err := client.doRequest(ctx, "DELETE", fmt.Sprintf("/texml_applications/%s", applicationID), nil, nil)
 	if err != nil {
 		client.logger.Error("Error deleting TeXML application", zap.Error(err), zap.String("applicationID", applicationID))
 	}
@@ -41,14 +42,24 @@ func (client *TelnyxClient) DeleteTeXMLApplication(applicationID string) error {
 }
 
 // GetTeXMLApplication retrieves a TeXML application by ID.
-func (client *TelnyxClient) GetTeXMLApplication(applicationID string) (*TeXMLApplication, error) {
+func (client *TelnyxClient) GetTeXMLApplication(ctx context.Context, applicationID string) (*TeXMLApplication, error) {
 	var result struct {
 		Data TeXMLApplication `json:"data"`
 	}
-	err := client.doRequest("GET", fmt.Sprintf("/texml_applications/%s", applicationID), nil, &result)
+	err := client.doRequest(ctx, "GET", fmt.Sprintf("/texml_applications/%s", applicationID), nil, &result)
 	if err != nil {
 		client.logger.Error("Error fetching TeXML application", zap.Error(err), zap.String("applicationID", applicationID))
 		return nil, err
 	}
 	return &result.Data, nil
 }
+
+// ListTeXMLApplications returns all TeXML applications, following pagination.
+func (client *TelnyxClient) ListTeXMLApplications(ctx context.Context, opts *ListOptions) ([]TeXMLApplication, error) {
+	return listAll(client.IterateTeXMLApplications(ctx, opts))
+}
+
+// IterateTeXMLApplications returns an Iterator over TeXML applications that fetches pages on demand.
+func (client *TelnyxClient) IterateTeXMLApplications(ctx context.Context, opts *ListOptions) *Iterator[TeXMLApplication] {
+	return newIterator[TeXMLApplication](ctx, client, "/texml_applications", opts)
+}
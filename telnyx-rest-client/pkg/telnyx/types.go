@@ -1,9 +1,6 @@
 package telnyx
 
-import (
-	"encoding/json"
-	"time"
-)
+import "time"
 
 // Struct Definitions
 
@@ -17,6 +14,29 @@ type BillingGroup struct {
 	DeletedAt      time.Time `json:"deleted_at,omitempty"`
 }
 
+// Connection summarises a connection of any kind, as listed by
+// ListConnections. RecordType tells the kinds apart, for example
+// credential_connection or texml_application.
+type Connection struct {
+	ID                     string    `json:"id"`
+	RecordType             string    `json:"record_type"`
+	ConnectionName         string    `json:"connection_name"`
+	Active                 bool      `json:"active"`
+	OutboundVoiceProfileID string    `json:"outbound_voice_profile_id"`
+	CreatedAt              time.Time `json:"created_at"`
+	UpdatedAt              time.Time `json:"updated_at"`
+}
+
+// Balance represents the account balance returned by the balance endpoint.
+type Balance struct {
+	RecordType      string `json:"record_type"`
+	Balance         string `json:"balance"`
+	CreditLimit     string `json:"credit_limit"`
+	AvailableCredit string `json:"available_credit"`
+	Pending         string `json:"pending"`
+	Currency        string `json:"currency"`
+}
+
 type CreateNumberOrderRequest struct {
 	PhoneNumbers       []PhoneNumberRequest `json:"phone_numbers"`
 	ConnectionID       string               `json:"connection_id"`
@@ -26,8 +46,13 @@ type CreateNumberOrderRequest struct {
 	SubNumberOrderIDs  []string             `json:"sub_number_orders_ids,omitempty"`
 }
 
+// PhoneNumberRequest is one number of a number order. Numbers that need
+// regulatory information carry either the ID of an approved requirement group
+// or the requirement values themselves.
 type PhoneNumberRequest struct {
-	PhoneNumber string `json:"phone_number"`
+	PhoneNumber            string                             `json:"phone_number"`
+	RequirementGroupID     string                             `json:"requirement_group_id,omitempty"`
+	RegulatoryRequirements []NumberOrderRegulatoryRequirement `json:"regulatory_requirements,omitempty"`
 }
 
 // CreateBillingGroupRequest represents the request payload for creating a billing group.
@@ -99,21 +124,25 @@ type CallRecording struct {
 }
 
 type PhoneNumberReservation struct {
-	ID           string `json:"id"`
-	RecordType   string `json:"record_type"`
-	PhoneNumbers []struct {
-		ID          string    `json:"id"`
-		RecordType  string    `json:"record_type"`
-		PhoneNumber string    `json:"phone_number"`
-		Status      string    `json:"status"`
-		CreatedAt   time.Time `json:"created_at"`
-		UpdatedAt   time.Time `json:"updated_at"`
-		ExpiredAt   time.Time `json:"expired_at"`
-	} `json:"phone_numbers"`
-	Status            string    `json:"status"`
-	CustomerReference string    `json:"customer_reference"`
-	CreatedAt         time.Time `json:"created_at"`
-	UpdatedAt         time.Time `json:"updated_at"`
+	ID                string                `json:"id"`
+	RecordType        string                `json:"record_type"`
+	PhoneNumbers      []ReservedPhoneNumber `json:"phone_numbers"`
+	Status            string                `json:"status"`
+	CustomerReference string                `json:"customer_reference"`
+	CreatedAt         time.Time             `json:"created_at"`
+	UpdatedAt         time.Time             `json:"updated_at"`
+}
+
+// ReservedPhoneNumber is one number held by a PhoneNumberReservation until
+// ExpiredAt.
+type ReservedPhoneNumber struct {
+	ID          string    `json:"id"`
+	RecordType  string    `json:"record_type"`
+	PhoneNumber string    `json:"phone_number"`
+	Status      string    `json:"status"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	ExpiredAt   time.Time `json:"expired_at"`
 }
 
 // SubNumberOrderRegulatoryRequirement represents a regulatory requirement for a sub number order
@@ -150,10 +179,13 @@ type SubNumberOrderResponse struct {
 // 	SubNumberOrderIDs  []string `json:"sub_number_order_ids,omitempty"`
 // }
 
+// NumberOrderRegulatoryRequirement is the value given for a regulatory
+// requirement. For document requirements FieldValue is the ID of an uploaded
+// Document.
 type NumberOrderRegulatoryRequirement struct {
 	RequirementID string `json:"requirement_id"`
 	FieldValue    string `json:"field_value"`
-	FieldType     string `json:"field_type"`
+	FieldType     string `json:"field_type,omitempty"`
 }
 
 type UpdateNumberOrderRequest struct {
@@ -186,6 +218,7 @@ type OrderResponsePhoneNumbers struct {
 	CountryCode            string                             `json:"country_code"`
 	RequirementsMet        bool                               `json:"requirements_met"`
 	Status                 string                             `json:"status"`
+	RequirementGroupID     string                             `json:"requirement_group_id"`
 	RegulatoryRequirements []NumberOrderRegulatoryRequirement `json:"regulatory_requirements"`
 }
 
@@ -212,6 +245,7 @@ type PhoneNumberResponse struct {
 	T38FaxGatewayEnabled  bool      `json:"t38_fax_gateway_enabled"`
 	NumberLevelRouting    string    `json:"number_level_routing"`
 	PhoneNumberType       string    `json:"phone_number_type"`
+	CountryISOAlpha2      string    `json:"country_iso_alpha2"`
 	PurchasedAt           time.Time `json:"purchased_at"`
 	CreatedAt             time.Time `json:"created_at"`
 	UpdatedAt             time.Time `json:"updated_at"`
@@ -312,6 +346,46 @@ type FQDNConnection struct {
 	SipUriCallingPreference          *string          `json:"sip_uri_calling_preference,omitempty"`
 }
 
+// IPConnection Struct. IP connections authenticate calls by source IP
+// address, so unlike FQDN connections they carry no credentials.
+type IPConnection struct {
+	ID                               string           `json:"id"`
+	RecordType                       string           `json:"record_type,omitempty"`
+	Active                           bool             `json:"active"`
+	AnchorsiteOverride               string           `json:"anchorsite_override"`
+	ConnectionName                   string           `json:"connection_name"`
+	TransportProtocol                string           `json:"transport_protocol"`
+	DefaultOnHoldComfortNoiseEnabled bool             `json:"default_on_hold_comfort_noise_enabled"`
+	DTMFType                         string           `json:"dtmf_type"`
+	EncodeContactHeaderEnabled       bool             `json:"encode_contact_header_enabled"`
+	EncryptedMedia                   *string          `json:"encrypted_media,omitempty"`
+	OnnetT38PassthroughEnabled       bool             `json:"onnet_t38_passthrough_enabled"`
+	IosPushCredentialID              *string          `json:"ios_push_credential_id,omitempty"`
+	AndroidPushCredentialID          *string          `json:"android_push_credential_id,omitempty"`
+	MicrosoftTeamsSbc                bool             `json:"microsoft_teams_sbc"`
+	WebhookEventURL                  string           `json:"webhook_event_url"`
+	WebhookEventFailoverURL          string           `json:"webhook_event_failover_url,omitempty"`
+	WebhookAPIVersion                string           `json:"webhook_api_version"`
+	WebhookTimeoutSecs               int              `json:"webhook_timeout_secs,omitempty"`
+	RTCPSettings                     RTCPSettings     `json:"rtcp_settings"`
+	Inbound                          InboundSettings  `json:"inbound"`
+	Outbound                         OutboundSettings `json:"outbound"`
+	CreatedAt                        time.Time        `json:"created_at"`
+	UpdatedAt                        time.Time        `json:"updated_at"`
+}
+
+// IP is an address allowed to send traffic on an IP connection. Telnyx
+// returns the connection ID as a string.
+type IP struct {
+	ID           string    `json:"id"`
+	RecordType   string    `json:"record_type,omitempty"`
+	ConnectionID string    `json:"connection_id"`
+	IPAddress    string    `json:"ip_address"`
+	Port         int       `json:"port"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
 // CredentialConnection Struct
 type CredentialConnection struct {
 	ID                               string           `json:"id"`
@@ -359,6 +433,7 @@ type PhoneNumber struct {
 	T38FaxGatewayEnabled  bool      `json:"t38_fax_gateway_enabled"`
 	NumberLevelRouting    string    `json:"number_level_routing"`
 	PhoneNumberType       string    `json:"phone_number_type"`
+	CountryISOAlpha2      string    `json:"country_iso_alpha2"`
 	PurchasedAt           time.Time `json:"purchased_at"`
 	CreatedAt             time.Time `json:"created_at"`
 	UpdatedAt             time.Time `json:"updated_at"`
@@ -424,10 +499,37 @@ type AvailablePhoneNumbersRequest struct {
 	ExcludeHeldNumbers      bool     `json:"filter[exclude_held_numbers],omitempty"`
 }
 
+// PhoneNumberFilters narrows ListPhoneNumbersMatching to numbers on the
+// account that match every field that is set.
+type PhoneNumberFilters struct {
+	// Tag matches numbers carrying the tag.
+	Tag string
+	// PhoneNumber matches a number in E.164 format.
+	PhoneNumber string
+	// Status is one of purchase-pending, purchase-failed, port-pending,
+	// active, deleted, port-failed, emergency-only, ported-out or
+	// port-out-pending.
+	Status       string
+	ConnectionID string
+	// ConnectionName matches numbers whose voice connection name contains it.
+	ConnectionName string
+	// CountryCode is the ISO 3166-1 alpha-2 country of the numbers.
+	CountryCode string
+	// NumberType is one of local, toll_free, mobile, national, shared_cost
+	// or landline.
+	NumberType         string
+	CustomerReference  string
+	BillingGroupID     string
+	MessagingProfileID string
+	// OutboundVoiceProfileID matches numbers whose connection uses the
+	// outbound voice profile.
+	OutboundVoiceProfileID string
+}
+
 // UpdatePhoneNumberRequest represents the request payload for updating a phone number.
 type UpdatePhoneNumberRequest struct {
 	CustomerReference  string   `json:"customer_reference"`
-	ConnectionID       int      `json:"connection_id"`
+	ConnectionID       string   `json:"connection_id"`
 	BillingGroupID     string   `json:"billing_group_id"`
 	Tags               []string `json:"tags"`
 	HDVoiceEnabled     bool     `json:"hd_voice_enabled"`
@@ -435,6 +537,110 @@ type UpdatePhoneNumberRequest struct {
 	NumberLevelRouting string   `json:"number_level_routing,omitempty"`
 }
 
+// PhoneNumberVoiceSettings represents the voice settings of a phone number.
+type PhoneNumberVoiceSettings struct {
+	ID                   string              `json:"id"`
+	RecordType           string              `json:"record_type"`
+	PhoneNumber          string              `json:"phone_number"`
+	ConnectionID         string              `json:"connection_id"`
+	CustomerReference    string              `json:"customer_reference"`
+	TechPrefixEnabled    bool                `json:"tech_prefix_enabled"`
+	TranslatedNumber     string              `json:"translated_number"`
+	CallerIDNameEnabled  bool                `json:"caller_id_name_enabled"`
+	UsagePaymentMethod   string              `json:"usage_payment_method"`
+	InboundCallScreening string              `json:"inbound_call_screening"`
+	CallForwarding       VoiceCallForwarding `json:"call_forwarding"`
+	CNAMListing          VoiceCNAMListing    `json:"cnam_listing"`
+	MediaFeatures        VoiceMediaFeatures  `json:"media_features"`
+	CallRecording        VoiceCallRecording  `json:"call_recording"`
+	Emergency            VoiceEmergency      `json:"emergency"`
+}
+
+// VoiceCallForwarding forwards calls to a phone number either always or only
+// when the connection cannot take them ("always" or "on_failure").
+type VoiceCallForwarding struct {
+	CallForwardingEnabled bool   `json:"call_forwarding_enabled"`
+	ForwardsTo            string `json:"forwards_to"`
+	ForwardingType        string `json:"forwarding_type"`
+}
+
+// VoiceCNAMListing controls the caller name shown for calls from a number.
+type VoiceCNAMListing struct {
+	CNAMListingEnabled bool   `json:"cnam_listing_enabled"`
+	CNAMListingDetails string `json:"cnam_listing_details"`
+}
+
+// VoiceMediaFeatures controls media handling for calls to a phone number.
+type VoiceMediaFeatures struct {
+	RTPAutoAdjustEnabled       bool `json:"rtp_auto_adjust_enabled"`
+	AcceptAnyRTPPacketsEnabled bool `json:"accept_any_rtp_packets_enabled"`
+	T38FaxGatewayEnabled       bool `json:"t38_fax_gateway_enabled"`
+}
+
+// VoiceCallRecording records inbound calls as "wav" or "mp3", on "single" or
+// "dual" channels.
+type VoiceCallRecording struct {
+	InboundCallRecordingEnabled  bool   `json:"inbound_call_recording_enabled"`
+	InboundCallRecordingFormat   string `json:"inbound_call_recording_format"`
+	InboundCallRecordingChannels string `json:"inbound_call_recording_channels"`
+}
+
+// VoiceEmergency reports whether emergency (E911) calling is enabled for a
+// phone number and the address it is registered to. EmergencyStatus is one
+// of "disabled", "active", "provisioning" or "deprovisioning".
+type VoiceEmergency struct {
+	EmergencyEnabled   bool   `json:"emergency_enabled"`
+	EmergencyAddressID string `json:"emergency_address_id"`
+	EmergencyStatus    string `json:"emergency_status"`
+}
+
+// EnablePhoneNumberEmergencyRequest represents the request payload for
+// enabling or disabling emergency calling on a phone number.
+type EnablePhoneNumberEmergencyRequest struct {
+	EmergencyEnabled   bool   `json:"emergency_enabled"`
+	EmergencyAddressID string `json:"emergency_address_id,omitempty"`
+}
+
+// UpdatePhoneNumberVoiceSettingsRequest represents the request payload for
+// updating the voice settings of a phone number. Nil fields are left
+// unchanged; nested settings are sent whole.
+type UpdatePhoneNumberVoiceSettingsRequest struct {
+	TechPrefixEnabled    *bool                `json:"tech_prefix_enabled,omitempty"`
+	TranslatedNumber     *string              `json:"translated_number,omitempty"`
+	CallerIDNameEnabled  *bool                `json:"caller_id_name_enabled,omitempty"`
+	UsagePaymentMethod   string               `json:"usage_payment_method,omitempty"`
+	InboundCallScreening string               `json:"inbound_call_screening,omitempty"`
+	CallForwarding       *VoiceCallForwarding `json:"call_forwarding,omitempty"`
+	CNAMListing          *VoiceCNAMListing    `json:"cnam_listing,omitempty"`
+	MediaFeatures        *VoiceMediaFeatures  `json:"media_features,omitempty"`
+	CallRecording        *VoiceCallRecording  `json:"call_recording,omitempty"`
+}
+
+// PhoneNumberMessagingSettings represents the messaging settings of a phone
+// number, including the messaging profile it is assigned to.
+type PhoneNumberMessagingSettings struct {
+	ID                        string    `json:"id"`
+	RecordType                string    `json:"record_type"`
+	PhoneNumber               string    `json:"phone_number"`
+	MessagingProfileID        string    `json:"messaging_profile_id"`
+	MessagingProduct          string    `json:"messaging_product"`
+	CountryCode               string    `json:"country_code"`
+	Type                      string    `json:"type"`
+	TrafficType               string    `json:"traffic_type"`
+	EligibleMessagingProducts []string  `json:"eligible_messaging_products"`
+	CreatedAt                 time.Time `json:"created_at"`
+	UpdatedAt                 time.Time `json:"updated_at"`
+}
+
+// UpdatePhoneNumberMessagingSettingsRequest represents the request payload
+// for updating the messaging settings of a phone number. A nil
+// MessagingProfileID leaves the assignment unchanged and an empty one
+// unassigns the number.
+type UpdatePhoneNumberMessagingSettingsRequest struct {
+	MessagingProfileID *string `json:"messaging_profile_id,omitempty"`
+	MessagingProduct   string  `json:"messaging_product,omitempty"`
+}
+
 // UpdatePhoneNumberResponse represents the response from updating a phone number.
 type UpdatePhoneNumberResponse struct {
 	ID                    string    `json:"id"`
@@ -458,6 +664,7 @@ type UpdatePhoneNumberResponse struct {
 	T38FaxGatewayEnabled  bool      `json:"t38_fax_gateway_enabled"`
 	NumberLevelRouting    string    `json:"number_level_routing"`
 	PhoneNumberType       string    `json:"phone_number_type"`
+	CountryISOAlpha2      string    `json:"country_iso_alpha2"`
 	PurchasedAt           time.Time `json:"purchased_at"`
 	CreatedAt             time.Time `json:"created_at"`
 	UpdatedAt             time.Time `json:"updated_at"`
@@ -537,36 +744,453 @@ type CallControlApplicationRequest struct {
 	WebhookTimeoutSecs      int                         `json:"webhook_timeout_secs"`
 }
 
-// TelnyxError represents an error from the Telnyx API
-type TelnyxError struct {
-	Errors []TelnyxErrorDetail `json:"errors"`
+// Address represents a Telnyx address, used among others as the emergency
+// (E911) location of phone numbers.
+type Address struct {
+	ID                 string    `json:"id"`
+	RecordType         string    `json:"record_type"`
+	CustomerReference  string    `json:"customer_reference"`
+	FirstName          string    `json:"first_name"`
+	LastName           string    `json:"last_name"`
+	BusinessName       string    `json:"business_name"`
+	PhoneNumber        string    `json:"phone_number"`
+	StreetAddress      string    `json:"street_address"`
+	ExtendedAddress    string    `json:"extended_address"`
+	Locality           string    `json:"locality"`
+	AdministrativeArea string    `json:"administrative_area"`
+	Neighborhood       string    `json:"neighborhood"`
+	Borough            string    `json:"borough"`
+	PostalCode         string    `json:"postal_code"`
+	CountryCode        string    `json:"country_code"`
+	AddressBook        bool      `json:"address_book"`
+	ValidateAddress    bool      `json:"validate_address"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+// CreateAddressRequest represents the request payload for creating an
+// address. Telnyx defaults AddressBook and ValidateAddress to true when they
+// are nil.
+type CreateAddressRequest struct {
+	CustomerReference  string `json:"customer_reference,omitempty"`
+	FirstName          string `json:"first_name"`
+	LastName           string `json:"last_name"`
+	BusinessName       string `json:"business_name"`
+	PhoneNumber        string `json:"phone_number,omitempty"`
+	StreetAddress      string `json:"street_address"`
+	ExtendedAddress    string `json:"extended_address,omitempty"`
+	Locality           string `json:"locality"`
+	AdministrativeArea string `json:"administrative_area,omitempty"`
+	Neighborhood       string `json:"neighborhood,omitempty"`
+	Borough            string `json:"borough,omitempty"`
+	PostalCode         string `json:"postal_code,omitempty"`
+	CountryCode        string `json:"country_code"`
+	AddressBook        *bool  `json:"address_book,omitempty"`
+	ValidateAddress    *bool  `json:"validate_address,omitempty"`
+}
+
+// ValidateAddressRequest represents the request payload for checking an
+// address before it is created.
+type ValidateAddressRequest struct {
+	StreetAddress      string `json:"street_address"`
+	ExtendedAddress    string `json:"extended_address,omitempty"`
+	Locality           string `json:"locality"`
+	AdministrativeArea string `json:"administrative_area,omitempty"`
+	PostalCode         string `json:"postal_code"`
+	CountryCode        string `json:"country_code"`
+}
+
+// AddressValidation is the outcome of validating an address. Result is
+// "valid" or "invalid". Suggested holds the corrected form of the address,
+// which may differ from the request even when it is valid, and Errors
+// explains why an invalid address was rejected.
+type AddressValidation struct {
+	RecordType string                 `json:"record_type"`
+	Result     string                 `json:"result"`
+	Suggested  ValidateAddressRequest `json:"suggested"`
+	Errors     []TelnyxErrorDetail    `json:"errors"`
+}
+
+// TelephonyCredential is an on-demand SIP credential generated under a
+// credential connection, typically one per WebRTC client or softphone.
+// ResourceID names the connection as "connection:<id>". ExpiresAt is nil
+// for credentials that never expire.
+type TelephonyCredential struct {
+	ID          string     `json:"id"`
+	RecordType  string     `json:"record_type"`
+	Name        string     `json:"name"`
+	Tag         string     `json:"tag"`
+	ResourceID  string     `json:"resource_id"`
+	UserID      string     `json:"user_id"`
+	SIPUsername string     `json:"sip_username"`
+	SIPPassword string     `json:"sip_password"`
+	Expired     bool       `json:"expired"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// TelephonyCredentialRequest represents the request payload for creating or
+// updating a telephony credential. ExpiresAt is an ISO 8601 timestamp.
+type TelephonyCredentialRequest struct {
+	ConnectionID string  `json:"connection_id,omitempty"`
+	Name         *string `json:"name,omitempty"`
+	Tag          *string `json:"tag,omitempty"`
+	ExpiresAt    *string `json:"expires_at,omitempty"`
+}
+
+// NotificationProfile groups the channels that notifications are delivered
+// to.
+type NotificationProfile struct {
+	ID        string    `json:"id,omitempty"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// NotificationChannel is a destination of a notification profile.
+// ChannelTypeID is one of "email", "sms", "voice" or "webhook", and
+// ChannelDestination the matching email address, E.164 number or URL.
+type NotificationChannel struct {
+	ID                    string    `json:"id,omitempty"`
+	NotificationProfileID string    `json:"notification_profile_id"`
+	ChannelTypeID         string    `json:"channel_type_id"`
+	ChannelDestination    string    `json:"channel_destination"`
+	CreatedAt             time.Time `json:"created_at,omitempty"`
+	UpdatedAt             time.Time `json:"updated_at,omitempty"`
+}
+
+// NotificationParameter narrows when a notification setting fires, for
+// example the balance threshold of a low balance alert.
+type NotificationParameter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// NotificationSetting delivers the events matching an event condition to a
+// channel. AssociatedRecordType and AssociatedRecordTypeValue name the record
+// the events are about, such as a connection and its ID, or "account" for
+// account wide events. Telnyx cannot change a setting once it is created.
+type NotificationSetting struct {
+	ID                           string                  `json:"id,omitempty"`
+	NotificationEventConditionID string                  `json:"notification_event_condition_id"`
+	NotificationProfileID        string                  `json:"notification_profile_id"`
+	NotificationChannelID        string                  `json:"notification_channel_id"`
+	AssociatedRecordType         string                  `json:"associated_record_type,omitempty"`
+	AssociatedRecordTypeValue    string                  `json:"associated_record_type_value,omitempty"`
+	Status                       string                  `json:"status,omitempty"`
+	Parameters                   []NotificationParameter `json:"parameters,omitempty"`
+	CreatedAt                    time.Time               `json:"created_at,omitempty"`
+	UpdatedAt                    time.Time               `json:"updated_at,omitempty"`
+}
+
+// NotificationEventCondition is an event that notification settings can
+// subscribe to, such as a port status change or a low balance.
+type NotificationEventCondition struct {
+	ID                   string                                `json:"id"`
+	Name                 string                                `json:"name"`
+	Description          string                                `json:"description"`
+	NotificationEventID  string                                `json:"notification_event_id"`
+	AssociatedRecordType string                                `json:"associated_record_type"`
+	Asynchronous         bool                                  `json:"asynchronous"`
+	Enabled              bool                                  `json:"enabled"`
+	SupportedChannels    []string                              `json:"supported_channels"`
+	Parameters           []NotificationEventConditionParameter `json:"parameters"`
+	CreatedAt            time.Time                             `json:"created_at"`
+	UpdatedAt            time.Time                             `json:"updated_at"`
+}
+
+// NotificationEventConditionParameter describes a parameter that settings
+// for an event condition accept.
+type NotificationEventConditionParameter struct {
+	Name     string `json:"name"`
+	DataType string `json:"data_type"`
+	Optional bool   `json:"optional"`
+}
+
+// PortabilityCheckResult tells whether a phone number can be ported to
+// Telnyx, and if not, why.
+type PortabilityCheckResult struct {
+	PhoneNumber       string `json:"phone_number"`
+	Portable          bool   `json:"portable"`
+	FastPortable      bool   `json:"fast_portable"`
+	NotPortableReason string `json:"not_portable_reason"`
+	RecordType        string `json:"record_type"`
+}
+
+// PortingOrder moves phone numbers from another carrier to Telnyx. Orders
+// start as drafts, are submitted once the end user details, LOA and invoice
+// are attached, and then move through in-process and foc-date-confirmed to
+// ported. Status.Value is one of "draft", "submitted", "in-process",
+// "exception", "foc-date-confirmed", "cancel-pending", "ported" or
+// "cancelled".
+type PortingOrder struct {
+	ID                       string                               `json:"id"`
+	RecordType               string                               `json:"record_type"`
+	CustomerReference        string                               `json:"customer_reference"`
+	SupportKey               string                               `json:"support_key"`
+	WebhookURL               string                               `json:"webhook_url"`
+	Status                   PortingOrderStatus                   `json:"status"`
+	ActivationSettings       PortingOrderActivationSettings       `json:"activation_settings"`
+	EndUser                  PortingOrderEndUser                  `json:"end_user"`
+	Documents                PortingOrderDocuments                `json:"documents"`
+	PhoneNumberConfiguration PortingOrderPhoneNumberConfiguration `json:"phone_number_configuration"`
+	PhoneNumberType          string                               `json:"phone_number_type"`
+	PortingPhoneNumbersCount int                                  `json:"porting_phone_numbers_count"`
+	RequirementsMet          bool                                 `json:"requirements_met"`
+	OldServiceProviderOCN    string                               `json:"old_service_provider_ocn"`
+	CreatedAt                time.Time                            `json:"created_at"`
+	UpdatedAt                time.Time                            `json:"updated_at"`
+}
+
+// PortingOrderStatus is the status of a porting order, with details that
+// explain exceptions.
+type PortingOrderStatus struct {
+	Value   string                     `json:"value"`
+	Details []PortingOrderStatusDetail `json:"details"`
+}
+
+type PortingOrderStatusDetail struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+// PortingOrderActivationSettings holds the requested and the confirmed firm
+// order commitment (FOC) date, when the numbers move to Telnyx.
+type PortingOrderActivationSettings struct {
+	FOCDatetimeRequested *time.Time `json:"foc_datetime_requested,omitempty"`
+	FOCDatetimeActual    *time.Time `json:"foc_datetime_actual,omitempty"`
+	FastPortEligible     bool       `json:"fast_port_eligible,omitempty"`
+	ActivationStatus     string     `json:"activation_status,omitempty"`
+}
+
+// PortingOrderEndUser identifies the current owner of the numbers, as known
+// to the losing carrier.
+type PortingOrderEndUser struct {
+	Admin    PortingOrderEndUserAdmin    `json:"admin"`
+	Location PortingOrderEndUserLocation `json:"location"`
+}
+
+type PortingOrderEndUserAdmin struct {
+	EntityName         string `json:"entity_name"`
+	AuthPersonName     string `json:"auth_person_name"`
+	BillingPhoneNumber string `json:"billing_phone_number"`
+	AccountNumber      string `json:"account_number"`
+	TaxIdentifier      string `json:"tax_identifier"`
+	PINPasscode        string `json:"pin_passcode"`
+	BusinessIdentifier string `json:"business_identifier"`
+}
+
+type PortingOrderEndUserLocation struct {
+	StreetAddress      string `json:"street_address"`
+	ExtendedAddress    string `json:"extended_address"`
+	Locality           string `json:"locality"`
+	AdministrativeArea string `json:"administrative_area"`
+	PostalCode         string `json:"postal_code"`
+	CountryCode        string `json:"country_code"`
+}
+
+// PortingOrderDocuments holds the IDs of the uploaded letter of
+// authorization (LOA) and latest invoice from the losing carrier.
+type PortingOrderDocuments struct {
+	LOA     string `json:"loa,omitempty"`
+	Invoice string `json:"invoice,omitempty"`
+}
+
+// PortingOrderPhoneNumberConfiguration is applied to the numbers once they
+// are ported.
+type PortingOrderPhoneNumberConfiguration struct {
+	ConnectionID       string   `json:"connection_id,omitempty"`
+	MessagingProfileID string   `json:"messaging_profile_id,omitempty"`
+	BillingGroupID     string   `json:"billing_group_id,omitempty"`
+	Tags               []string `json:"tags,omitempty"`
+}
+
+type CreatePortingOrderRequest struct {
+	PhoneNumbers      []string `json:"phone_numbers"`
+	CustomerReference string   `json:"customer_reference,omitempty"`
+}
+
+// UpdatePortingOrderRequest changes a draft porting order, or one in
+// exception. Nil fields are left unchanged.
+type UpdatePortingOrderRequest struct {
+	CustomerReference        *string                               `json:"customer_reference,omitempty"`
+	WebhookURL               *string                               `json:"webhook_url,omitempty"`
+	EndUser                  *PortingOrderEndUser                  `json:"end_user,omitempty"`
+	Documents                *PortingOrderDocuments                `json:"documents,omitempty"`
+	ActivationSettings       *PortingOrderActivationSettings       `json:"activation_settings,omitempty"`
+	PhoneNumberConfiguration *PortingOrderPhoneNumberConfiguration `json:"phone_number_configuration,omitempty"`
+}
+
+// PortingPhoneNumber is the status of one number of a porting order.
+type PortingPhoneNumber struct {
+	PhoneNumber        string `json:"phone_number"`
+	PortingOrderID     string `json:"porting_order_id"`
+	PortingOrderStatus string `json:"porting_order_status"`
+	SupportKey         string `json:"support_key"`
+	PhoneNumberType    string `json:"phone_number_type"`
+	ActivationStatus   string `json:"activation_status"`
+	PortabilityStatus  string `json:"portability_status"`
+	RequirementsStatus string `json:"requirements_status"`
+	RecordType         string `json:"record_type"`
+}
+
+// PortingComment is a message on a porting order, written by the user, by
+// the Telnyx porting team or by the system on status changes.
+type PortingComment struct {
+	ID             string    `json:"id"`
+	Body           string    `json:"body"`
+	PortingOrderID string    `json:"porting_order_id"`
+	UserType       string    `json:"user_type"`
+	RecordType     string    `json:"record_type"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// PhoneNumberRegulatoryRequirements lists the information Telnyx needs
+// before it can assign a phone number, which depends on the number's country
+// and type.
+type PhoneNumberRegulatoryRequirements struct {
+	PhoneNumber            string                  `json:"phone_number"`
+	PhoneNumberType        string                  `json:"phone_number_type"`
+	RecordType             string                  `json:"record_type"`
+	RegulatoryRequirements []RegulatoryRequirement `json:"regulatory_requirements"`
+}
+
+// RegulatoryRequirement describes one piece of regulatory information, such
+// as a local address or a proof of identity. FieldType is one of "textual",
+// "datetime", "address" or "document"; document requirements take the ID of
+// an uploaded Document as their value.
+type RegulatoryRequirement struct {
+	ID                 string                 `json:"id"`
+	RecordType         string                 `json:"record_type"`
+	Name               string                 `json:"name"`
+	Description        string                 `json:"description"`
+	Example            string                 `json:"example"`
+	FieldType          string                 `json:"field_type"`
+	AcceptanceCriteria map[string]interface{} `json:"acceptance_criteria"`
+}
+
+// RequirementGroup is a reusable set of regulatory requirement values for
+// one country, number type and action, such as "ordering". Number orders
+// refer to an approved group instead of repeating the values.
+type RequirementGroup struct {
+	ID                     string                        `json:"id"`
+	RecordType             string                        `json:"record_type"`
+	CountryCode            string                        `json:"country_code"`
+	PhoneNumberType        string                        `json:"phone_number_type"`
+	Action                 string                        `json:"action"`
+	Status                 string                        `json:"status"`
+	CustomerReference      string                        `json:"customer_reference"`
+	RegulatoryRequirements []RequirementGroupRequirement `json:"regulatory_requirements"`
+	CreatedAt              time.Time                     `json:"created_at"`
+	UpdatedAt              time.Time                     `json:"updated_at"`
+}
+
+// RequirementGroupRequirement is the value a requirement group holds for one
+// requirement. Status is filled in by Telnyx as it reviews the value.
+type RequirementGroupRequirement struct {
+	RequirementID string `json:"requirement_id"`
+	FieldValue    string `json:"field_value"`
+	FieldType     string `json:"field_type,omitempty"`
+	Status        string `json:"status,omitempty"`
+}
+
+type CreateRequirementGroupRequest struct {
+	CountryCode            string                        `json:"country_code"`
+	PhoneNumberType        string                        `json:"phone_number_type"`
+	Action                 string                        `json:"action"`
+	CustomerReference      string                        `json:"customer_reference,omitempty"`
+	RegulatoryRequirements []RequirementGroupRequirement `json:"regulatory_requirements,omitempty"`
+}
+
+// UpdateRequirementGroupRequest changes a requirement group. Nil fields are
+// left unchanged; requirement values are merged by requirement ID.
+type UpdateRequirementGroupRequest struct {
+	CustomerReference      *string                       `json:"customer_reference,omitempty"`
+	RegulatoryRequirements []RequirementGroupRequirement `json:"regulatory_requirements,omitempty"`
+}
+
+// Document is a file uploaded to Telnyx, such as a proof of address for a
+// regulatory requirement or the LOA of a porting order.
+type Document struct {
+	ID                string       `json:"id"`
+	RecordType        string       `json:"record_type"`
+	Filename          string       `json:"filename"`
+	ContentType       string       `json:"content_type"`
+	Size              DocumentSize `json:"size"`
+	SHA256            string       `json:"sha256"`
+	Status            string       `json:"status"`
+	AVScanStatus      string       `json:"av_scan_status"`
+	CustomerReference string       `json:"customer_reference"`
+	CreatedAt         time.Time    `json:"created_at"`
+	UpdatedAt         time.Time    `json:"updated_at"`
+}
+
+type DocumentSize struct {
+	Amount int64  `json:"amount"`
+	Unit   string `json:"unit"`
+}
+
+// UpdateDocumentRequest changes the metadata of a document. Nil fields are
+// left unchanged; the file itself cannot be replaced.
+type UpdateDocumentRequest struct {
+	Filename          *string `json:"filename,omitempty"`
+	CustomerReference *string `json:"customer_reference,omitempty"`
+}
+
+// Lookup types select the optional parts of a number lookup.
+const (
+	LookupTypeCarrier    = "carrier"
+	LookupTypeCallerName = "caller-name"
+)
+
+// NumberLookup is what Telnyx knows about a phone number. Carrier and
+// CallerName are only set when the lookup asked for them.
+type NumberLookup struct {
+	RecordType     string                   `json:"record_type"`
+	PhoneNumber    string                   `json:"phone_number"`
+	CountryCode    string                   `json:"country_code"`
+	NationalFormat string                   `json:"national_format"`
+	Carrier        *NumberLookupCarrier     `json:"carrier"`
+	CallerName     *NumberLookupCallerName  `json:"caller_name"`
+	Portability    *NumberLookupPortability `json:"portability"`
+}
+
+type NumberLookupCarrier struct {
+	Name              string `json:"name"`
+	NormalizedCarrier string `json:"normalized_carrier"`
+	// Type is the line type, such as mobile, fixed line, voip or toll free.
+	Type              string `json:"type"`
+	MobileCountryCode string `json:"mobile_country_code"`
+	MobileNetworkCode string `json:"mobile_network_code"`
+	ErrorCode         string `json:"error_code"`
+}
+
+type NumberLookupCallerName struct {
+	CallerName string `json:"caller_name"`
+	ErrorCode  string `json:"error_code"`
+}
+
+type NumberLookupPortability struct {
+	LRN string `json:"lrn"`
+	// PortedStatus is Y when the number has been ported from the carrier
+	// it was first assigned to, and N otherwise.
+	PortedStatus    string `json:"ported_status"`
+	PortedDate      string `json:"ported_date"`
+	OCN             string `json:"ocn"`
+	LineType        string `json:"line_type"`
+	SPID            string `json:"spid"`
+	SPIDCarrierName string `json:"spid_carrier_name"`
+	SPIDCarrierType string `json:"spid_carrier_type"`
+	City            string `json:"city"`
+	State           string `json:"state"`
 }
 
 // TelnyxErrorDetail represents individual error details from the Telnyx API
 type TelnyxErrorDetail struct {
-	Code   string            `json:"code"`
-	Title  string            `json:"title"`
-	Detail string            `json:"detail"`
-	Source map[string]string `json:"source"`
-	Meta   map[string]string `json:"meta"`
-}
-
-// Error implements the error interface for TelnyxError
-func (e *TelnyxError) Error() string {
-	if len(e.Errors) == 0 {
-		return "unknown Telnyx API error"
-	}
-	msgBytes, err := json.Marshal(e.Errors)
-	if err != nil {
-		return "unknown Telnyx API error"
-	}
-	return string(msgBytes)
-}
-
-// https://developers.telnyx.com/api/errors/10005
-func (e *TelnyxError) IsResourceNotFound() bool {
-	if len(e.Errors) != 1 {
-		return false
-	}
-	return e.Errors[0].Code == "10005"
+	Code   string                 `json:"code"`
+	Title  string                 `json:"title"`
+	Detail string                 `json:"detail"`
+	Source map[string]string      `json:"source"`
+	Meta   map[string]interface{} `json:"meta"`
 }
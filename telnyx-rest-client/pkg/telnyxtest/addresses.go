@@ -0,0 +1,192 @@
+package telnyxtest
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	usPostalCodePattern = regexp.MustCompile(`^[0-9]{5}(-[0-9]{4})?$`)
+	usStatePattern      = regexp.MustCompile(`^[A-Za-z]{2}$`)
+	houseNumberPattern  = regexp.MustCompile(`^[0-9]+[A-Za-z]?\s`)
+)
+
+// streetSuffixes are the USPS abbreviations the fake suggests for common
+// street suffixes.
+var streetSuffixes = map[string]string{
+	"street": "St", "avenue": "Ave", "boulevard": "Blvd", "drive": "Dr",
+	"road": "Rd", "lane": "Ln", "court": "Ct", "place": "Pl", "suite": "Ste",
+}
+
+var addressValidationFields = []string{"street_address", "extended_address", "locality", "administrative_area", "postal_code", "country_code"}
+
+// checkAddress validates the location fields of an address the way Telnyx
+// does for emergency use, returning the suggested form of the address and
+// the reasons it is invalid. Only US addresses are checked strictly: they
+// need a house number, a two letter state and a ZIP code. Suggestions
+// abbreviate street suffixes, upper-case the state and drop ZIP+4
+// extensions.
+func checkAddress(body map[string]interface{}) (map[string]interface{}, []apiError) {
+	suggested := map[string]interface{}{}
+	for _, field := range addressValidationFields {
+		suggested[field] = strings.Join(strings.Fields(stringField(body, field)), " ")
+	}
+	var errs []apiError
+	for _, field := range []string{"street_address", "locality", "country_code"} {
+		if suggested[field] == "" {
+			errs = append(errs, apiError{Code: "10032", Title: "Missing required parameter", Detail: fmt.Sprintf("The '%s' parameter is required.", field), Source: map[string]string{"pointer": "/" + field}})
+		}
+	}
+	if strings.ToUpper(suggested["country_code"].(string)) != "US" {
+		return suggested, errs
+	}
+
+	street := strings.Fields(suggested["street_address"].(string))
+	for i, word := range street {
+		if abbreviation, ok := streetSuffixes[strings.ToLower(word)]; ok && i > 0 {
+			street[i] = abbreviation
+		}
+	}
+	suggested["street_address"] = strings.Join(street, " ")
+	suggested["administrative_area"] = strings.ToUpper(suggested["administrative_area"].(string))
+	suggested["postal_code"] = strings.SplitN(suggested["postal_code"].(string), "-", 2)[0]
+
+	if !houseNumberPattern.MatchString(stringField(body, "street_address")) {
+		errs = append(errs, validationError("/street_address", "The street address must start with a house number."))
+	}
+	if !usStatePattern.MatchString(stringField(body, "administrative_area")) {
+		errs = append(errs, validationError("/administrative_area", "The administrative area must be a two letter state code."))
+	}
+	if !usPostalCodePattern.MatchString(stringField(body, "postal_code")) {
+		errs = append(errs, validationError("/postal_code", "The postal code must be a five digit ZIP code."))
+	}
+	return suggested, errs
+}
+
+func (server *Server) handleValidateAddress(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, apiError{Code: "10002", Title: "Invalid request body", Detail: err.Error()})
+		return
+	}
+
+	suggested, errs := checkAddress(body)
+	result := "valid"
+	if len(errs) > 0 {
+		result = "invalid"
+	} else {
+		errs = []apiError{}
+	}
+	writeData(w, http.StatusOK, map[string]interface{}{
+		"record_type": "address_validation",
+		"result":      result,
+		"suggested":   suggested,
+		"errors":      errs,
+	})
+}
+
+func (server *Server) handleCreateAddress(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, apiError{Code: "10002", Title: "Invalid request body", Detail: err.Error()})
+		return
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	records := server.collections["addresses"]
+	for field, value := range records.spec.defaults {
+		if _, ok := body[field]; !ok {
+			body[field] = value
+		}
+	}
+	errs := server.validate(records, "", body, true)
+	if validate, _ := body["validate_address"].(bool); validate && len(errs) == 0 {
+		_, errs = checkAddress(body)
+	}
+	if len(errs) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, errs...)
+		return
+	}
+	for _, field := range []string{"customer_reference", "phone_number", "extended_address", "administrative_area", "neighborhood", "borough", "postal_code"} {
+		if _, ok := body[field]; !ok {
+			body[field] = ""
+		}
+	}
+	server.stamp(records.spec, body)
+	records.insert(body)
+	writeData(w, http.StatusOK, body)
+}
+
+func (server *Server) handleDeleteAddress(w http.ResponseWriter, r *http.Request) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	records := server.collections["addresses"]
+	id := r.PathValue("id")
+	record, ok := records.objects[id]
+	if !ok {
+		notFound(w, "address", id)
+		return
+	}
+	for _, phoneNumber := range server.collections["phone_numbers"].objects {
+		if phoneNumber["emergency_address_id"] == id {
+			writeError(w, http.StatusUnprocessableEntity, apiError{Code: "10015", Title: "Address in use", Detail: fmt.Sprintf("The address is the emergency address of %s. Disable emergency calling on the number first.", phoneNumber["phone_number"])})
+			return
+		}
+	}
+	records.remove(id)
+	writeData(w, http.StatusOK, record)
+}
+
+// handleEnableEmergency switches emergency calling on or off. Unlike Telnyx,
+// which provisions the address with the carrier in the background, the fake
+// activates it immediately.
+func (server *Server) handleEnableEmergency(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, apiError{Code: "10002", Title: "Invalid request body", Detail: err.Error()})
+		return
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	id := r.PathValue("id")
+	phoneNumber, ok := server.collections["phone_numbers"].objects[id]
+	if !ok {
+		notFound(w, "phone_number", id)
+		return
+	}
+	enabled, _ := body["emergency_enabled"].(bool)
+	addressID := stringField(body, "emergency_address_id")
+	if enabled {
+		address, ok := server.collections["addresses"].objects[addressID]
+		if !ok {
+			writeError(w, http.StatusUnprocessableEntity, validationError("/emergency_address_id", fmt.Sprintf("The emergency_address_id %q does not exist.", addressID)))
+			return
+		}
+		if validated, _ := address["validate_address"].(bool); !validated {
+			writeError(w, http.StatusUnprocessableEntity, validationError("/emergency_address_id", "The address was not validated for emergency use."))
+			return
+		}
+	} else {
+		addressID = ""
+	}
+
+	status := "disabled"
+	if enabled {
+		status = "active"
+	}
+	phoneNumber["emergency_enabled"] = enabled
+	phoneNumber["emergency_address_id"] = addressID
+	phoneNumber["updated_at"] = timestamp(time.Now())
+	settings, _ := server.settingsFor(phoneNumberSettingsSpec("voice"), id)
+	settings["emergency"] = map[string]interface{}{
+		"emergency_enabled":    enabled,
+		"emergency_address_id": addressID,
+		"emergency_status":     status,
+	}
+	writeData(w, http.StatusOK, settings)
+}
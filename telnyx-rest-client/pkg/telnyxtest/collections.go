@@ -0,0 +1,645 @@
+package telnyxtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// collectionSpec describes how the fake treats one Telnyx collection.
+type collectionSpec struct {
+	// name is the path segment, such as "billing_groups".
+	name       string
+	recordType string
+	// numericIDs gives records Telnyx style numeric IDs instead of UUIDs.
+	numericIDs bool
+
+	creatable, updatable, deletable bool
+
+	// required fields must be present and non-empty on create.
+	required []string
+	// enums restrict non-empty values of the given fields.
+	enums map[string][]string
+	// references name the collections that IDs in the given fields must
+	// exist in. Empty values are allowed.
+	references map[string][]string
+	// unique fields must not repeat across records.
+	unique []string
+	// defaults are applied on create to fields the request left out.
+	defaults map[string]interface{}
+	// generated fields are filled on create by calling the function.
+	generated map[string]func() interface{}
+	// stringFields are always stored as strings, the way Telnyx returns
+	// them, even when a request sends a number.
+	stringFields []string
+	// refresh, when set, recomputes derived fields of a record before it is
+	// returned by get or list. It runs with the server lock held.
+	refresh func(server *Server, record map[string]interface{})
+	// fixtures are records every server starts with, for collections such
+	// as event catalogs that Telnyx maintains.
+	fixtures []map[string]interface{}
+	// filterFields map list filters named differently from the field they
+	// match, such as filter[tag] matching tags.
+	filterFields map[string]string
+}
+
+var connectionCollections = []string{"credential_connections", "fqdn_connections", "ip_connections", "texml_applications", "call_control_applications"}
+
+var collectionSpecs = []collectionSpec{
+	{
+		name: "billing_groups", recordType: "billing_group",
+		creatable: true, updatable: true, deletable: true,
+		required: []string{"name"},
+		defaults: map[string]interface{}{"organization_id": "f1486bae-f067-460c-ad43-73a92848f902"},
+	},
+	{
+		name: "outbound_voice_profiles", recordType: "outbound_voice_profile",
+		numericIDs: true,
+		creatable:  true, updatable: true, deletable: true,
+		required:   []string{"name"},
+		enums:      map[string][]string{"traffic_type": {"conversational"}, "service_plan": {"global"}, "usage_payment_method": {"rate-deck"}},
+		references: map[string][]string{"billing_group_id": {"billing_groups"}},
+		defaults: map[string]interface{}{
+			"traffic_type": "conversational", "service_plan": "global", "usage_payment_method": "rate-deck",
+			"enabled": true, "connections_count": 0, "tags": []interface{}{}, "whitelisted_destinations": []interface{}{"US", "CA"},
+		},
+	},
+	{
+		name: "messaging_profiles", recordType: "messaging_profile",
+		creatable: true, updatable: true, deletable: true,
+		required: []string{"name"},
+		enums:    map[string][]string{"webhook_api_version": {"1", "2", "2010-04-01"}},
+		defaults: map[string]interface{}{"enabled": true, "webhook_api_version": "2", "whitelisted_destinations": []interface{}{"US"}},
+		generated: map[string]func() interface{}{
+			"v1_secret": func() interface{} { return strings.ReplaceAll(newUUID(), "-", "")[:24] },
+		},
+	},
+	{
+		name: "credential_connections", recordType: "credential_connection",
+		numericIDs: true,
+		creatable:  true, updatable: true, deletable: true,
+		required:   []string{"connection_name", "user_name", "password"},
+		unique:     []string{"user_name"},
+		enums:      map[string][]string{"webhook_api_version": {"1", "2"}},
+		references: map[string][]string{"outbound/outbound_voice_profile_id": {"outbound_voice_profiles"}},
+		defaults:   map[string]interface{}{"active": true, "anchorsite_override": "Latency", "dtmf_type": "RFC 2833"},
+	},
+	{
+		name: "fqdn_connections", recordType: "fqdn_connection",
+		numericIDs: true,
+		creatable:  true, updatable: true, deletable: true,
+		required:   []string{"connection_name"},
+		enums:      map[string][]string{"webhook_api_version": {"1", "2"}},
+		references: map[string][]string{"outbound/outbound_voice_profile_id": {"outbound_voice_profiles"}},
+		defaults:   map[string]interface{}{"active": true, "anchorsite_override": "Latency", "dtmf_type": "RFC 2833", "transport_protocol": "UDP"},
+	},
+	{
+		name: "fqdns", recordType: "fqdn",
+		numericIDs: true,
+		creatable:  true, updatable: true, deletable: true,
+		required:   []string{"fqdn", "connection_id", "dns_record_type"},
+		enums:      map[string][]string{"dns_record_type": {"a", "srv"}},
+		references: map[string][]string{"connection_id": {"fqdn_connections"}},
+		defaults:   map[string]interface{}{"port": 5060},
+	},
+	{
+		name: "ip_connections", recordType: "ip_connection",
+		numericIDs: true,
+		creatable:  true, updatable: true, deletable: true,
+		required:   []string{"connection_name"},
+		enums:      map[string][]string{"webhook_api_version": {"1", "2"}},
+		references: map[string][]string{"outbound/outbound_voice_profile_id": {"outbound_voice_profiles"}},
+		defaults:   map[string]interface{}{"active": true, "anchorsite_override": "Latency", "dtmf_type": "RFC 2833", "transport_protocol": "UDP"},
+	},
+	{
+		name: "ips", recordType: "ip",
+		creatable: true, updatable: true, deletable: true,
+		required:     []string{"ip_address", "connection_id"},
+		references:   map[string][]string{"connection_id": {"ip_connections"}},
+		stringFields: []string{"connection_id"},
+		defaults:     map[string]interface{}{"port": 5060},
+	},
+	{
+		name: "texml_applications", recordType: "texml_application",
+		numericIDs: true,
+		creatable:  true, updatable: true, deletable: true,
+		required:   []string{"friendly_name", "voice_url"},
+		enums:      map[string][]string{"voice_method": {"get", "post"}},
+		references: map[string][]string{"outbound/outbound_voice_profile_id": {"outbound_voice_profiles"}},
+		defaults:   map[string]interface{}{"active": true, "voice_method": "post"},
+	},
+	{
+		name: "call_control_applications", recordType: "call_control_application",
+		numericIDs: true,
+		creatable:  true, updatable: true, deletable: true,
+		required:   []string{"application_name", "webhook_event_url"},
+		enums:      map[string][]string{"webhook_api_version": {"1", "2"}},
+		references: map[string][]string{"outbound/outbound_voice_profile_id": {"outbound_voice_profiles"}},
+		defaults:   map[string]interface{}{"active": true, "webhook_api_version": "2", "webhook_timeout_secs": 25},
+	},
+	{
+		// Number orders are created and updated by handlers in numbers.go
+		// and regulatory.go, which track their regulatory requirements.
+		name: "number_orders", recordType: "number_order",
+	},
+	{
+		name: "sub_number_orders", recordType: "sub_number_order",
+	},
+	{
+		name: "number_reservations", recordType: "number_reservation",
+		deletable: true,
+	},
+	{
+		// Requirement groups are created and updated by handlers in
+		// regulatory.go, which check their values against the catalog.
+		name: "requirement_groups", recordType: "requirement_group",
+		deletable: true,
+		required:  []string{"country_code", "phone_number_type", "action"},
+		enums:     map[string][]string{"phone_number_type": {"local", "toll_free", "mobile", "national"}, "action": {"ordering", "porting"}},
+	},
+	{
+		// Documents are uploaded and downloaded by handlers in
+		// regulatory.go.
+		name: "documents", recordType: "document",
+		updatable: true, deletable: true,
+	},
+	{
+		name: "notification_profiles", recordType: "notification_profile",
+		creatable: true, updatable: true, deletable: true,
+		required: []string{"name"},
+	},
+	{
+		name: "notification_channels", recordType: "notification_channel",
+		creatable: true, updatable: true, deletable: true,
+		required:   []string{"notification_profile_id", "channel_type_id", "channel_destination"},
+		enums:      map[string][]string{"channel_type_id": {"email", "sms", "voice", "webhook"}},
+		references: map[string][]string{"notification_profile_id": {"notification_profiles"}},
+	},
+	{
+		// Notification settings are created by a handler in
+		// notifications.go, which checks the channel, condition and
+		// associated record fit together. Telnyx cannot update them.
+		name: "notification_settings", recordType: "notification_setting",
+		deletable: true,
+		required:  []string{"notification_event_condition_id", "notification_profile_id", "notification_channel_id"},
+		references: map[string][]string{
+			"notification_event_condition_id": {"notification_event_conditions"},
+			"notification_profile_id":         {"notification_profiles"},
+			"notification_channel_id":         {"notification_channels"},
+		},
+	},
+	{
+		name: "notification_event_conditions", recordType: "notification_event_condition",
+		fixtures: notificationEventConditions,
+	},
+	{
+		// Telephony credentials are created and updated by handlers in
+		// credentials.go, which generate the SIP credentials and track
+		// expiry.
+		name: "telephony_credentials", recordType: "credential",
+		deletable:  true,
+		required:   []string{"connection_id"},
+		references: map[string][]string{"connection_id": {"credential_connections"}},
+		refresh: func(_ *Server, record map[string]interface{}) {
+			refreshCredentialExpiry(record)
+		},
+	},
+	{
+		// Porting orders are created, changed, submitted, cancelled and
+		// deleted by handlers in porting.go, which also move submitted
+		// orders along their statuses.
+		name: "porting_orders", recordType: "porting_order",
+		refresh: (*Server).advancePortingOrder,
+	},
+	{
+		name: "porting_phone_numbers", recordType: "porting_phone_number",
+	},
+	{
+		// Addresses are created and deleted by handlers in addresses.go,
+		// which validate them and protect addresses in use.
+		name: "addresses", recordType: "address",
+		required: []string{"first_name", "last_name", "business_name", "street_address", "locality", "country_code"},
+		defaults: map[string]interface{}{"address_book": true, "validate_address": true},
+	},
+	{
+		name: "phone_numbers", recordType: "phone_number",
+		numericIDs: true,
+		updatable:  true, deletable: true,
+		stringFields: []string{"connection_id", "messaging_profile_id", "billing_group_id"},
+		references: map[string][]string{
+			"connection_id":        connectionCollections,
+			"messaging_profile_id": {"messaging_profiles"},
+			"billing_group_id":     {"billing_groups"},
+		},
+		filterFields: map[string]string{
+			"tag":                   "tags",
+			"voice.connection_name": "connection_name",
+			"number_type":           "phone_number_type",
+		},
+	},
+}
+
+type collection struct {
+	spec    collectionSpec
+	objects map[string]map[string]interface{}
+	order   []string
+}
+
+func newCollection(spec collectionSpec) *collection {
+	return &collection{spec: spec, objects: map[string]map[string]interface{}{}}
+}
+
+func (records *collection) insert(record map[string]interface{}) {
+	id := record["id"].(string)
+	if _, exists := records.objects[id]; !exists {
+		records.order = append(records.order, id)
+	}
+	records.objects[id] = record
+}
+
+func (records *collection) remove(id string) {
+	delete(records.objects, id)
+	for i, existing := range records.order {
+		if existing == id {
+			records.order = append(records.order[:i], records.order[i+1:]...)
+			break
+		}
+	}
+}
+
+func (server *Server) handleCreate(name string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := decodeBody(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, apiError{Code: "10002", Title: "Invalid request body", Detail: err.Error()})
+			return
+		}
+
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		records := server.collections[name]
+		for _, field := range []string{"id", "record_type", "created_at", "updated_at"} {
+			delete(body, field)
+		}
+		for field, value := range records.spec.defaults {
+			if _, ok := body[field]; !ok {
+				body[field] = value
+			}
+		}
+		if errs := server.validate(records, "", body, true); len(errs) > 0 {
+			writeError(w, http.StatusUnprocessableEntity, errs...)
+			return
+		}
+		coerceStrings(records.spec, body)
+		for field, generate := range records.spec.generated {
+			body[field] = generate()
+		}
+		server.stamp(records.spec, body)
+		records.insert(body)
+		writeData(w, http.StatusOK, body)
+	}
+}
+
+func (server *Server) handleGet(name string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		records := server.collections[name]
+		record, ok := records.objects[r.PathValue("id")]
+		if !ok {
+			notFound(w, records.spec.recordType, r.PathValue("id"))
+			return
+		}
+		if records.spec.refresh != nil {
+			records.spec.refresh(server, record)
+		}
+		writeData(w, http.StatusOK, record)
+	}
+}
+
+func (server *Server) handleUpdate(name string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := decodeBody(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, apiError{Code: "10002", Title: "Invalid request body", Detail: err.Error()})
+			return
+		}
+
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		records := server.collections[name]
+		id := r.PathValue("id")
+		record, ok := records.objects[id]
+		if !ok {
+			notFound(w, records.spec.recordType, id)
+			return
+		}
+		for _, field := range []string{"id", "record_type", "created_at", "updated_at"} {
+			delete(body, field)
+		}
+		if errs := server.validate(records, id, body, false); len(errs) > 0 {
+			writeError(w, http.StatusUnprocessableEntity, errs...)
+			return
+		}
+		coerceStrings(records.spec, body)
+		updated := cloneObject(record)
+		mergeObject(updated, body)
+		server.stamp(records.spec, updated)
+		records.insert(updated)
+		writeData(w, http.StatusOK, updated)
+	}
+}
+
+func (server *Server) handleDelete(name string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		records := server.collections[name]
+		id := r.PathValue("id")
+		record, ok := records.objects[id]
+		if !ok {
+			notFound(w, records.spec.recordType, id)
+			return
+		}
+		records.remove(id)
+		writeData(w, http.StatusOK, record)
+	}
+}
+
+func (server *Server) handleList(name string) http.HandlerFunc {
+	return server.handlePage(func(query url.Values) []map[string]interface{} {
+		records := server.collections[name]
+		matches := []map[string]interface{}{}
+		for _, id := range records.order {
+			if records.spec.refresh != nil {
+				records.spec.refresh(server, records.objects[id])
+			}
+			if matchesFilters(records.objects[id], query, records.spec.filterFields) {
+				matches = append(matches, records.objects[id])
+			}
+		}
+		return matches
+	})
+}
+
+// handlePage serves one page of the records that list returns for the
+// request's query. list runs with the server locked.
+func (server *Server) handlePage(list func(query url.Values) []map[string]interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		pageNumber, pageSize := 1, 20
+		if value := query.Get("page[number]"); value != "" {
+			pageNumber, _ = strconv.Atoi(value)
+		}
+		if value := query.Get("page[size]"); value != "" {
+			pageSize, _ = strconv.Atoi(value)
+		}
+		if pageNumber < 1 {
+			writeError(w, http.StatusUnprocessableEntity, validationError("/page/number", "page[number] must be at least 1."))
+			return
+		}
+		if pageSize < 1 || pageSize > 250 {
+			writeError(w, http.StatusUnprocessableEntity, validationError("/page/size", "page[size] must be between 1 and 250."))
+			return
+		}
+
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		matches := list(query)
+
+		totalPages := (len(matches) + pageSize - 1) / pageSize
+		start := (pageNumber - 1) * pageSize
+		end := start + pageSize
+		if start > len(matches) {
+			start = len(matches)
+		}
+		if end > len(matches) {
+			end = len(matches)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"data": matches[start:end],
+			"meta": map[string]int{
+				"page_number":   pageNumber,
+				"page_size":     pageSize,
+				"total_pages":   totalPages,
+				"total_results": len(matches),
+			},
+		})
+	}
+}
+
+// handleListConnections lists every kind of connection in the summary form
+// of GET /connections, which supports filter[connection_name][contains] and
+// filter[outbound_voice_profile_id].
+func (server *Server) handleListConnections() http.HandlerFunc {
+	return server.handlePage(func(query url.Values) []map[string]interface{} {
+		matches := []map[string]interface{}{}
+		for _, name := range connectionCollections {
+			records := server.collections[name]
+			for _, id := range records.order {
+				record := records.objects[id]
+				connection := map[string]interface{}{
+					"id":                        id,
+					"record_type":               records.spec.recordType,
+					"connection_name":           server.connectionName(id),
+					"active":                    record["active"],
+					"outbound_voice_profile_id": lookup(record, "outbound/outbound_voice_profile_id"),
+					"created_at":                record["created_at"],
+					"updated_at":                record["updated_at"],
+				}
+				if matchesFilters(connection, query, nil) {
+					matches = append(matches, connection)
+				}
+			}
+		}
+		return matches
+	})
+}
+
+// validate checks a create or update body against the collection spec.
+// id is the record being updated, or empty on create.
+func (server *Server) validate(records *collection, id string, body map[string]interface{}, create bool) []apiError {
+	var errs []apiError
+	spec := records.spec
+
+	if create {
+		for _, field := range spec.required {
+			if isBlank(lookup(body, field)) {
+				errs = append(errs, apiError{Code: "10032", Title: "Missing required parameter", Detail: fmt.Sprintf("The '%s' parameter is required.", field), Source: map[string]string{"pointer": "/" + field}})
+			}
+		}
+	}
+
+	for _, field := range sortedKeys(spec.enums) {
+		value, ok := lookup(body, field).(string)
+		if !ok || value == "" {
+			continue
+		}
+		if !contains(spec.enums[field], value) {
+			errs = append(errs, validationError("/"+field, fmt.Sprintf("The value %q is not one of %s.", value, strings.Join(spec.enums[field], ", "))))
+		}
+	}
+
+	for _, field := range sortedKeys(spec.references) {
+		value := lookup(body, field)
+		if isBlank(value) {
+			continue
+		}
+		reference := fmt.Sprint(value)
+		found := false
+		for _, target := range spec.references[field] {
+			if _, ok := server.collections[target].objects[reference]; ok {
+				found = true
+				break
+			}
+		}
+		if !found {
+			errs = append(errs, validationError("/"+field, fmt.Sprintf("The %s %q does not exist.", field, reference)))
+		}
+	}
+
+	for _, field := range spec.unique {
+		value := lookup(body, field)
+		if isBlank(value) {
+			continue
+		}
+		for otherID, other := range records.objects {
+			if otherID != id && fmt.Sprint(lookup(other, field)) == fmt.Sprint(value) {
+				errs = append(errs, apiError{Code: "10015", Title: "Value already taken", Detail: fmt.Sprintf("The %s %q is already in use.", field, fmt.Sprint(value)), Source: map[string]string{"pointer": "/" + field}})
+			}
+		}
+	}
+
+	return errs
+}
+
+// matchesFilters applies Telnyx style filter query parameters:
+// filter[field]=value for equality, filter[field][contains|starts_with|ends_with|eq]
+// for string matching and filter[parent][child]=value for nested fields.
+// fields renames filters to the record fields they match.
+func matchesFilters(record map[string]interface{}, query map[string][]string, fields map[string]string) bool {
+	for key, values := range query {
+		if !strings.HasPrefix(key, "filter[") || len(values) == 0 {
+			continue
+		}
+		segments := strings.Split(strings.TrimSuffix(strings.TrimPrefix(key, "filter["), "]"), "][")
+		operator := "eq"
+		if last := segments[len(segments)-1]; len(segments) > 1 && contains([]string{"eq", "contains", "starts_with", "ends_with"}, last) {
+			operator = last
+			segments = segments[:len(segments)-1]
+		}
+		field := strings.Join(segments, "/")
+		if renamed, ok := fields[field]; ok {
+			field = renamed
+		}
+		actual := lookup(record, field)
+		if actual == nil {
+			return false
+		}
+		if !matchValue(actual, operator, values[0]) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchValue(actual interface{}, operator, expected string) bool {
+	if list, ok := actual.([]interface{}); ok {
+		for _, item := range list {
+			if matchValue(item, operator, expected) {
+				return true
+			}
+		}
+		return false
+	}
+	value := fmt.Sprint(actual)
+	switch operator {
+	case "contains":
+		return strings.Contains(strings.ToLower(value), strings.ToLower(expected))
+	case "starts_with":
+		return strings.HasPrefix(value, expected)
+	case "ends_with":
+		return strings.HasSuffix(value, expected)
+	default:
+		return value == expected
+	}
+}
+
+// lookup returns the value at a slash separated path such as
+// "outbound/outbound_voice_profile_id".
+func lookup(record map[string]interface{}, path string) interface{} {
+	var current interface{} = record
+	for _, segment := range strings.Split(path, "/") {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		current = object[segment]
+	}
+	return current
+}
+
+func isBlank(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case json.Number:
+		return v.String() == "0"
+	default:
+		return false
+	}
+}
+
+func coerceStrings(spec collectionSpec, body map[string]interface{}) {
+	for _, field := range spec.stringFields {
+		if value, ok := body[field]; ok && value != nil {
+			body[field] = fmt.Sprint(value)
+		}
+	}
+}
+
+// mergeObject applies a PATCH body, merging nested objects field by field.
+func mergeObject(dst, src map[string]interface{}) {
+	for key, value := range src {
+		if nested, ok := value.(map[string]interface{}); ok {
+			if existing, ok := dst[key].(map[string]interface{}); ok {
+				mergeObject(existing, nested)
+				continue
+			}
+		}
+		dst[key] = value
+	}
+}
+
+func cloneObject(record map[string]interface{}) map[string]interface{} {
+	encoded, _ := json.Marshal(record)
+	var clone map[string]interface{}
+	decoder := json.NewDecoder(strings.NewReader(string(encoded)))
+	decoder.UseNumber()
+	_ = decoder.Decode(&clone)
+	return clone
+}
+
+func contains(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,145 @@
+package telnyxtest
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// refreshCredentialExpiry marks a telephony credential expired once its
+// expires_at has passed, as Telnyx does.
+func refreshCredentialExpiry(record map[string]interface{}) {
+	expiresAt := stringField(record, "expires_at")
+	if expiresAt == "" {
+		return
+	}
+	if at, err := time.Parse(time.RFC3339Nano, expiresAt); err == nil && !at.After(time.Now()) {
+		record["expired"] = true
+	}
+}
+
+// applyCredentialFields copies the writable fields of a create or update
+// request onto a telephony credential, rejecting expiry times that are not
+// ISO 8601.
+func applyCredentialFields(record, body map[string]interface{}) []apiError {
+	for _, field := range []string{"name", "tag"} {
+		if _, ok := body[field]; ok {
+			record[field] = stringField(body, field)
+		}
+	}
+	if connectionID := stringField(body, "connection_id"); connectionID != "" {
+		record["resource_id"] = "connection:" + connectionID
+	}
+	if _, ok := body["expires_at"]; ok {
+		expiresAt := stringField(body, "expires_at")
+		at, err := time.Parse(time.RFC3339Nano, expiresAt)
+		if expiresAt != "" && err != nil {
+			return []apiError{validationError("/expires_at", fmt.Sprintf("%q is not an ISO 8601 timestamp.", expiresAt))}
+		}
+		record["expires_at"] = nil
+		record["expired"] = false
+		if expiresAt != "" {
+			record["expires_at"] = timestamp(at)
+		}
+	}
+	refreshCredentialExpiry(record)
+	return nil
+}
+
+func (server *Server) handleCreateTelephonyCredential(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, apiError{Code: "10002", Title: "Invalid request body", Detail: err.Error()})
+		return
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	records := server.collections["telephony_credentials"]
+	if errs := server.validate(records, "", body, true); len(errs) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, errs...)
+		return
+	}
+	credential := map[string]interface{}{
+		"name":         "",
+		"tag":          "",
+		"user_id":      "f1486bae-f067-460c-ad43-73a92848f902",
+		"sip_username": "gencred" + randomToken(13),
+		"sip_password": randomToken(16),
+		"expires_at":   nil,
+		"expired":      false,
+	}
+	if errs := applyCredentialFields(credential, body); len(errs) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, errs...)
+		return
+	}
+	server.stamp(records.spec, credential)
+	records.insert(credential)
+	writeData(w, http.StatusOK, credential)
+}
+
+func (server *Server) handleUpdateTelephonyCredential(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, apiError{Code: "10002", Title: "Invalid request body", Detail: err.Error()})
+		return
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	records := server.collections["telephony_credentials"]
+	id := r.PathValue("id")
+	record, ok := records.objects[id]
+	if !ok {
+		notFound(w, records.spec.recordType, id)
+		return
+	}
+	if errs := server.validate(records, id, body, false); len(errs) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, errs...)
+		return
+	}
+	updated := cloneObject(record)
+	if errs := applyCredentialFields(updated, body); len(errs) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, errs...)
+		return
+	}
+	server.stamp(records.spec, updated)
+	records.insert(updated)
+	writeData(w, http.StatusOK, updated)
+}
+
+// handleCreateTelephonyCredentialToken answers with a bare token in
+// text/plain, like Telnyx. The token only looks like a JWT.
+func (server *Server) handleCreateTelephonyCredentialToken(w http.ResponseWriter, r *http.Request) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	records := server.collections["telephony_credentials"]
+	id := r.PathValue("id")
+	record, ok := records.objects[id]
+	if !ok {
+		notFound(w, records.spec.recordType, id)
+		return
+	}
+	refreshCredentialExpiry(record)
+	if expired, _ := record["expired"].(bool); expired {
+		writeError(w, http.StatusUnprocessableEntity, apiError{Code: "10015", Title: "Credential expired", Detail: fmt.Sprintf("The credential %q has expired.", id)})
+		return
+	}
+
+	encode := base64.RawURLEncoding.EncodeToString
+	token := encode([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." +
+		encode([]byte(fmt.Sprintf(`{"sub":%q,"exp":%d}`, record["sip_username"], time.Now().Add(24*time.Hour).Unix()))) + "." +
+		randomToken(16)
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write([]byte(token))
+}
+
+func randomToken(size int) string {
+	b := make([]byte, size)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
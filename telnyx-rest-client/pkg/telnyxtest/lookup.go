@@ -0,0 +1,127 @@
+package telnyxtest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// Numbers the fake number lookup describes in a fixed way, so tests can
+// check how lookups of mobile, landline and ported numbers are handled.
+const (
+	LookupMobileNumber   = "+13125550101"
+	LookupLandlineNumber = "+13125550102"
+	LookupPortedNumber   = "+13125550103"
+)
+
+// lookupCarrier describes the carrier and line of a number the fake looks up.
+type lookupCarrier struct {
+	name, lineType, callerName string
+	ported                     bool
+}
+
+var lookupFixtures = map[string]lookupCarrier{
+	LookupMobileNumber:   {name: "T-Mobile USA, Inc.", lineType: "mobile", callerName: "WIRELESS CALLER"},
+	LookupLandlineNumber: {name: "AT&T Illinois", lineType: "fixed line", callerName: "ACME PLUMBING"},
+	LookupPortedNumber:   {name: "Verizon Wireless", lineType: "mobile", callerName: "WIRELESS CALLER", ported: true},
+}
+
+var lookupTypes = []string{"carrier", "caller-name"}
+
+// handleNumberLookup answers a number lookup. Numbers on the account are
+// Telnyx VoIP numbers and numbers ported in by a completed porting order
+// are reported as ported; the lookup fixtures describe a few more, and any
+// other number is an unported mobile.
+func (server *Server) handleNumberLookup(w http.ResponseWriter, r *http.Request) {
+	phoneNumber := r.PathValue("phone_number")
+	if !strings.HasPrefix(phoneNumber, "+") || len(digitsOnly(phoneNumber)) < 8 || digitsOnly(phoneNumber) != phoneNumber[1:] {
+		writeError(w, http.StatusBadRequest, apiError{Code: "10015", Title: "Invalid phone number", Detail: fmt.Sprintf("%q is not a valid E.164 phone number.", phoneNumber), Source: map[string]string{"parameter": "phone_number"}})
+		return
+	}
+	requested := r.URL.Query()["type"]
+	for _, lookupType := range requested {
+		if !slices.Contains(lookupTypes, lookupType) {
+			writeError(w, http.StatusUnprocessableEntity, apiError{Code: "10015", Title: "Invalid value", Detail: fmt.Sprintf("type must be one of %s, got %q.", strings.Join(lookupTypes, ", "), lookupType), Source: map[string]string{"parameter": "type"}})
+			return
+		}
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	carrier, ok := lookupFixtures[phoneNumber]
+	if !ok {
+		carrier = lookupCarrier{name: "T-Mobile USA, Inc.", lineType: "mobile", callerName: "WIRELESS CALLER"}
+	}
+	portedDate := ""
+	if carrier.ported {
+		portedDate = "2019-04-01"
+	}
+	numbers := server.collections["porting_phone_numbers"]
+	for _, id := range numbers.order {
+		number := numbers.objects[id]
+		if number["phone_number"] == phoneNumber && number["porting_order_status"] == "ported" {
+			carrier.ported = true
+			portedDate = strings.SplitN(stringField(number, "updated_at"), "T", 2)[0]
+		}
+	}
+	for _, record := range server.collections["phone_numbers"].objects {
+		if record["phone_number"] == phoneNumber {
+			carrier.name, carrier.lineType, carrier.callerName = "Telnyx", "voip", "TELNYX"
+		}
+	}
+	portedStatus := "N"
+	if carrier.ported {
+		portedStatus = "Y"
+	}
+
+	lrn := ""
+	if countryOf(phoneNumber) == "US" {
+		lrn = digitsOnly(phoneNumber)[1:]
+	}
+	data := map[string]interface{}{
+		"record_type":     "number_lookup",
+		"phone_number":    phoneNumber,
+		"country_code":    countryOf(phoneNumber),
+		"national_format": nationalFormat(phoneNumber),
+		"carrier":         nil,
+		"caller_name":     nil,
+		"portability": map[string]interface{}{
+			"lrn":               lrn,
+			"ported_status":     portedStatus,
+			"ported_date":       portedDate,
+			"ocn":               "",
+			"line_type":         carrier.lineType,
+			"spid":              "",
+			"spid_carrier_name": carrier.name,
+			"spid_carrier_type": "",
+			"city":              "",
+			"state":             "",
+		},
+	}
+	if slices.Contains(requested, "carrier") {
+		data["carrier"] = map[string]interface{}{
+			"name":                carrier.name,
+			"normalized_carrier":  carrier.name,
+			"type":                carrier.lineType,
+			"mobile_country_code": "",
+			"mobile_network_code": "",
+			"error_code":          nil,
+		}
+	}
+	if slices.Contains(requested, "caller-name") {
+		data["caller_name"] = map[string]interface{}{"caller_name": carrier.callerName, "error_code": nil}
+	}
+	writeData(w, http.StatusOK, data)
+}
+
+// nationalFormat formats a North American number as (NXX) NXX-XXXX and
+// leaves others in E.164.
+func nationalFormat(phoneNumber string) string {
+	digits := digitsOnly(phoneNumber)
+	if len(digits) != 11 || digits[0] != '1' {
+		return phoneNumber
+	}
+	return fmt.Sprintf("(%s) %s-%s", digits[1:4], digits[4:7], digits[7:])
+}
@@ -0,0 +1,151 @@
+package telnyxtest
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// notificationEventConditions is the fake's catalog of events notification
+// settings can subscribe to. The IDs are fixed so tests can refer to them.
+var notificationEventConditions = []map[string]interface{}{
+	{
+		"id":                     "70c7c5cb-dce2-4124-accb-870d39dbe852",
+		"name":                   "Port status changed",
+		"description":            "A port order moved to a new status.",
+		"notification_event_id":  "port.status_changed",
+		"associated_record_type": "account",
+		"asynchronous":           true,
+		"enabled":                true,
+		"supported_channels":     []interface{}{"email", "sms", "webhook"},
+		"parameters":             []interface{}{},
+	},
+	{
+		"id":                     "d7c3a0f3-3f5e-4c2c-9d1b-4b4f3f6a52a1",
+		"name":                   "Number order failed",
+		"description":            "A number order could not be fulfilled.",
+		"notification_event_id":  "number_order.failed",
+		"associated_record_type": "account",
+		"asynchronous":           true,
+		"enabled":                true,
+		"supported_channels":     []interface{}{"email", "sms", "webhook"},
+		"parameters":             []interface{}{},
+	},
+	{
+		"id":                     "0a5e4b44-8f6b-4a0a-a0a8-1c6f1b1f7e0d",
+		"name":                   "Low balance",
+		"description":            "The account balance dropped below a threshold.",
+		"notification_event_id":  "balance.low",
+		"associated_record_type": "account",
+		"asynchronous":           false,
+		"enabled":                true,
+		"supported_channels":     []interface{}{"email", "sms", "voice", "webhook"},
+		"parameters": []interface{}{
+			map[string]interface{}{"name": "amount", "data_type": "decimal", "optional": false},
+		},
+	},
+	{
+		"id":                     "5f6f8d5e-2a4b-4c7e-9b3a-7e8a6c2d1f90",
+		"name":                   "Emergency call placed",
+		"description":            "An emergency call was placed from a phone number.",
+		"notification_event_id":  "emergency.call_placed",
+		"associated_record_type": "phone_number",
+		"asynchronous":           false,
+		"enabled":                true,
+		"supported_channels":     []interface{}{"email", "sms", "voice", "webhook"},
+		"parameters":             []interface{}{},
+	},
+	{
+		"id":                     "b3e1f2a4-6c5d-4e8f-8a7b-2c9d0e1f3a4b",
+		"name":                   "Connection registration lost",
+		"description":            "A connection stopped answering SIP OPTIONS pings.",
+		"notification_event_id":  "connection.registration_lost",
+		"associated_record_type": "connection",
+		"asynchronous":           false,
+		"enabled":                true,
+		"supported_channels":     []interface{}{"email", "sms", "webhook"},
+		"parameters":             []interface{}{},
+	},
+}
+
+// associatedRecordCollections are the collections that hold the records a
+// notification setting can be associated with, by associated_record_type.
+var associatedRecordCollections = map[string][]string{
+	"phone_number": {"phone_numbers"},
+	"connection":   connectionCollections,
+}
+
+// handleCreateNotificationSetting binds an event condition to a channel. The
+// channel must belong to the profile and support the condition, and the
+// associated record, when the condition is about one, must exist. Telnyx
+// confirms settings in the background; the fake enables them immediately.
+func (server *Server) handleCreateNotificationSetting(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, apiError{Code: "10002", Title: "Invalid request body", Detail: err.Error()})
+		return
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	records := server.collections["notification_settings"]
+	if errs := server.validate(records, "", body, true); len(errs) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, errs...)
+		return
+	}
+
+	condition := server.collections["notification_event_conditions"].objects[stringField(body, "notification_event_condition_id")]
+	channel := server.collections["notification_channels"].objects[stringField(body, "notification_channel_id")]
+	recordType := stringField(body, "associated_record_type")
+	if recordType == "" {
+		recordType = stringField(condition, "associated_record_type")
+	}
+	recordValue := stringField(body, "associated_record_type_value")
+
+	var errs []apiError
+	if stringField(channel, "notification_profile_id") != stringField(body, "notification_profile_id") {
+		errs = append(errs, validationError("/notification_channel_id", "The channel does not belong to the notification profile."))
+	}
+	supported, _ := condition["supported_channels"].([]interface{})
+	if !containsValue(supported, stringField(channel, "channel_type_id")) {
+		errs = append(errs, validationError("/notification_channel_id", fmt.Sprintf("The event condition cannot be delivered to %s channels.", channel["channel_type_id"])))
+	}
+	if recordType != stringField(condition, "associated_record_type") {
+		errs = append(errs, validationError("/associated_record_type", fmt.Sprintf("The event condition is about %s records, not %s records.", condition["associated_record_type"], recordType)))
+	} else if collections, ok := associatedRecordCollections[recordType]; ok {
+		if recordValue == "" {
+			errs = append(errs, apiError{Code: "10032", Title: "Missing required parameter", Detail: "The 'associated_record_type_value' parameter is required.", Source: map[string]string{"pointer": "/associated_record_type_value"}})
+		} else if !server.exists(collections, recordValue) {
+			errs = append(errs, validationError("/associated_record_type_value", fmt.Sprintf("The %s %q does not exist.", recordType, recordValue)))
+		}
+	}
+	if len(errs) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, errs...)
+		return
+	}
+
+	parameters, _ := body["parameters"].([]interface{})
+	if parameters == nil {
+		parameters = []interface{}{}
+	}
+	setting := map[string]interface{}{
+		"notification_event_condition_id": stringField(body, "notification_event_condition_id"),
+		"notification_profile_id":         stringField(body, "notification_profile_id"),
+		"notification_channel_id":         stringField(body, "notification_channel_id"),
+		"associated_record_type":          recordType,
+		"associated_record_type_value":    recordValue,
+		"status":                          "enabled",
+		"parameters":                      parameters,
+	}
+	server.stamp(records.spec, setting)
+	records.insert(setting)
+	writeData(w, http.StatusOK, setting)
+}
+
+func containsValue(values []interface{}, value string) bool {
+	for _, candidate := range values {
+		if fmt.Sprint(candidate) == value {
+			return true
+		}
+	}
+	return false
+}
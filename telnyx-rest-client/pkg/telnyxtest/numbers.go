@@ -0,0 +1,495 @@
+package telnyxtest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// reservationLifetime is how long a number reservation lasts before it must
+// be extended.
+const reservationLifetime = 30 * time.Minute
+
+// countryPrefixes maps the countries the fake sells numbers in to their
+// calling codes.
+var countryPrefixes = map[string]string{"US": "1", "CA": "1", "GB": "44"}
+
+func (server *Server) handleBalance(w http.ResponseWriter, r *http.Request) {
+	writeData(w, http.StatusOK, map[string]interface{}{
+		"record_type":      "balance",
+		"balance":          "300.00",
+		"credit_limit":     "100.00",
+		"available_credit": "400.00",
+		"pending":          "0.00",
+		"currency":         "USD",
+	})
+}
+
+// handleAvailablePhoneNumbers generates numbers that satisfy the phone
+// number filters. Results are deterministic and skip numbers that are
+// already on the account or reserved.
+func (server *Server) handleAvailablePhoneNumbers(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	countryCode := strings.ToUpper(query.Get("filter[country_code]"))
+	if countryCode == "" {
+		countryCode = "US"
+	}
+	prefix, ok := countryPrefixes[countryCode]
+	if !ok {
+		writeError(w, http.StatusUnprocessableEntity, validationError("/filter/country_code", fmt.Sprintf("Numbers are not available in %q.", countryCode)))
+		return
+	}
+	limit := 10
+	if value := query.Get("filter[limit]"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			writeError(w, http.StatusUnprocessableEntity, validationError("/filter/limit", "filter[limit] must be a positive integer."))
+			return
+		}
+		limit = parsed
+	}
+	features := []interface{}{map[string]interface{}{"name": "sms"}, map[string]interface{}{"name": "voice"}, map[string]interface{}{"name": "mms"}}
+	phoneNumberType := query.Get("filter[phone_number_type]")
+	if phoneNumberType == "" {
+		phoneNumberType = "local"
+	}
+
+	startsWith := digitsOnly(query.Get("filter[phone_number][starts_with]"))
+	endsWith := digitsOnly(query.Get("filter[phone_number][ends_with]"))
+	containsDigits := digitsOnly(query.Get("filter[phone_number][contains]"))
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	taken := server.takenPhoneNumbers()
+
+	data := []map[string]interface{}{}
+	for sequence := 0; len(data) < limit && sequence < 10000; sequence++ {
+		national := nationalNumber(startsWith, containsDigits, endsWith, sequence, 10)
+		if national == "" {
+			break
+		}
+		phoneNumber := "+" + prefix + national
+		if taken[phoneNumber] {
+			continue
+		}
+		data = append(data, map[string]interface{}{
+			"record_type":   "available_phone_number",
+			"phone_number":  phoneNumber,
+			"vanity_format": "",
+			"best_effort":   false,
+			"quickship":     true,
+			"reservable":    true,
+			"region_information": []map[string]interface{}{
+				{"region_type": "country_code", "region_name": countryCode},
+				{"region_type": "rate_center", "region_name": strings.ToUpper(query.Get("filter[rate_center]"))},
+				{"region_type": "state", "region_name": query.Get("filter[administrative_area]")},
+			},
+			"cost_information":  map[string]interface{}{"upfront_cost": "1.00", "monthly_cost": "1.00", "currency": "USD"},
+			"features":          features,
+			"phone_number_type": phoneNumberType,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": data,
+		"meta": map[string]int{"total_results": len(data), "best_effort_results": 0},
+	})
+}
+
+// nationalNumber builds the sequence-th number of the given length that
+// starts with startsWith, contains containsDigits and ends with endsWith, or
+// returns "" when the constraints leave no room.
+func nationalNumber(startsWith, containsDigits, endsWith string, sequence, length int) string {
+	free := length - len(startsWith) - len(containsDigits) - len(endsWith)
+	if free < 1 {
+		return ""
+	}
+	fill := fmt.Sprintf("%0*d", free, sequence)
+	if len(fill) > free {
+		return ""
+	}
+	if startsWith == "" {
+		// A leading zero or one is not a valid area code.
+		fill = "5" + fill[1:]
+	}
+	return startsWith + containsDigits + fill + endsWith
+}
+
+func digitsOnly(value string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, strings.TrimPrefix(value, "+"))
+}
+
+// takenPhoneNumbers returns the numbers that are no longer available,
+// including those of orders still waiting for regulatory requirements.
+// Callers must hold server.mu.
+func (server *Server) takenPhoneNumbers() map[string]bool {
+	taken := map[string]bool{}
+	for _, record := range server.collections["phone_numbers"].objects {
+		taken[fmt.Sprint(record["phone_number"])] = true
+	}
+	for _, order := range server.collections["number_orders"].objects {
+		orderNumbers, _ := order["phone_numbers"].([]interface{})
+		for _, item := range orderNumbers {
+			if entry, _ := item.(map[string]interface{}); entry["status"] == "pending" {
+				taken[fmt.Sprint(entry["phone_number"])] = true
+			}
+		}
+	}
+	now := time.Now()
+	for _, reservation := range server.collections["number_reservations"].objects {
+		numbers, _ := reservation["phone_numbers"].([]interface{})
+		for _, item := range numbers {
+			number, _ := item.(map[string]interface{})
+			expiredAt, _ := time.Parse(time.RFC3339Nano, fmt.Sprint(number["expired_at"]))
+			if expiredAt.After(now) {
+				taken[fmt.Sprint(number["phone_number"])] = true
+			}
+		}
+	}
+	return taken
+}
+
+// handleCreateNumberOrder places an order grouped into one sub number order
+// per country and number type. Numbers whose regulatory requirements are met,
+// by their own values or a requirement group, are added to the account as
+// active phone numbers straight away; the rest stay pending until the order
+// is updated with the missing values.
+func (server *Server) handleCreateNumberOrder(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, apiError{Code: "10002", Title: "Invalid request body", Detail: err.Error()})
+		return
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	requested, _ := body["phone_numbers"].([]interface{})
+	var errs []apiError
+	if len(requested) == 0 {
+		errs = append(errs, apiError{Code: "10032", Title: "Missing required parameter", Detail: "The 'phone_numbers' parameter is required.", Source: map[string]string{"pointer": "/phone_numbers"}})
+	}
+	taken := server.takenPhoneNumbers()
+	var phoneNumbers []string
+	var groupIDs []string
+	var requirementValues [][]interface{}
+	for i, item := range requested {
+		entry, _ := item.(map[string]interface{})
+		phoneNumber, _ := entry["phone_number"].(string)
+		pointer := fmt.Sprintf("/phone_numbers/%d", i)
+		switch {
+		case !strings.HasPrefix(phoneNumber, "+") || len(digitsOnly(phoneNumber)) < 8:
+			errs = append(errs, validationError(pointer+"/phone_number", fmt.Sprintf("%q is not a valid E.164 phone number.", phoneNumber)))
+		case taken[phoneNumber] && !server.reservedBy(phoneNumber, body["customer_reference"]):
+			errs = append(errs, apiError{Code: "85001", Title: "Number not available", Detail: fmt.Sprintf("%s is no longer available.", phoneNumber), Source: map[string]string{"pointer": pointer + "/phone_number"}})
+		}
+		groupID := stringField(entry, "requirement_group_id")
+		if groupID != "" {
+			if err := server.checkRequirementGroup(groupID, countryOf(phoneNumber), pointer+"/requirement_group_id"); err != nil {
+				errs = append(errs, *err)
+			}
+		}
+		submitted, _ := entry["regulatory_requirements"].([]interface{})
+		values, valueErrs := server.checkRequirementValues(countryOf(phoneNumber), submitted, pointer+"/regulatory_requirements")
+		errs = append(errs, valueErrs...)
+		phoneNumbers = append(phoneNumbers, phoneNumber)
+		groupIDs = append(groupIDs, groupID)
+		requirementValues = append(requirementValues, values)
+	}
+	phoneNumbersSpec := server.collections["phone_numbers"].spec
+	for _, field := range sortedKeys(phoneNumbersSpec.references) {
+		if value := body[field]; !isBlank(value) && !server.exists(phoneNumbersSpec.references[field], fmt.Sprint(value)) {
+			errs = append(errs, validationError("/"+field, fmt.Sprintf("The %s %q does not exist.", field, fmt.Sprint(value))))
+		}
+	}
+	if len(errs) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, errs...)
+		return
+	}
+
+	now := timestamp(time.Now())
+	order := map[string]interface{}{
+		"id":                   newUUID(),
+		"record_type":          "number_order",
+		"phone_numbers_count":  len(phoneNumbers),
+		"connection_id":        stringField(body, "connection_id"),
+		"messaging_profile_id": stringField(body, "messaging_profile_id"),
+		"billing_group_id":     stringField(body, "billing_group_id"),
+		"customer_reference":   stringField(body, "customer_reference"),
+		"created_at":           now,
+	}
+
+	subOrders := map[string]map[string]interface{}{}
+	var orderNumbers []interface{}
+	var subOrderIDs []interface{}
+	for i, phoneNumber := range phoneNumbers {
+		countryCode := countryOf(phoneNumber)
+		key := countryCode + "/local"
+		subOrder, ok := subOrders[key]
+		if !ok {
+			requirements := []interface{}{}
+			for _, requirement := range regulatoryCatalog[countryCode] {
+				requirements = append(requirements, map[string]interface{}{
+					"record_type":    "sub_number_order_regulatory_requirement",
+					"requirement_id": requirement["id"],
+					"field_type":     requirement["field_type"],
+				})
+			}
+			subOrder = map[string]interface{}{
+				"id":                        newUUID(),
+				"record_type":               "sub_number_order",
+				"order_request_id":          order["id"],
+				"country_code":              countryCode,
+				"phone_number_type":         "local",
+				"user_id":                   "8a2bde89-0e8c-4a1a-9f1e-5f1f0f0c0a11",
+				"regulatory_requirements":   requirements,
+				"phone_numbers_count":       0,
+				"customer_reference":        order["customer_reference"],
+				"is_block_sub_number_order": false,
+				"created_at":                now,
+			}
+			subOrders[key] = subOrder
+			subOrderIDs = append(subOrderIDs, subOrder["id"])
+		}
+		subOrder["phone_numbers_count"] = subOrder["phone_numbers_count"].(int) + 1
+
+		// The fake reuses the phone number's ID for its order entry, so
+		// either can be passed to the phone number endpoints.
+		entry := map[string]interface{}{
+			"id":                      server.nextNumericID(),
+			"record_type":             "number_order_phone_number",
+			"phone_number":            phoneNumber,
+			"bundle_id":               "",
+			"phone_number_type":       "local",
+			"country_code":            countryCode,
+			"requirements_met":        false,
+			"status":                  "pending",
+			"requirement_group_id":    groupIDs[i],
+			"regulatory_requirements": append([]interface{}{}, requirementValues[i]...),
+			"sub_number_order_id":     subOrder["id"],
+		}
+		orderNumbers = append(orderNumbers, entry)
+		server.fulfilOrderNumber(order, entry, now)
+	}
+	for _, subOrder := range subOrders {
+		server.collections["sub_number_orders"].insert(subOrder)
+	}
+	order["phone_numbers"] = orderNumbers
+	order["sub_number_orders_ids"] = subOrderIDs
+	server.refreshNumberOrder(order, now)
+	server.collections["number_orders"].insert(order)
+
+	writeData(w, http.StatusOK, order)
+}
+
+// newPhoneNumber returns an active phone number record as Telnyx shows it
+// right after the number is ordered or ported in.
+func (server *Server) newPhoneNumber(id, phoneNumber, connectionID, messagingProfileID, billingGroupID, customerReference, now string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                      id,
+		"record_type":             "phone_number",
+		"phone_number":            phoneNumber,
+		"status":                  "active",
+		"tags":                    []interface{}{},
+		"external_pin":            "",
+		"connection_id":           connectionID,
+		"connection_name":         server.connectionName(connectionID),
+		"customer_reference":      customerReference,
+		"messaging_profile_id":    messagingProfileID,
+		"messaging_profile_name":  server.fieldOf("messaging_profiles", messagingProfileID, "name"),
+		"billing_group_id":        billingGroupID,
+		"emergency_enabled":       false,
+		"emergency_address_id":    "",
+		"call_forwarding_enabled": true,
+		"cnam_listing_enabled":    false,
+		"caller_id_name_enabled":  false,
+		"call_recording_enabled":  false,
+		"t38_fax_gateway_enabled": false,
+		"number_level_routing":    "disabled",
+		"phone_number_type":       "local",
+		"country_iso_alpha2":      countryOf(phoneNumber),
+		"hd_voice_enabled":        false,
+		"purchased_at":            now,
+		"created_at":              now,
+		"updated_at":              now,
+	}
+}
+
+// handleCancelSubNumberOrder cancels a sub number order and releases the
+// numbers it added to the account or is still holding.
+func (server *Server) handleCancelSubNumberOrder(w http.ResponseWriter, r *http.Request) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	id := r.PathValue("id")
+	subOrder, ok := server.collections["sub_number_orders"].objects[id]
+	if !ok {
+		notFound(w, "sub_number_order", id)
+		return
+	}
+	if subOrder["status"] == "cancelled" {
+		writeError(w, http.StatusUnprocessableEntity, apiError{Code: "10015", Title: "Invalid value", Detail: "The sub number order has already been cancelled."})
+		return
+	}
+
+	phoneNumbers := server.collections["phone_numbers"]
+	for _, numberID := range append([]string(nil), phoneNumbers.order...) {
+		if phoneNumbers.objects[numberID]["sub_number_order_id"] == id {
+			phoneNumbers.remove(numberID)
+		}
+	}
+	if order, ok := server.collections["number_orders"].objects[stringField(subOrder, "order_request_id")]; ok {
+		orderNumbers, _ := order["phone_numbers"].([]interface{})
+		for _, item := range orderNumbers {
+			if entry, _ := item.(map[string]interface{}); entry["sub_number_order_id"] == id && entry["status"] == "pending" {
+				entry["status"] = "cancelled"
+			}
+		}
+	}
+	subOrder["status"] = "cancelled"
+	subOrder["updated_at"] = timestamp(time.Now())
+	writeData(w, http.StatusOK, subOrder)
+}
+
+func (server *Server) handleCreateNumberReservation(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, apiError{Code: "10002", Title: "Invalid request body", Detail: err.Error()})
+		return
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	requested, _ := body["phone_numbers"].([]interface{})
+	if len(requested) == 0 {
+		writeError(w, http.StatusUnprocessableEntity, apiError{Code: "10032", Title: "Missing required parameter", Detail: "The 'phone_numbers' parameter is required.", Source: map[string]string{"pointer": "/phone_numbers"}})
+		return
+	}
+	taken := server.takenPhoneNumbers()
+	now := time.Now()
+	var numbers []interface{}
+	for i, item := range requested {
+		entry, _ := item.(map[string]interface{})
+		phoneNumber, _ := entry["phone_number"].(string)
+		if taken[phoneNumber] {
+			writeError(w, http.StatusUnprocessableEntity, apiError{Code: "85001", Title: "Number not available", Detail: fmt.Sprintf("%s is no longer available.", phoneNumber), Source: map[string]string{"pointer": fmt.Sprintf("/phone_numbers/%d/phone_number", i)}})
+			return
+		}
+		numbers = append(numbers, map[string]interface{}{
+			"id":           newUUID(),
+			"record_type":  "reserved_phone_number",
+			"phone_number": phoneNumber,
+			"status":       "success",
+			"created_at":   timestamp(now),
+			"updated_at":   timestamp(now),
+			"expired_at":   timestamp(now.Add(reservationLifetime)),
+		})
+	}
+
+	reservation := map[string]interface{}{
+		"id":                 newUUID(),
+		"record_type":        "number_reservation",
+		"phone_numbers":      numbers,
+		"status":             "success",
+		"customer_reference": stringField(body, "customer_reference"),
+		"created_at":         timestamp(now),
+		"updated_at":         timestamp(now),
+	}
+	server.collections["number_reservations"].insert(reservation)
+	writeData(w, http.StatusOK, reservation)
+}
+
+func (server *Server) handleExtendNumberReservation(w http.ResponseWriter, r *http.Request) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	id := r.PathValue("id")
+	reservation, ok := server.collections["number_reservations"].objects[id]
+	if !ok {
+		notFound(w, "number_reservation", id)
+		return
+	}
+	now := time.Now()
+	numbers, _ := reservation["phone_numbers"].([]interface{})
+	for _, item := range numbers {
+		number := item.(map[string]interface{})
+		number["expired_at"] = timestamp(now.Add(reservationLifetime))
+		number["updated_at"] = timestamp(now)
+	}
+	reservation["updated_at"] = timestamp(now)
+	writeData(w, http.StatusOK, reservation)
+}
+
+// reservedBy reports whether phoneNumber is held by a live reservation with
+// the given customer reference, which lets that customer order it.
+func (server *Server) reservedBy(phoneNumber string, customerReference interface{}) bool {
+	now := time.Now()
+	for _, reservation := range server.collections["number_reservations"].objects {
+		if isBlank(customerReference) || reservation["customer_reference"] != customerReference {
+			continue
+		}
+		numbers, _ := reservation["phone_numbers"].([]interface{})
+		for _, item := range numbers {
+			number, _ := item.(map[string]interface{})
+			expiredAt, _ := time.Parse(time.RFC3339Nano, fmt.Sprint(number["expired_at"]))
+			if number["phone_number"] == phoneNumber && expiredAt.After(now) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (server *Server) exists(collections []string, id string) bool {
+	for _, name := range collections {
+		if _, ok := server.collections[name].objects[id]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (server *Server) connectionName(id string) string {
+	for _, name := range connectionCollections {
+		if record, ok := server.collections[name].objects[id]; ok {
+			for _, field := range []string{"connection_name", "friendly_name", "application_name"} {
+				if value, ok := record[field].(string); ok {
+					return value
+				}
+			}
+		}
+	}
+	return ""
+}
+
+func (server *Server) fieldOf(collectionName, id, field string) string {
+	if record, ok := server.collections[collectionName].objects[id]; ok {
+		if value, ok := record[field].(string); ok {
+			return value
+		}
+	}
+	return ""
+}
+
+func stringField(body map[string]interface{}, field string) string {
+	if value := body[field]; value != nil {
+		return fmt.Sprint(value)
+	}
+	return ""
+}
+
+// countryOf guesses the country of an E.164 number from its calling code.
+func countryOf(phoneNumber string) string {
+	if strings.HasPrefix(phoneNumber, "+44") {
+		return "GB"
+	}
+	return "US"
+}
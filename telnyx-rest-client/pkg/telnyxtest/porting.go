@@ -0,0 +1,477 @@
+package telnyxtest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// portingCommentsSpec describes the comments on porting orders, which are
+// only reachable below /porting_orders/{id}/comments.
+var portingCommentsSpec = collectionSpec{name: "porting_orders/comments", recordType: "porting_comment"}
+
+// portingSteps maps the status of a submitted porting order to the status it
+// moves to next. The fake takes one step each time the order is read, so a
+// client polling the order sees every transition without waiting. The last
+// step, to ported, happens once the FOC date has passed.
+var portingSteps = map[string]string{
+	"submitted":      "in-process",
+	"in-process":     "foc-date-confirmed",
+	"cancel-pending": "cancelled",
+}
+
+// portingActivationStatuses is the activation status of the numbers of an
+// order in each status.
+var portingActivationStatuses = map[string]string{
+	"draft":              "New",
+	"submitted":          "Pending",
+	"in-process":         "Pending",
+	"exception":          "Conflict",
+	"foc-date-confirmed": "Activate RDY",
+	"cancel-pending":     "Cancel Pending",
+	"cancelled":          "Cancelled",
+	"ported":             "Active",
+}
+
+// portingSubmitRequirements are the fields an order needs before it can be
+// submitted to the losing carrier.
+var portingSubmitRequirements = []string{
+	"end_user/admin/entity_name",
+	"end_user/admin/auth_person_name",
+	"end_user/admin/billing_phone_number",
+	"end_user/location/street_address",
+	"end_user/location/locality",
+	"end_user/location/administrative_area",
+	"end_user/location/postal_code",
+	"end_user/location/country_code",
+	"documents/loa",
+	"documents/invoice",
+	"activation_settings/foc_datetime_requested",
+}
+
+// portingUpdateFields are the fields of a porting order a PATCH may change.
+var portingUpdateFields = []string{"customer_reference", "webhook_url", "end_user", "documents", "activation_settings", "phone_number_configuration"}
+
+// portingConfigurationReferences name the collections the IDs in
+// phone_number_configuration must exist in.
+var portingConfigurationReferences = map[string][]string{
+	"connection_id":        connectionCollections,
+	"messaging_profile_id": {"messaging_profiles"},
+	"billing_group_id":     {"billing_groups"},
+}
+
+func portingStatus(record map[string]interface{}) string {
+	status, _ := record["status"].(map[string]interface{})
+	return stringField(status, "value")
+}
+
+func stringAt(record map[string]interface{}, path string) string {
+	value, _ := lookup(record, path).(string)
+	return value
+}
+
+// advancePortingOrder moves a submitted porting order one step along
+// portingSteps, and ports its numbers once the FOC date has passed.
+func (server *Server) advancePortingOrder(record map[string]interface{}) {
+	status := portingStatus(record)
+	if status == "foc-date-confirmed" {
+		focDate, err := time.Parse(time.RFC3339Nano, stringAt(record, "activation_settings/foc_datetime_actual"))
+		if err == nil && !focDate.After(time.Now()) {
+			server.completePort(record)
+		}
+		return
+	}
+	next, ok := portingSteps[status]
+	if !ok {
+		return
+	}
+	if next == "foc-date-confirmed" {
+		activation := record["activation_settings"].(map[string]interface{})
+		activation["foc_datetime_actual"] = activation["foc_datetime_requested"]
+	}
+	server.setPortingStatus(record, next)
+}
+
+// completePort adds the numbers of a porting order to the account, configured
+// as the order asks, and marks the order ported.
+func (server *Server) completePort(record map[string]interface{}) {
+	now := timestamp(time.Now())
+	for _, number := range server.portingNumbersOf(stringField(record, "id")) {
+		server.collections["phone_numbers"].insert(server.newPhoneNumber(
+			server.nextNumericID(),
+			stringField(number, "phone_number"),
+			stringAt(record, "phone_number_configuration/connection_id"),
+			stringAt(record, "phone_number_configuration/messaging_profile_id"),
+			stringAt(record, "phone_number_configuration/billing_group_id"),
+			stringField(record, "customer_reference"),
+			now,
+		))
+	}
+	server.setPortingStatus(record, "ported")
+}
+
+// setPortingStatus moves a porting order and its numbers to status, and
+// leaves a system comment on the order like Telnyx does.
+func (server *Server) setPortingStatus(record map[string]interface{}, status string) {
+	now := timestamp(time.Now())
+	record["status"] = map[string]interface{}{"value": status, "details": []interface{}{}}
+	record["updated_at"] = now
+	for _, number := range server.portingNumbersOf(stringField(record, "id")) {
+		number["porting_order_status"] = status
+		number["activation_status"] = portingActivationStatuses[status]
+		number["updated_at"] = now
+	}
+	comment := map[string]interface{}{
+		"body":             fmt.Sprintf("Porting order status changed to %s.", status),
+		"porting_order_id": record["id"],
+		"user_type":        "system",
+	}
+	server.stamp(portingCommentsSpec, comment)
+	server.collections[portingCommentsSpec.name].insert(comment)
+}
+
+func (server *Server) portingNumbersOf(portingOrderID string) []map[string]interface{} {
+	numbers := server.collections["porting_phone_numbers"]
+	var result []map[string]interface{}
+	for _, id := range numbers.order {
+		if numbers.objects[id]["porting_order_id"] == portingOrderID {
+			result = append(result, numbers.objects[id])
+		}
+	}
+	return result
+}
+
+// notPortableReason explains why a phone number cannot be ported, or returns
+// an empty string when it can.
+func (server *Server) notPortableReason(phoneNumber string) string {
+	if !strings.HasPrefix(phoneNumber, "+") || len(digitsOnly(phoneNumber)) < 8 {
+		return fmt.Sprintf("%q is not a valid E.164 phone number.", phoneNumber)
+	}
+	if server.takenPhoneNumbers()[phoneNumber] {
+		return fmt.Sprintf("%s is already on your account.", phoneNumber)
+	}
+	numbers := server.collections["porting_phone_numbers"]
+	for _, id := range numbers.order {
+		number := numbers.objects[id]
+		status := stringField(number, "porting_order_status")
+		if number["phone_number"] == phoneNumber && status != "cancelled" && status != "ported" {
+			return fmt.Sprintf("%s is already in porting order %s.", phoneNumber, number["porting_order_id"])
+		}
+	}
+	return ""
+}
+
+func (server *Server) handlePortabilityChecks(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, apiError{Code: "10002", Title: "Invalid request body", Detail: err.Error()})
+		return
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	requested, _ := body["phone_numbers"].([]interface{})
+	if len(requested) == 0 {
+		writeError(w, http.StatusUnprocessableEntity, apiError{Code: "10032", Title: "Missing required parameter", Detail: "The 'phone_numbers' parameter is required.", Source: map[string]string{"pointer": "/phone_numbers"}})
+		return
+	}
+	results := []interface{}{}
+	for _, item := range requested {
+		phoneNumber := fmt.Sprint(item)
+		reason := server.notPortableReason(phoneNumber)
+		results = append(results, map[string]interface{}{
+			"record_type":         "portability_check_result",
+			"phone_number":        phoneNumber,
+			"portable":            reason == "",
+			"fast_portable":       reason == "" && countryOf(phoneNumber) == "US",
+			"not_portable_reason": reason,
+		})
+	}
+	writeData(w, http.StatusOK, results)
+}
+
+// handleCreatePortingOrders creates draft porting orders. Telnyx splits the
+// numbers by losing carrier; the fake has no carriers and splits them by
+// country instead.
+func (server *Server) handleCreatePortingOrders(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, apiError{Code: "10002", Title: "Invalid request body", Detail: err.Error()})
+		return
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	requested, _ := body["phone_numbers"].([]interface{})
+	var errs []apiError
+	if len(requested) == 0 {
+		errs = append(errs, apiError{Code: "10032", Title: "Missing required parameter", Detail: "The 'phone_numbers' parameter is required.", Source: map[string]string{"pointer": "/phone_numbers"}})
+	}
+	byCountry := map[string][]string{}
+	var countries []string
+	for i, item := range requested {
+		phoneNumber := fmt.Sprint(item)
+		if reason := server.notPortableReason(phoneNumber); reason != "" {
+			errs = append(errs, apiError{Code: "10015", Title: "Number not portable", Detail: reason, Source: map[string]string{"pointer": fmt.Sprintf("/phone_numbers/%d", i)}})
+			continue
+		}
+		country := countryOf(phoneNumber)
+		if _, ok := byCountry[country]; !ok {
+			countries = append(countries, country)
+		}
+		byCountry[country] = append(byCountry[country], phoneNumber)
+	}
+	if len(errs) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, errs...)
+		return
+	}
+
+	orders := server.collections["porting_orders"]
+	numbers := server.collections["porting_phone_numbers"]
+	var created []interface{}
+	for _, country := range countries {
+		supportKey := "sr_" + randomToken(3)
+		order := map[string]interface{}{
+			"customer_reference":          stringField(body, "customer_reference"),
+			"support_key":                 supportKey,
+			"webhook_url":                 "",
+			"status":                      map[string]interface{}{"value": "draft", "details": []interface{}{}},
+			"phone_number_type":           "local",
+			"porting_phone_numbers_count": len(byCountry[country]),
+			"requirements_met":            false,
+			"old_service_provider_ocn":    "",
+			"activation_settings": map[string]interface{}{
+				"foc_datetime_requested": nil,
+				"foc_datetime_actual":    nil,
+				"fast_port_eligible":     country == "US",
+				"activation_status":      nil,
+			},
+			"end_user": map[string]interface{}{
+				"admin": map[string]interface{}{
+					"entity_name":          "",
+					"auth_person_name":     "",
+					"billing_phone_number": "",
+					"account_number":       "",
+					"tax_identifier":       "",
+					"pin_passcode":         "",
+					"business_identifier":  "",
+				},
+				"location": map[string]interface{}{
+					"street_address":      "",
+					"extended_address":    "",
+					"locality":            "",
+					"administrative_area": "",
+					"postal_code":         "",
+					"country_code":        "",
+				},
+			},
+			"documents": map[string]interface{}{"loa": nil, "invoice": nil},
+			"phone_number_configuration": map[string]interface{}{
+				"connection_id":        nil,
+				"messaging_profile_id": nil,
+				"billing_group_id":     nil,
+				"tags":                 []interface{}{},
+			},
+		}
+		server.stamp(orders.spec, order)
+		orders.insert(order)
+		for _, phoneNumber := range byCountry[country] {
+			number := map[string]interface{}{
+				"phone_number":         phoneNumber,
+				"porting_order_id":     order["id"],
+				"porting_order_status": "draft",
+				"support_key":          supportKey,
+				"phone_number_type":    "local",
+				"activation_status":    portingActivationStatuses["draft"],
+				"portability_status":   "confirmed",
+				"requirements_status":  "requirement-info-pending",
+			}
+			server.stamp(numbers.spec, number)
+			numbers.insert(number)
+		}
+		created = append(created, order)
+	}
+	writeData(w, http.StatusOK, created)
+}
+
+// handleUpdatePortingOrder changes the details of a porting order. Telnyx only
+// accepts changes while the order is a draft or in exception.
+func (server *Server) handleUpdatePortingOrder(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, apiError{Code: "10002", Title: "Invalid request body", Detail: err.Error()})
+		return
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	orders := server.collections["porting_orders"]
+	id := r.PathValue("id")
+	record, ok := orders.objects[id]
+	if !ok {
+		notFound(w, orders.spec.recordType, id)
+		return
+	}
+	if status := portingStatus(record); status != "draft" && status != "exception" {
+		writeError(w, http.StatusUnprocessableEntity, apiError{Code: "10015", Title: "Invalid porting order status", Detail: fmt.Sprintf("Porting orders can only be changed as a draft or in exception, not %s.", status)})
+		return
+	}
+
+	changes := map[string]interface{}{}
+	for _, field := range portingUpdateFields {
+		if value, ok := body[field]; ok {
+			changes[field] = value
+		}
+	}
+	var errs []apiError
+	if activation, ok := changes["activation_settings"].(map[string]interface{}); ok {
+		// Only the requested FOC date can be set; Telnyx fills in the rest.
+		requested := activation["foc_datetime_requested"]
+		changes["activation_settings"] = map[string]interface{}{"foc_datetime_requested": requested}
+		if !isBlank(requested) {
+			focDate, err := time.Parse(time.RFC3339Nano, fmt.Sprint(requested))
+			if err != nil {
+				errs = append(errs, validationError("/activation_settings/foc_datetime_requested", fmt.Sprintf("%q is not an ISO 8601 timestamp.", requested)))
+			} else {
+				changes["activation_settings"] = map[string]interface{}{"foc_datetime_requested": timestamp(focDate)}
+			}
+		}
+	}
+	for _, field := range sortedKeys(portingConfigurationReferences) {
+		value := lookup(changes, "phone_number_configuration/"+field)
+		if !isBlank(value) && !server.exists(portingConfigurationReferences[field], fmt.Sprint(value)) {
+			errs = append(errs, validationError("/phone_number_configuration/"+field, fmt.Sprintf("The %s %q does not exist.", field, fmt.Sprint(value))))
+		}
+	}
+	if len(errs) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, errs...)
+		return
+	}
+
+	updated := cloneObject(record)
+	mergeObject(updated, changes)
+	server.stamp(orders.spec, updated)
+	orders.insert(updated)
+	writeData(w, http.StatusOK, updated)
+}
+
+func (server *Server) handleDeletePortingOrder(w http.ResponseWriter, r *http.Request) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	orders := server.collections["porting_orders"]
+	id := r.PathValue("id")
+	record, ok := orders.objects[id]
+	if !ok {
+		notFound(w, orders.spec.recordType, id)
+		return
+	}
+	if status := portingStatus(record); status != "draft" {
+		writeError(w, http.StatusUnprocessableEntity, apiError{Code: "10015", Title: "Invalid porting order status", Detail: fmt.Sprintf("Only draft porting orders can be deleted; cancel %s orders instead.", status)})
+		return
+	}
+	for _, number := range server.portingNumbersOf(id) {
+		server.collections["porting_phone_numbers"].remove(stringField(number, "id"))
+	}
+	orders.remove(id)
+	writeData(w, http.StatusOK, record)
+}
+
+// handleConfirmPortingOrder submits a porting order once it carries the end
+// user details, documents and FOC date the losing carrier needs.
+func (server *Server) handleConfirmPortingOrder(w http.ResponseWriter, r *http.Request) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	orders := server.collections["porting_orders"]
+	id := r.PathValue("id")
+	record, ok := orders.objects[id]
+	if !ok {
+		notFound(w, orders.spec.recordType, id)
+		return
+	}
+	if status := portingStatus(record); status != "draft" && status != "exception" {
+		writeError(w, http.StatusUnprocessableEntity, apiError{Code: "10015", Title: "Invalid porting order status", Detail: fmt.Sprintf("Only draft porting orders or orders in exception can be submitted, not %s orders.", status)})
+		return
+	}
+	var errs []apiError
+	for _, field := range portingSubmitRequirements {
+		if isBlank(lookup(record, field)) {
+			errs = append(errs, validationError("/"+field, fmt.Sprintf("%s is required before the porting order can be submitted.", field)))
+		}
+	}
+	if len(errs) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, errs...)
+		return
+	}
+	record["requirements_met"] = true
+	for _, number := range server.portingNumbersOf(id) {
+		number["requirements_status"] = "approved"
+	}
+	server.setPortingStatus(record, "submitted")
+	writeData(w, http.StatusOK, record)
+}
+
+func (server *Server) handleCancelPortingOrder(w http.ResponseWriter, r *http.Request) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	orders := server.collections["porting_orders"]
+	id := r.PathValue("id")
+	record, ok := orders.objects[id]
+	if !ok {
+		notFound(w, orders.spec.recordType, id)
+		return
+	}
+	status := portingStatus(record)
+	if !slices.Contains([]string{"submitted", "in-process", "exception", "foc-date-confirmed"}, status) {
+		writeError(w, http.StatusUnprocessableEntity, apiError{Code: "10015", Title: "Invalid porting order status", Detail: fmt.Sprintf("%s porting orders cannot be cancelled.", status)})
+		return
+	}
+	server.setPortingStatus(record, "cancel-pending")
+	writeData(w, http.StatusOK, record)
+}
+
+func (server *Server) handleCreatePortingComment(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, apiError{Code: "10002", Title: "Invalid request body", Detail: err.Error()})
+		return
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	id := r.PathValue("id")
+	if _, ok := server.collections["porting_orders"].objects[id]; !ok {
+		notFound(w, "porting_order", id)
+		return
+	}
+	if isBlank(body["body"]) {
+		writeError(w, http.StatusUnprocessableEntity, apiError{Code: "10032", Title: "Missing required parameter", Detail: "The 'body' parameter is required.", Source: map[string]string{"pointer": "/body"}})
+		return
+	}
+	comment := map[string]interface{}{
+		"body":             stringField(body, "body"),
+		"porting_order_id": id,
+		"user_type":        "user",
+	}
+	server.stamp(portingCommentsSpec, comment)
+	server.collections[portingCommentsSpec.name].insert(comment)
+	writeData(w, http.StatusOK, comment)
+}
+
+// handleListPortingComments lists the comments on one porting order with the
+// generic list handler, filtered by order.
+func (server *Server) handleListPortingComments(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	server.mu.Lock()
+	_, ok := server.collections["porting_orders"].objects[id]
+	server.mu.Unlock()
+	if !ok {
+		notFound(w, "porting_order", id)
+		return
+	}
+	query := r.URL.Query()
+	query.Set("filter[porting_order_id]", id)
+	r.URL.RawQuery = query.Encode()
+	server.handleList(portingCommentsSpec.name)(w, r)
+}
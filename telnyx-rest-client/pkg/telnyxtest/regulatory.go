@@ -0,0 +1,499 @@
+package telnyxtest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Requirement IDs of the fake's regulatory catalog, exported so tests can
+// fulfil them.
+const (
+	// RequirementGBAddress is the textual UK address required for GB numbers.
+	RequirementGBAddress = "c1d2a1b0-2f4e-4d5a-9a3c-5b1f0e7d6a01"
+	// RequirementGBProofOfAddress is the document proving the GB address.
+	RequirementGBProofOfAddress = "c1d2a1b0-2f4e-4d5a-9a3c-5b1f0e7d6a02"
+)
+
+// regulatoryCatalog lists the requirements for ordering numbers in each
+// country. Countries that are not listed, such as the US, have none.
+var regulatoryCatalog = map[string][]map[string]interface{}{
+	"GB": {
+		{
+			"id":                  RequirementGBAddress,
+			"record_type":         "requirement_type",
+			"name":                "UK address",
+			"description":         "An address in the United Kingdom where the end user can be reached.",
+			"example":             "10 Downing Street, London, SW1A 2AA",
+			"field_type":          "textual",
+			"acceptance_criteria": map[string]interface{}{"locality_limit": "Within the United Kingdom"},
+		},
+		{
+			"id":                  RequirementGBProofOfAddress,
+			"record_type":         "requirement_type",
+			"name":                "Proof of address",
+			"description":         "A utility bill or bank statement issued in the last three months showing the UK address.",
+			"example":             "utility_bill.pdf",
+			"field_type":          "document",
+			"acceptance_criteria": map[string]interface{}{"acceptable_values": []interface{}{"application/pdf", "image/jpeg", "image/png"}},
+		},
+	},
+}
+
+// maxDocumentSize is the largest upload the fake accepts.
+const maxDocumentSize = 10 << 20
+
+// requirementGroupUpdateFields are the fields of a requirement group a PATCH
+// may change.
+var requirementGroupUpdateFields = []string{"customer_reference", "regulatory_requirements"}
+
+// catalogRequirement returns the requirement with the given ID from the
+// catalog of a country.
+func catalogRequirement(countryCode, requirementID string) (map[string]interface{}, bool) {
+	for _, requirement := range regulatoryCatalog[countryCode] {
+		if requirement["id"] == requirementID {
+			return requirement, true
+		}
+	}
+	return nil, false
+}
+
+// checkRequirementValues validates regulatory requirement values given for
+// numbers in a country and returns them with their field types filled in.
+// Document values must be the IDs of uploaded documents. Callers must hold
+// server.mu.
+func (server *Server) checkRequirementValues(countryCode string, values []interface{}, pointer string) ([]interface{}, []apiError) {
+	var checked []interface{}
+	var errs []apiError
+	for i, item := range values {
+		value, _ := item.(map[string]interface{})
+		requirementID := stringField(value, "requirement_id")
+		fieldValue := stringField(value, "field_value")
+		requirement, ok := catalogRequirement(countryCode, requirementID)
+		switch {
+		case !ok:
+			errs = append(errs, validationError(fmt.Sprintf("%s/%d/requirement_id", pointer, i), fmt.Sprintf("%q is not a regulatory requirement for %s numbers.", requirementID, countryCode)))
+			continue
+		case fieldValue == "":
+			errs = append(errs, validationError(fmt.Sprintf("%s/%d/field_value", pointer, i), fmt.Sprintf("A value is required for %q.", requirement["name"])))
+			continue
+		case requirement["field_type"] == "document" && !server.exists([]string{"documents"}, fieldValue):
+			errs = append(errs, validationError(fmt.Sprintf("%s/%d/field_value", pointer, i), fmt.Sprintf("The document %q does not exist.", fieldValue)))
+			continue
+		}
+		checked = append(checked, map[string]interface{}{
+			"requirement_id": requirementID,
+			"field_value":    fieldValue,
+			"field_type":     requirement["field_type"],
+		})
+	}
+	return checked, errs
+}
+
+// mergeRequirementValues returns current with the values in updates added
+// or replaced by requirement ID.
+func mergeRequirementValues(current, updates []interface{}) []interface{} {
+	merged := append([]interface{}(nil), current...)
+	for _, item := range updates {
+		update := item.(map[string]interface{})
+		replaced := false
+		for i, existing := range merged {
+			if stringField(existing.(map[string]interface{}), "requirement_id") == update["requirement_id"] {
+				merged[i] = update
+				replaced = true
+			}
+		}
+		if !replaced {
+			merged = append(merged, update)
+		}
+	}
+	return merged
+}
+
+// requirementsMet reports whether values cover every requirement of a
+// country.
+func requirementsMet(countryCode string, values []interface{}) bool {
+	for _, requirement := range regulatoryCatalog[countryCode] {
+		covered := false
+		for _, item := range values {
+			if stringField(item.(map[string]interface{}), "requirement_id") == requirement["id"] {
+				covered = true
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+// handleRegulatoryRequirements answers with the requirements for each
+// number in filter[phone_number], a comma separated list.
+func (server *Server) handleRegulatoryRequirements(w http.ResponseWriter, r *http.Request) {
+	filter := r.URL.Query().Get("filter[phone_number]")
+	if filter == "" {
+		writeError(w, http.StatusUnprocessableEntity, apiError{Code: "10032", Title: "Missing required parameter", Detail: "The 'filter[phone_number]' parameter is required.", Source: map[string]string{"parameter": "filter[phone_number]"}})
+		return
+	}
+	data := []interface{}{}
+	for _, phoneNumber := range strings.Split(filter, ",") {
+		phoneNumber = strings.TrimSpace(phoneNumber)
+		requirements := []interface{}{}
+		for _, requirement := range regulatoryCatalog[countryOf(phoneNumber)] {
+			requirements = append(requirements, cloneObject(requirement))
+		}
+		data = append(data, map[string]interface{}{
+			"record_type":             "phone_number_regulatory_requirement",
+			"phone_number":            phoneNumber,
+			"phone_number_type":       "local",
+			"regulatory_requirements": requirements,
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": data,
+		"meta": map[string]int{"page_number": 1, "page_size": len(data), "total_pages": 1, "total_results": len(data)},
+	})
+}
+
+// handleCreateRequirementGroup creates a requirement group. The group is
+// approved as soon as its values cover every requirement of its country.
+func (server *Server) handleCreateRequirementGroup(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, apiError{Code: "10002", Title: "Invalid request body", Detail: err.Error()})
+		return
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	records := server.collections["requirement_groups"]
+	if errs := server.validate(records, "", body, true); len(errs) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, errs...)
+		return
+	}
+	countryCode := strings.ToUpper(stringField(body, "country_code"))
+	submitted, _ := body["regulatory_requirements"].([]interface{})
+	values, errs := server.checkRequirementValues(countryCode, submitted, "/regulatory_requirements")
+	if len(errs) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, errs...)
+		return
+	}
+
+	group := map[string]interface{}{
+		"country_code":       countryCode,
+		"phone_number_type":  stringField(body, "phone_number_type"),
+		"action":             stringField(body, "action"),
+		"customer_reference": stringField(body, "customer_reference"),
+	}
+	setRequirementGroupValues(group, values)
+	server.stamp(records.spec, group)
+	records.insert(group)
+	writeData(w, http.StatusOK, group)
+}
+
+// handleUpdateRequirementGroup changes the customer reference of a group or
+// merges new requirement values into it.
+func (server *Server) handleUpdateRequirementGroup(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, apiError{Code: "10002", Title: "Invalid request body", Detail: err.Error()})
+		return
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	records := server.collections["requirement_groups"]
+	id := r.PathValue("id")
+	group, ok := records.objects[id]
+	if !ok {
+		notFound(w, "requirement_group", id)
+		return
+	}
+	var errs []apiError
+	for _, field := range sortedKeys(body) {
+		if !contains(requirementGroupUpdateFields, field) {
+			errs = append(errs, validationError("/"+field, fmt.Sprintf("The %s of a requirement group cannot be changed.", field)))
+		}
+	}
+	submitted, _ := body["regulatory_requirements"].([]interface{})
+	values, valueErrs := server.checkRequirementValues(stringField(group, "country_code"), submitted, "/regulatory_requirements")
+	if errs = append(errs, valueErrs...); len(errs) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, errs...)
+		return
+	}
+
+	if value, ok := body["customer_reference"]; ok {
+		group["customer_reference"] = value
+	}
+	current, _ := group["regulatory_requirements"].([]interface{})
+	setRequirementGroupValues(group, mergeRequirementValues(current, values))
+	server.stamp(records.spec, group)
+	writeData(w, http.StatusOK, group)
+}
+
+// setRequirementGroupValues stores the values of a requirement group and
+// recomputes its status.
+func setRequirementGroupValues(group map[string]interface{}, values []interface{}) {
+	stored := []interface{}{}
+	for _, item := range values {
+		value := cloneObject(item.(map[string]interface{}))
+		value["status"] = "approved"
+		stored = append(stored, value)
+	}
+	group["regulatory_requirements"] = stored
+	group["status"] = "unapproved"
+	if requirementsMet(stringField(group, "country_code"), stored) {
+		group["status"] = "approved"
+	}
+}
+
+// handleUploadDocument stores the file part of a multipart upload. The
+// fake accepts every file and marks it verified straight away.
+func (server *Server) handleUploadDocument(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(maxDocumentSize); err != nil {
+		writeError(w, http.StatusBadRequest, apiError{Code: "10002", Title: "Invalid request body", Detail: err.Error()})
+		return
+	}
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, apiError{Code: "10032", Title: "Missing required parameter", Detail: "The 'file' parameter is required.", Source: map[string]string{"pointer": "/file"}})
+		return
+	}
+	defer file.Close()
+	content, err := io.ReadAll(file)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, apiError{Code: "10002", Title: "Invalid request body", Detail: err.Error()})
+		return
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	contentType := header.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	digest := sha256.Sum256(content)
+	records := server.collections["documents"]
+	document := map[string]interface{}{
+		"filename":           header.Filename,
+		"content_type":       contentType,
+		"size":               map[string]interface{}{"amount": len(content), "unit": "bytes"},
+		"sha256":             hex.EncodeToString(digest[:]),
+		"status":             "verified",
+		"av_scan_status":     "scanned",
+		"customer_reference": r.FormValue("customer_reference"),
+	}
+	server.stamp(records.spec, document)
+	records.insert(document)
+	server.documents[document["id"].(string)] = content
+	writeData(w, http.StatusOK, document)
+}
+
+// handleDownloadDocument answers with the uploaded file itself.
+func (server *Server) handleDownloadDocument(w http.ResponseWriter, r *http.Request) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	id := r.PathValue("id")
+	document, ok := server.collections["documents"].objects[id]
+	if !ok {
+		notFound(w, "document", id)
+		return
+	}
+	w.Header().Set("Content-Type", stringField(document, "content_type"))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(server.documents[id])
+}
+
+// handleUpdateNumberOrder changes the customer reference of an order or
+// supplies regulatory requirement values for its pending numbers. Numbers
+// whose requirements are then met are added to the account.
+func (server *Server) handleUpdateNumberOrder(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, apiError{Code: "10002", Title: "Invalid request body", Detail: err.Error()})
+		return
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	id := r.PathValue("id")
+	order, ok := server.collections["number_orders"].objects[id]
+	if !ok {
+		notFound(w, "number_order", id)
+		return
+	}
+
+	// A value applies to every number of the order whose country has that
+	// requirement, so it is checked against the first such country.
+	submitted, _ := body["regulatory_requirements"].([]interface{})
+	orderNumbers, _ := order["phone_numbers"].([]interface{})
+	var values []interface{}
+	var errs []apiError
+	for i, item := range submitted {
+		value, _ := item.(map[string]interface{})
+		countryCode := ""
+		for _, entry := range orderNumbers {
+			if _, ok := catalogRequirement(stringField(entry.(map[string]interface{}), "country_code"), stringField(value, "requirement_id")); ok {
+				countryCode = stringField(entry.(map[string]interface{}), "country_code")
+				break
+			}
+		}
+		if countryCode == "" {
+			errs = append(errs, validationError(fmt.Sprintf("/regulatory_requirements/%d/requirement_id", i), fmt.Sprintf("%q is not a regulatory requirement of any number in the order.", stringField(value, "requirement_id"))))
+			continue
+		}
+		checked, valueErrs := server.checkRequirementValues(countryCode, []interface{}{value}, "/regulatory_requirements")
+		for _, valueErr := range valueErrs {
+			valueErr.Source["pointer"] = strings.Replace(valueErr.Source["pointer"], "/0/", fmt.Sprintf("/%d/", i), 1)
+			errs = append(errs, valueErr)
+		}
+		values = append(values, checked...)
+	}
+	if len(errs) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, errs...)
+		return
+	}
+
+	now := timestamp(time.Now())
+	if value, ok := body["customer_reference"]; ok {
+		order["customer_reference"] = value
+	}
+	for _, item := range orderNumbers {
+		entry := item.(map[string]interface{})
+		var applicable []interface{}
+		for _, value := range values {
+			if _, ok := catalogRequirement(stringField(entry, "country_code"), stringField(value.(map[string]interface{}), "requirement_id")); ok {
+				applicable = append(applicable, value)
+			}
+		}
+		current, _ := entry["regulatory_requirements"].([]interface{})
+		entry["regulatory_requirements"] = mergeRequirementValues(current, applicable)
+		server.fulfilOrderNumber(order, entry, now)
+	}
+	server.refreshNumberOrder(order, now)
+	writeData(w, http.StatusOK, order)
+}
+
+// handleSetOrderNumberRequirementGroup fulfils the requirements of one
+// number of an order with a requirement group.
+func (server *Server) handleSetOrderNumberRequirementGroup(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, apiError{Code: "10002", Title: "Invalid request body", Detail: err.Error()})
+		return
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	id := r.PathValue("id")
+	order, entry := server.findOrderNumber(id)
+	if entry == nil {
+		notFound(w, "number_order_phone_number", id)
+		return
+	}
+	groupID := stringField(body, "requirement_group_id")
+	if err := server.checkRequirementGroup(groupID, stringField(entry, "country_code"), "/requirement_group_id"); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, *err)
+		return
+	}
+
+	now := timestamp(time.Now())
+	entry["requirement_group_id"] = groupID
+	server.fulfilOrderNumber(order, entry, now)
+	server.refreshNumberOrder(order, now)
+	writeData(w, http.StatusOK, entry)
+}
+
+// checkRequirementGroup verifies that a requirement group exists and
+// applies to numbers in the given country. Callers must hold server.mu.
+func (server *Server) checkRequirementGroup(groupID, countryCode, pointer string) *apiError {
+	group, ok := server.collections["requirement_groups"].objects[groupID]
+	if !ok {
+		err := validationError(pointer, fmt.Sprintf("The requirement group %q does not exist.", groupID))
+		return &err
+	}
+	if group["country_code"] != countryCode {
+		err := validationError(pointer, fmt.Sprintf("The requirement group %q is for %s numbers, not %s.", groupID, group["country_code"], countryCode))
+		return &err
+	}
+	return nil
+}
+
+// findOrderNumber returns the number order entry with the given ID and the
+// order it belongs to. Callers must hold server.mu.
+func (server *Server) findOrderNumber(id string) (map[string]interface{}, map[string]interface{}) {
+	for _, order := range server.collections["number_orders"].objects {
+		orderNumbers, _ := order["phone_numbers"].([]interface{})
+		for _, item := range orderNumbers {
+			if entry := item.(map[string]interface{}); entry["id"] == id {
+				return order, entry
+			}
+		}
+	}
+	return nil, nil
+}
+
+// fulfilOrderNumber recomputes whether a pending number of an order meets
+// its requirements, from its own values and those of its requirement group,
+// and adds it to the account once it does. Callers must hold server.mu.
+func (server *Server) fulfilOrderNumber(order, entry map[string]interface{}, now string) {
+	if entry["status"] != "pending" {
+		return
+	}
+	values, _ := entry["regulatory_requirements"].([]interface{})
+	if group, ok := server.collections["requirement_groups"].objects[stringField(entry, "requirement_group_id")]; ok {
+		groupValues, _ := group["regulatory_requirements"].([]interface{})
+		values = mergeRequirementValues(groupValues, values)
+	}
+	if !requirementsMet(stringField(entry, "country_code"), values) {
+		return
+	}
+	entry["requirements_met"] = true
+	entry["status"] = "success"
+
+	phoneNumberRecord := server.newPhoneNumber(stringField(entry, "id"), stringField(entry, "phone_number"), stringField(order, "connection_id"), stringField(order, "messaging_profile_id"), stringField(order, "billing_group_id"), stringField(order, "customer_reference"), now)
+	phoneNumberRecord["sub_number_order_id"] = entry["sub_number_order_id"]
+	server.collections["phone_numbers"].insert(phoneNumberRecord)
+}
+
+// refreshNumberOrder recomputes the status of an order and its sub orders
+// from the status of their numbers. Callers must hold server.mu.
+func (server *Server) refreshNumberOrder(order map[string]interface{}, now string) {
+	pendingSubOrders := map[string]bool{}
+	orderNumbers, _ := order["phone_numbers"].([]interface{})
+	for _, item := range orderNumbers {
+		if entry := item.(map[string]interface{}); entry["status"] == "pending" {
+			pendingSubOrders[stringField(entry, "sub_number_order_id")] = true
+		}
+	}
+	subOrderIDs, _ := order["sub_number_orders_ids"].([]interface{})
+	for _, subOrderID := range subOrderIDs {
+		subOrder, ok := server.collections["sub_number_orders"].objects[fmt.Sprint(subOrderID)]
+		if !ok || subOrder["status"] == "cancelled" {
+			continue
+		}
+		subOrder["requirements_met"] = !pendingSubOrders[fmt.Sprint(subOrderID)]
+		subOrder["status"] = orderStatus(!pendingSubOrders[fmt.Sprint(subOrderID)])
+		subOrder["updated_at"] = now
+	}
+	order["requirements_met"] = len(pendingSubOrders) == 0
+	order["status"] = orderStatus(len(pendingSubOrders) == 0)
+	order["updated_at"] = now
+}
+
+func orderStatus(requirementsMet bool) string {
+	if requirementsMet {
+		return "success"
+	}
+	return "pending"
+}
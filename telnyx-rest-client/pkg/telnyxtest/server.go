@@ -0,0 +1,330 @@
+// Package telnyxtest provides an in-memory fake of the Telnyx v2 API for
+// tests that must not touch a real account.
+//
+// The fake speaks the same JSON:API envelopes as Telnyx: single records are
+// wrapped in {"data": ...}, collections carry a "meta" object with
+// page_number, page_size, total_pages and total_results, and failures return
+// an {"errors": [...]} document with Telnyx error codes and source pointers.
+// State is kept per server, so a record created with POST can be read,
+// patched, listed and deleted afterwards.
+//
+//	server := telnyxtest.NewServer()
+//	defer server.Close()
+//	client, err := server.NewClient()
+package telnyxtest
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/petsinc/telnyx-rest-client/pkg/telnyx"
+	"go.uber.org/zap"
+)
+
+// DefaultAPIKey is the API key a Server accepts unless another one is set
+// before the first request.
+const DefaultAPIKey = "KEYTELNYXTEST"
+
+// Server is a running fake Telnyx API. The zero value is not usable; create
+// one with NewServer.
+type Server struct {
+	*httptest.Server
+
+	// APIKey is the bearer token every request must present.
+	APIKey string
+
+	mu          sync.Mutex
+	collections map[string]*collection
+	documents   map[string][]byte
+	failures    []*failure
+	sequence    int64
+}
+
+type failure struct {
+	method    string
+	path      string
+	status    int
+	remaining int
+}
+
+// NewServer starts a fake Telnyx API on a local port.
+func NewServer() *Server {
+	server := &Server{
+		APIKey:      DefaultAPIKey,
+		collections: map[string]*collection{},
+		documents:   map[string][]byte{},
+	}
+	for _, spec := range collectionSpecs {
+		server.collections[spec.name] = newCollection(spec)
+		for _, fixture := range spec.fixtures {
+			record := cloneObject(fixture)
+			server.stamp(spec, record)
+			server.collections[spec.name].insert(record)
+		}
+	}
+	for _, spec := range phoneNumberSettingsSpecs {
+		server.collections["phone_numbers/"+spec.name] = newCollection(spec.collectionSpec)
+	}
+	server.collections[portingCommentsSpec.name] = newCollection(portingCommentsSpec)
+	server.Server = httptest.NewServer(server.routes())
+	return server
+}
+
+// NewClient returns a client pointed at the server with its API key. Retries
+// are disabled so injected failures surface immediately; pass options to
+// override any setting.
+func (server *Server) NewClient(opts ...telnyx.Option) (*telnyx.TelnyxClient, error) {
+	defaults := []telnyx.Option{
+		telnyx.WithAPIKey(server.APIKey),
+		telnyx.WithBaseURL(server.URL),
+		telnyx.WithMaxRetries(0),
+		telnyx.WithLogger(zap.NewNop()),
+	}
+	return telnyx.NewClient(append(defaults, opts...)...)
+}
+
+// Fail makes the next count requests with the given method and path fail
+// with status before reaching the fake's handlers. Path is matched exactly,
+// without the query string. A 429 or 503 failure carries "Retry-After: 0".
+func (server *Server) Fail(method, path string, status, count int) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	server.failures = append(server.failures, &failure{method: method, path: path, status: status, remaining: count})
+}
+
+// Get returns a copy of a stored record, for example
+// Get("billing_groups", id).
+func (server *Server) Get(collectionName, id string) (map[string]interface{}, bool) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	records, ok := server.collections[collectionName]
+	if !ok {
+		return nil, false
+	}
+	record, ok := records.objects[id]
+	if !ok {
+		return nil, false
+	}
+	return cloneObject(record), true
+}
+
+// List returns copies of every stored record in a collection, oldest first.
+func (server *Server) List(collectionName string) []map[string]interface{} {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	records, ok := server.collections[collectionName]
+	if !ok {
+		return nil
+	}
+	result := make([]map[string]interface{}, 0, len(records.order))
+	for _, id := range records.order {
+		result = append(result, cloneObject(records.objects[id]))
+	}
+	return result
+}
+
+// Put stores a record directly, bypassing validation, and returns its ID.
+// Use it to seed state such as phone numbers that already exist on the
+// account. An ID, record type and timestamps are filled in when missing.
+func (server *Server) Put(collectionName string, record map[string]interface{}) string {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	records, ok := server.collections[collectionName]
+	if !ok {
+		panic(fmt.Sprintf("telnyxtest: unknown collection %q", collectionName))
+	}
+	record = cloneObject(record)
+	server.stamp(records.spec, record)
+	records.insert(record)
+	return record["id"].(string)
+}
+
+func (server *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /balance", server.handleBalance)
+	mux.HandleFunc("GET /connections", server.handleListConnections())
+	mux.HandleFunc("GET /available_phone_numbers", server.handleAvailablePhoneNumbers)
+	mux.HandleFunc("POST /number_orders", server.handleCreateNumberOrder)
+	mux.HandleFunc("PATCH /number_orders/{id}", server.handleUpdateNumberOrder)
+	mux.HandleFunc("POST /number_order_phone_numbers/{id}/requirement_group", server.handleSetOrderNumberRequirementGroup)
+	mux.HandleFunc("PATCH /sub_number_orders/{id}/cancel", server.handleCancelSubNumberOrder)
+	mux.HandleFunc("GET /phone_numbers_regulatory_requirements", server.handleRegulatoryRequirements)
+	mux.HandleFunc("GET /number_lookup/{phone_number}", server.handleNumberLookup)
+	mux.HandleFunc("POST /requirement_groups", server.handleCreateRequirementGroup)
+	mux.HandleFunc("PATCH /requirement_groups/{id}", server.handleUpdateRequirementGroup)
+	mux.HandleFunc("POST /documents", server.handleUploadDocument)
+	mux.HandleFunc("GET /documents/{id}/download", server.handleDownloadDocument)
+	mux.HandleFunc("POST /number_reservations", server.handleCreateNumberReservation)
+	mux.HandleFunc("POST /number_reservations/{id}/actions/extend", server.handleExtendNumberReservation)
+	mux.HandleFunc("POST /addresses", server.handleCreateAddress)
+	mux.HandleFunc("POST /addresses/actions/validate", server.handleValidateAddress)
+	mux.HandleFunc("DELETE /addresses/{id}", server.handleDeleteAddress)
+	mux.HandleFunc("POST /phone_numbers/{id}/actions/enable_emergency", server.handleEnableEmergency)
+	mux.HandleFunc("POST /notification_settings", server.handleCreateNotificationSetting)
+	mux.HandleFunc("POST /telephony_credentials", server.handleCreateTelephonyCredential)
+	mux.HandleFunc("PATCH /telephony_credentials/{id}", server.handleUpdateTelephonyCredential)
+	mux.HandleFunc("POST /telephony_credentials/{id}/token", server.handleCreateTelephonyCredentialToken)
+	mux.HandleFunc("POST /portability_checks", server.handlePortabilityChecks)
+	mux.HandleFunc("POST /porting_orders", server.handleCreatePortingOrders)
+	mux.HandleFunc("PATCH /porting_orders/{id}", server.handleUpdatePortingOrder)
+	mux.HandleFunc("DELETE /porting_orders/{id}", server.handleDeletePortingOrder)
+	mux.HandleFunc("POST /porting_orders/{id}/actions/confirm", server.handleConfirmPortingOrder)
+	mux.HandleFunc("POST /porting_orders/{id}/actions/cancel", server.handleCancelPortingOrder)
+	mux.HandleFunc("GET /porting_orders/{id}/comments", server.handleListPortingComments)
+	mux.HandleFunc("POST /porting_orders/{id}/comments", server.handleCreatePortingComment)
+
+	for _, spec := range phoneNumberSettingsSpecs {
+		mux.HandleFunc("GET /phone_numbers/{id}/"+spec.name, server.handleGetSettings(spec))
+		mux.HandleFunc("PATCH /phone_numbers/{id}/"+spec.name, server.handleUpdateSettings(spec))
+	}
+
+	for _, spec := range collectionSpecs {
+		prefix := "/" + spec.name
+		mux.HandleFunc("GET "+prefix, server.handleList(spec.name))
+		mux.HandleFunc("GET "+prefix+"/{id}", server.handleGet(spec.name))
+		if spec.creatable {
+			mux.HandleFunc("POST "+prefix, server.handleCreate(spec.name))
+		}
+		if spec.updatable {
+			mux.HandleFunc("PATCH "+prefix+"/{id}", server.handleUpdate(spec.name))
+		}
+		if spec.deletable {
+			mux.HandleFunc("DELETE "+prefix+"/{id}", server.handleDelete(spec.name))
+		}
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", newUUID())
+		if r.Header.Get("Authorization") != "Bearer "+server.APIKey {
+			writeError(w, http.StatusUnauthorized, apiError{Code: "10009", Title: "Authentication failed", Detail: "The API key provided is not valid."})
+			return
+		}
+		if server.injectedFailure(w, r) {
+			return
+		}
+		if _, pattern := mux.Handler(r); pattern == "" {
+			writeError(w, http.StatusNotFound, apiError{Code: "10005", Title: "Resource not found", Detail: fmt.Sprintf("%s %s is not supported by the fake Telnyx API.", r.Method, r.URL.Path)})
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func (server *Server) injectedFailure(w http.ResponseWriter, r *http.Request) bool {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	for i, injected := range server.failures {
+		if injected.method != r.Method || injected.path != r.URL.Path {
+			continue
+		}
+		injected.remaining--
+		if injected.remaining <= 0 {
+			server.failures = append(server.failures[:i], server.failures[i+1:]...)
+		}
+		if injected.status == http.StatusTooManyRequests || injected.status == http.StatusServiceUnavailable {
+			w.Header().Set("Retry-After", "0")
+		}
+		writeError(w, injected.status, apiError{Code: "10000", Title: http.StatusText(injected.status), Detail: "Failure injected by telnyxtest."})
+		return true
+	}
+	return false
+}
+
+// nextNumericID returns a Telnyx style numeric ID, as used for connections
+// and phone numbers.
+func (server *Server) nextNumericID() string {
+	server.sequence++
+	return fmt.Sprintf("%d", 2000000000000000000+server.sequence)
+}
+
+// stamp fills in the fields Telnyx sets on every new record.
+func (server *Server) stamp(spec collectionSpec, record map[string]interface{}) {
+	if id, _ := record["id"].(string); id == "" {
+		if spec.numericIDs {
+			record["id"] = server.nextNumericID()
+		} else {
+			record["id"] = newUUID()
+		}
+	}
+	if _, ok := record["record_type"]; !ok && spec.recordType != "" {
+		record["record_type"] = spec.recordType
+	}
+	now := timestamp(time.Now())
+	if _, ok := record["created_at"]; !ok {
+		record["created_at"] = now
+	}
+	record["updated_at"] = now
+}
+
+// apiError is one entry of a Telnyx error document.
+type apiError struct {
+	Code   string            `json:"code"`
+	Title  string            `json:"title"`
+	Detail string            `json:"detail"`
+	Source map[string]string `json:"source,omitempty"`
+}
+
+func validationError(pointer, detail string) apiError {
+	return apiError{Code: "10015", Title: "Invalid value", Detail: detail, Source: map[string]string{"pointer": pointer}}
+}
+
+func notFound(w http.ResponseWriter, kind, id string) {
+	writeError(w, http.StatusNotFound, apiError{Code: "10005", Title: "Resource not found", Detail: fmt.Sprintf("The requested %s %q could not be found.", kind, id)})
+}
+
+func writeError(w http.ResponseWriter, status int, errs ...apiError) {
+	writeJSON(w, status, map[string]interface{}{"errors": errs})
+}
+
+func writeData(w http.ResponseWriter, status int, data interface{}) {
+	writeJSON(w, status, map[string]interface{}{"data": data})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// decodeBody reads a JSON object, keeping numbers exact so 19 digit IDs
+// survive the round trip.
+func decodeBody(r *http.Request) (map[string]interface{}, error) {
+	var body map[string]interface{}
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(r.Body); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(buf.String()) == "" {
+		return map[string]interface{}{}, nil
+	}
+	decoder := json.NewDecoder(&buf)
+	decoder.UseNumber()
+	if err := decoder.Decode(&body); err != nil {
+		return nil, err
+	}
+	if body == nil {
+		body = map[string]interface{}{}
+	}
+	return body, nil
+}
+
+func timestamp(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func newUUID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	h := hex.EncodeToString(b[:])
+	return h[0:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:32]
+}
@@ -0,0 +1,183 @@
+package telnyxtest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// settingsSpec describes a settings document Telnyx keeps for every phone
+// number under /phone_numbers/{id}/{name}. Documents are created from the
+// defaults the first time they are read or patched and stored in a
+// collection named "phone_numbers/{name}", keyed by phone number ID.
+type settingsSpec struct {
+	collectionSpec
+	// inherited fields are copied from the phone number record whenever the
+	// settings are read.
+	inherited []string
+	// readOnly fields are ignored in PATCH bodies.
+	readOnly []string
+	// sync copies settings that Telnyx also reports on the phone number
+	// record itself.
+	sync func(server *Server, phoneNumber, settings map[string]interface{})
+}
+
+var phoneNumberSettingsSpecs = []settingsSpec{
+	{
+		collectionSpec: collectionSpec{
+			name: "voice", recordType: "voice_settings",
+			enums: map[string][]string{
+				"usage_payment_method":                           {"pay-per-minute", "channel"},
+				"inbound_call_screening":                         {"disabled", "reject_calls", "flag_calls"},
+				"call_forwarding/forwarding_type":                {"always", "on_failure"},
+				"call_recording/inbound_call_recording_format":   {"wav", "mp3"},
+				"call_recording/inbound_call_recording_channels": {"single", "dual"},
+			},
+			defaults: map[string]interface{}{
+				"tech_prefix_enabled":    false,
+				"translated_number":      "",
+				"caller_id_name_enabled": false,
+				"usage_payment_method":   "pay-per-minute",
+				"inbound_call_screening": "disabled",
+				"call_forwarding": map[string]interface{}{
+					"call_forwarding_enabled": true,
+					"forwards_to":             "",
+					"forwarding_type":         "always",
+				},
+				"cnam_listing": map[string]interface{}{
+					"cnam_listing_enabled": false,
+					"cnam_listing_details": "",
+				},
+				"media_features": map[string]interface{}{
+					"rtp_auto_adjust_enabled":        true,
+					"accept_any_rtp_packets_enabled": false,
+					"t38_fax_gateway_enabled":        false,
+				},
+				"call_recording": map[string]interface{}{
+					"inbound_call_recording_enabled":  false,
+					"inbound_call_recording_format":   "wav",
+					"inbound_call_recording_channels": "single",
+				},
+				"emergency": map[string]interface{}{
+					"emergency_enabled":    false,
+					"emergency_address_id": "",
+					"emergency_status":     "disabled",
+				},
+			},
+		},
+		inherited: []string{"phone_number", "connection_id", "customer_reference"},
+		readOnly:  []string{"phone_number", "connection_id", "customer_reference", "emergency"},
+		sync: func(server *Server, phoneNumber, settings map[string]interface{}) {
+			phoneNumber["call_forwarding_enabled"] = lookup(settings, "call_forwarding/call_forwarding_enabled")
+			phoneNumber["cnam_listing_enabled"] = lookup(settings, "cnam_listing/cnam_listing_enabled")
+			phoneNumber["caller_id_name_enabled"] = settings["caller_id_name_enabled"]
+			phoneNumber["call_recording_enabled"] = lookup(settings, "call_recording/inbound_call_recording_enabled")
+			phoneNumber["t38_fax_gateway_enabled"] = lookup(settings, "media_features/t38_fax_gateway_enabled")
+		},
+	},
+	{
+		collectionSpec: collectionSpec{
+			name: "messaging", recordType: "messaging_settings",
+			enums:        map[string][]string{"messaging_product": {"P2P", "A2P"}},
+			references:   map[string][]string{"messaging_profile_id": {"messaging_profiles"}},
+			stringFields: []string{"messaging_profile_id"},
+			defaults: map[string]interface{}{
+				"messaging_product":           "P2P",
+				"traffic_type":                "P2P",
+				"type":                        "long-code",
+				"eligible_messaging_products": []interface{}{"P2P", "A2P"},
+				"country_code":                "US",
+			},
+		},
+		inherited: []string{"phone_number", "messaging_profile_id"},
+		readOnly:  []string{"phone_number", "country_code", "type", "traffic_type", "eligible_messaging_products"},
+		sync: func(server *Server, phoneNumber, settings map[string]interface{}) {
+			profileID, _ := settings["messaging_profile_id"].(string)
+			phoneNumber["messaging_profile_id"] = profileID
+			phoneNumber["messaging_profile_name"] = server.fieldOf("messaging_profiles", profileID, "name")
+		},
+	},
+}
+
+func phoneNumberSettingsSpec(name string) settingsSpec {
+	for _, spec := range phoneNumberSettingsSpecs {
+		if spec.name == name {
+			return spec
+		}
+	}
+	panic(fmt.Sprintf("telnyxtest: unknown phone number settings %q", name))
+}
+
+func (server *Server) handleGetSettings(spec settingsSpec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		settings, ok := server.settingsFor(spec, r.PathValue("id"))
+		if !ok {
+			notFound(w, "phone_number", r.PathValue("id"))
+			return
+		}
+		writeData(w, http.StatusOK, settings)
+	}
+}
+
+func (server *Server) handleUpdateSettings(spec settingsSpec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := decodeBody(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, apiError{Code: "10002", Title: "Invalid request body", Detail: err.Error()})
+			return
+		}
+
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		id := r.PathValue("id")
+		settings, ok := server.settingsFor(spec, id)
+		if !ok {
+			notFound(w, "phone_number", id)
+			return
+		}
+		for _, field := range append([]string{"id", "record_type", "created_at", "updated_at"}, spec.readOnly...) {
+			delete(body, field)
+		}
+		records := server.collections["phone_numbers/"+spec.name]
+		if errs := server.validate(records, id, body, false); len(errs) > 0 {
+			writeError(w, http.StatusUnprocessableEntity, errs...)
+			return
+		}
+		coerceStrings(spec.collectionSpec, body)
+		mergeObject(settings, body)
+		settings["updated_at"] = timestamp(time.Now())
+		records.insert(settings)
+		if spec.sync != nil {
+			phoneNumber := server.collections["phone_numbers"].objects[id]
+			spec.sync(server, phoneNumber, settings)
+			phoneNumber["updated_at"] = settings["updated_at"]
+		}
+		writeData(w, http.StatusOK, settings)
+	}
+}
+
+// settingsFor returns the stored settings document of a phone number,
+// creating it from the defaults on first use. The caller must hold
+// server.mu.
+func (server *Server) settingsFor(spec settingsSpec, id string) (map[string]interface{}, bool) {
+	phoneNumber, ok := server.collections["phone_numbers"].objects[id]
+	if !ok {
+		return nil, false
+	}
+	records := server.collections["phone_numbers/"+spec.name]
+	settings, ok := records.objects[id]
+	if !ok {
+		settings = cloneObject(spec.defaults)
+		settings["id"] = id
+		settings["record_type"] = spec.recordType
+		settings["created_at"] = phoneNumber["created_at"]
+		settings["updated_at"] = timestamp(time.Now())
+		records.insert(settings)
+	}
+	for _, field := range spec.inherited {
+		settings[field] = phoneNumber[field]
+	}
+	return settings, true
+}